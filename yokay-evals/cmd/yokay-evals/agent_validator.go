@@ -0,0 +1,140 @@
+package main
+
+import "fmt"
+
+// AgentValidator customizes ValidateEvalConfig's per-test-case rules for
+// one yokay-* agent: which TaskInput fields it actually needs and which
+// Expected verdicts it accepts. Most built-in agents differ only in
+// whether they need input.task_description or input.implementation (or
+// both), but a custom validator can enforce anything, e.g. restricting
+// Expected to a bespoke verdict set.
+type AgentValidator interface {
+	// Name is the agent name this validator applies to (e.g.
+	// "yokay-quality-reviewer"), matched against EvalConfig.Agent.
+	Name() string
+	// ValidateInput checks a test case's TaskInput, returning a
+	// descriptive error (without a "test case X:" prefix, which
+	// ValidateEvalConfig adds) or nil.
+	ValidateInput(input TaskInput) error
+	// ValidateExpected checks a test case's Expected verdict, returning
+	// a descriptive error or nil.
+	ValidateExpected(expected string) error
+	// RequiredFields lists the input.* paths this agent always needs,
+	// for callers that want to describe a validator without running it
+	// (e.g. `yokay-evals init` scaffolding, documentation).
+	RequiredFields() []string
+}
+
+// agentValidators is the registry RegisterAgentValidator populates and
+// agentValidatorFor reads from. Pre-populated in init() below with the
+// built-in yokay-* agents that need something other than
+// DefaultAgentValidator's rules.
+var agentValidators = map[string]AgentValidator{}
+
+// RegisterAgentValidator adds v to the registry under v.Name(), replacing
+// any validator already registered for that name. Call this (typically
+// from an init()) to add a custom agent's validation rules without
+// patching ValidateEvalConfig itself.
+func RegisterAgentValidator(v AgentValidator) {
+	agentValidators[v.Name()] = v
+}
+
+// agentValidatorFor returns the AgentValidator registered for agent, or
+// DefaultAgentValidator{} if none is registered.
+func agentValidatorFor(agent string) AgentValidator {
+	if v, ok := agentValidators[agent]; ok {
+		return v
+	}
+	return DefaultAgentValidator{}
+}
+
+// DefaultAgentValidator is used for any agent without a registered
+// AgentValidator: it requires input.task_title plus at least one of
+// input.task_description or input.implementation, and accepts any
+// non-empty Expected verdict.
+type DefaultAgentValidator struct{}
+
+func (DefaultAgentValidator) Name() string { return "" }
+
+func (DefaultAgentValidator) ValidateInput(input TaskInput) error {
+	if input.TaskTitle == "" {
+		return fmt.Errorf("input.task_title is required")
+	}
+	if input.TaskDescription == "" && input.Implementation == "" {
+		return fmt.Errorf("at least one of input.task_description or input.implementation is required")
+	}
+	return nil
+}
+
+func (DefaultAgentValidator) ValidateExpected(expected string) error {
+	return requireNonEmptyExpected(expected)
+}
+
+func (DefaultAgentValidator) RequiredFields() []string {
+	return []string{"input.task_title"}
+}
+
+// requireNonEmptyExpected is the ValidateExpected rule shared by every
+// built-in AgentValidator below: any non-empty verdict is accepted.
+func requireNonEmptyExpected(expected string) error {
+	if expected == "" {
+		return fmt.Errorf("expected is required")
+	}
+	return nil
+}
+
+// qualityReviewerValidator is registered for yokay-quality-reviewer,
+// which reviews an implementation rather than a task description — a
+// description alone isn't gradable, so task_description is optional but
+// implementation is not.
+type qualityReviewerValidator struct{}
+
+func (qualityReviewerValidator) Name() string { return "yokay-quality-reviewer" }
+
+func (qualityReviewerValidator) ValidateInput(input TaskInput) error {
+	if input.TaskTitle == "" {
+		return fmt.Errorf("input.task_title is required")
+	}
+	if input.Implementation == "" {
+		return fmt.Errorf("input.implementation is required")
+	}
+	return nil
+}
+
+func (qualityReviewerValidator) ValidateExpected(expected string) error {
+	return requireNonEmptyExpected(expected)
+}
+
+func (qualityReviewerValidator) RequiredFields() []string {
+	return []string{"input.task_title", "input.implementation"}
+}
+
+// brainstormerValidator is registered for yokay-brainstormer, which
+// reviews a task description before any implementation exists, so
+// implementation is optional but task_description is not.
+type brainstormerValidator struct{}
+
+func (brainstormerValidator) Name() string { return "yokay-brainstormer" }
+
+func (brainstormerValidator) ValidateInput(input TaskInput) error {
+	if input.TaskTitle == "" {
+		return fmt.Errorf("input.task_title is required")
+	}
+	if input.TaskDescription == "" {
+		return fmt.Errorf("input.task_description is required")
+	}
+	return nil
+}
+
+func (brainstormerValidator) ValidateExpected(expected string) error {
+	return requireNonEmptyExpected(expected)
+}
+
+func (brainstormerValidator) RequiredFields() []string {
+	return []string{"input.task_title", "input.task_description"}
+}
+
+func init() {
+	RegisterAgentValidator(qualityReviewerValidator{})
+	RegisterAgentValidator(brainstormerValidator{})
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAgentValidatorForFallsBackToDefault(t *testing.T) {
+	v := agentValidatorFor("yokay-some-unregistered-agent")
+	if _, ok := v.(DefaultAgentValidator); !ok {
+		t.Fatalf("agentValidatorFor(unregistered) = %T, want DefaultAgentValidator", v)
+	}
+}
+
+func TestAgentValidatorForReturnsRegisteredValidator(t *testing.T) {
+	v := agentValidatorFor("yokay-quality-reviewer")
+	if v.Name() != "yokay-quality-reviewer" {
+		t.Fatalf("agentValidatorFor(yokay-quality-reviewer).Name() = %q, want yokay-quality-reviewer", v.Name())
+	}
+}
+
+func TestRegisterAgentValidatorAddsCustomAgent(t *testing.T) {
+	custom := fakeSecurityReviewerValidator{}
+	RegisterAgentValidator(custom)
+	defer delete(agentValidators, custom.Name())
+
+	v := agentValidatorFor("yokay-security-reviewer")
+	if v.Name() != "yokay-security-reviewer" {
+		t.Fatalf("agentValidatorFor(yokay-security-reviewer) = %+v, want the registered custom validator", v)
+	}
+	if err := v.ValidateExpected("MAYBE"); err == nil {
+		t.Error("expected ValidateExpected(\"MAYBE\") to be rejected by the custom validator")
+	}
+	if err := v.ValidateExpected("VULNERABLE"); err != nil {
+		t.Errorf("expected ValidateExpected(\"VULNERABLE\") to be accepted, got: %v", err)
+	}
+}
+
+// fakeSecurityReviewerValidator exercises RegisterAgentValidator's
+// extension point with a non-default-yokay-agent-shaped allowed Expected
+// set, the scenario the AgentValidator interface exists for.
+type fakeSecurityReviewerValidator struct{}
+
+func (fakeSecurityReviewerValidator) Name() string { return "yokay-security-reviewer" }
+
+func (fakeSecurityReviewerValidator) ValidateInput(input TaskInput) error {
+	return DefaultAgentValidator{}.ValidateInput(input)
+}
+
+func (fakeSecurityReviewerValidator) ValidateExpected(expected string) error {
+	switch expected {
+	case "VULNERABLE", "SAFE":
+		return nil
+	default:
+		return fmt.Errorf("expected must be VULNERABLE or SAFE, got %q", expected)
+	}
+}
+
+func (fakeSecurityReviewerValidator) RequiredFields() []string {
+	return []string{"input.task_title", "input.implementation"}
+}
+
+func TestQualityReviewerValidatorAllowsMissingDescription(t *testing.T) {
+	v := qualityReviewerValidator{}
+	input := TaskInput{TaskTitle: "Test", Implementation: "code"}
+	if err := v.ValidateInput(input); err != nil {
+		t.Errorf("expected no error for a quality-reviewer case with implementation but no description, got: %v", err)
+	}
+}
+
+func TestQualityReviewerValidatorRequiresImplementation(t *testing.T) {
+	v := qualityReviewerValidator{}
+	input := TaskInput{TaskTitle: "Test", TaskDescription: "Desc"}
+	if err := v.ValidateInput(input); err == nil {
+		t.Error("expected an error for a quality-reviewer case missing implementation")
+	}
+}
+
+func TestBrainstormerValidatorAllowsMissingImplementation(t *testing.T) {
+	v := brainstormerValidator{}
+	input := TaskInput{TaskTitle: "Test", TaskDescription: "Desc"}
+	if err := v.ValidateInput(input); err != nil {
+		t.Errorf("expected no error for a brainstormer case with description but no implementation, got: %v", err)
+	}
+}
+
+func TestBrainstormerValidatorRequiresDescription(t *testing.T) {
+	v := brainstormerValidator{}
+	input := TaskInput{TaskTitle: "Test", Implementation: "code"}
+	if err := v.ValidateInput(input); err == nil {
+		t.Error("expected an error for a brainstormer case missing task_description")
+	}
+}
+
+func TestDefaultAgentValidatorRequiresTaskTitle(t *testing.T) {
+	v := DefaultAgentValidator{}
+	input := TaskInput{TaskDescription: "Desc"}
+	if err := v.ValidateInput(input); err == nil {
+		t.Error("expected an error for a default-agent case missing task_title")
+	}
+}
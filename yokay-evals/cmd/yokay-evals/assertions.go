@@ -0,0 +1,425 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/stevestomp/yokay-evals/internal/assert"
+	"github.com/stevestomp/yokay-evals/internal/criteria"
+	"github.com/stevestomp/yokay-evals/internal/harness"
+)
+
+// Vars is the variable bag (H) an assertion is evaluated against. It is
+// populated from the isolated context's working directory plus any
+// driver-command stdout/stderr/exit code captured for the run.
+type Vars struct {
+	WorkingDir string
+	Stdout     string
+	Stderr     string
+	ExitCode   int
+	// Artifacts holds adapter-produced outputs addressable from the rich
+	// assertion DSL (see assert.Vars) as "result.artifacts.<key>".
+	Artifacts map[string]string
+	// Task and RootCause mirror the owning FailureCase's Context.Task and
+	// Failure.RootCause. They're only consumed by the judge-backed
+	// "code-based"/"model-based" criterion types, whose ModelJudge prompt
+	// needs the surrounding context that a plain assertion doesn't.
+	Task      string
+	RootCause string
+	// WhatWasBuilt mirrors the owning FailureCase's Evidence.WhatWasBuilt.
+	// It's only consumed by a "code-based" criterion whose Check isn't a
+	// key==value clause expression (see judge.CodeJudge): codebased.Executor
+	// materializes it into the run's working directory before running
+	// the check.
+	WhatWasBuilt string
+	// CandidateDir, when set, is copied into the run's working directory
+	// alongside WhatWasBuilt before a non-clause "code-based" check runs,
+	// letting a shell/named check inspect a real candidate artifact tree
+	// instead of just WhatWasBuilt's prose description.
+	CandidateDir string
+}
+
+// Failure describes an assertion that did not hold.
+type Failure struct {
+	Reason   string
+	Actual   string
+	Expected string
+	// CriterionIndex is the zero-based index into the owning FailureCase's
+	// EvalCriteria, set by runEvaluation. It lets callers (e.g. the
+	// integration tests' -update mode) map a failure back to the specific
+	// criterion that produced it.
+	CriterionIndex int
+}
+
+func (f *Failure) Error() string {
+	return fmt.Sprintf("%s: expected %q, got %q", f.Reason, f.Expected, f.Actual)
+}
+
+// AssertionFunc evaluates a single EvalCriterion's Check string against vars.
+// It returns a non-nil Failure when the assertion does not hold, and a
+// non-nil error only when the assertion itself could not be evaluated
+// (e.g. malformed check syntax).
+type AssertionFunc func(check string, vars Vars) (*Failure, error)
+
+// assertionRegistry maps an EvalCriterion.Type to the function that
+// evaluates its Check string. New check types can be added via
+// RegisterAssertion without touching runEvaluation.
+var assertionRegistry = map[string]AssertionFunc{
+	"file.exists":           assertFileExists,
+	"file.contains":         assertFileContains,
+	"file.regex":            assertFileRegex,
+	"file.json":             assertFileJSON,
+	"shell.exitcode":        assertShellExitCode,
+	"shell.stdout.contains": assertShellStdoutContains,
+	"expr":                  assertExpr,
+	"fixture.replay":        assertFixtureReplay,
+}
+
+// RegisterAssertion adds or replaces the AssertionFunc for a check type.
+func RegisterAssertion(checkType string, fn AssertionFunc) {
+	assertionRegistry[checkType] = fn
+}
+
+// EvaluateCriterion dispatches an EvalCriterion to its assertion. A
+// criterion carrying an Assertions list (see assert.Assertion) is
+// evaluated through the internal/assert DSL and only its first failure is
+// reported, matching the single-Failure shape the legacy AssertionFuncs
+// return. Otherwise it falls back to the registered AssertionFunc for its
+// Type. A bare check with no Type has no registered dispatch of its own;
+// it's treated as shorthand for a single ShouldMatchRegex assertion
+// against result.stdout rather than silently passing. A Type with no
+// AssertionFunc of its own (its Check isn't a file/shell selector) is
+// instead routed to internal/criteria, which dispatches "code-based" and
+// "model-based" - and, beyond these two built-ins, any type a project has
+// registered via criteria.Register - to the Adapter that knows how to
+// judge it.
+func EvaluateCriterion(criterion EvalCriterion, vars Vars) (*Failure, error) {
+	if len(criterion.Assertions) > 0 {
+		return evaluateAssertions(criterion.Assertions, vars)
+	}
+
+	if fn, ok := assertionRegistry[criterion.Type]; ok {
+		return fn(criterion.Check, vars)
+	}
+
+	if criteria.Registered(criterion.Type) {
+		return evaluateCriterionAdapter(criterion, vars)
+	}
+
+	if criterion.Type == "" && criterion.Check != "" {
+		return evaluateAssertions([]assert.Assertion{{
+			Operator: assert.ShouldMatchRegex,
+			Selector: "result.stdout",
+			Expected: criterion.Check,
+		}}, vars)
+	}
+
+	return nil, nil
+}
+
+var (
+	criterionAdaptersOnce sync.Once
+	criterionAdapters     map[string]criteria.Adapter
+	criterionAdaptersMu   sync.Mutex
+)
+
+// criterionAdapterFor returns the shared internal/criteria.Adapter for
+// criterionType, building it on first use. Adapters are either stateless
+// dispatchers (the built-in "code-based"/"go-test") or own only a
+// long-lived model client ("model-based"), so one instance per type is
+// reused across every criterion evaluated in the process.
+func criterionAdapterFor(criterionType string) (criteria.Adapter, error) {
+	criterionAdaptersOnce.Do(func() {
+		criterionAdapters = make(map[string]criteria.Adapter)
+	})
+
+	criterionAdaptersMu.Lock()
+	defer criterionAdaptersMu.Unlock()
+
+	if a, ok := criterionAdapters[criterionType]; ok {
+		return a, nil
+	}
+
+	a, err := criteria.New(criterionType, nil)
+	if err != nil {
+		return nil, err
+	}
+	criterionAdapters[criterionType] = a
+	return a, nil
+}
+
+// evaluateCriterionAdapter dispatches criterion to its registered
+// internal/criteria.Adapter and translates a failing Result into a
+// Failure.
+func evaluateCriterionAdapter(criterion EvalCriterion, vars Vars) (*Failure, error) {
+	a, err := criterionAdapterFor(criterion.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.Evaluate(context.Background(), criteria.Criterion{
+		Name:  criterion.criterionName(),
+		Check: criterion.Check,
+	}, criteria.RunContext{
+		WorkingDir:   vars.WorkingDir,
+		Task:         vars.Task,
+		RootCause:    vars.RootCause,
+		WhatWasBuilt: vars.WhatWasBuilt,
+		CandidateDir: vars.CandidateDir,
+		Stdout:       vars.Stdout,
+		Stderr:       vars.Stderr,
+		ExitCode:     vars.ExitCode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("evaluating %s criterion %q: %w", criterion.Type, criterion.criterionName(), err)
+	}
+	if !result.Passed {
+		return &Failure{Reason: criterion.Type, Actual: result.Message, Expected: criterion.Check}, nil
+	}
+	return nil, nil
+}
+
+// evaluateAssertions runs assertions in order against vars (converted to
+// assert.Vars), stopping at and returning the first failure, so a
+// criterion with several assertions reports one clear reason rather than
+// all of them at once.
+func evaluateAssertions(assertions []assert.Assertion, vars Vars) (*Failure, error) {
+	assertVars := assert.Vars{
+		Stdout:    vars.Stdout,
+		Stderr:    vars.Stderr,
+		ExitCode:  vars.ExitCode,
+		Artifacts: vars.Artifacts,
+	}
+
+	for _, a := range assertions {
+		failure, err := assert.Apply(context.Background(), assertVars, a)
+		if err != nil {
+			return nil, err
+		}
+		if failure != nil {
+			return &Failure{Reason: string(a.Operator), Actual: failure.Actual, Expected: failure.Expected}, nil
+		}
+	}
+	return nil, nil
+}
+
+func assertFileExists(check string, vars Vars) (*Failure, error) {
+	path := filepath.Join(vars.WorkingDir, strings.TrimSpace(check))
+	if _, err := os.Stat(path); err != nil {
+		return &Failure{Reason: "file.exists", Actual: "missing", Expected: check}, nil
+	}
+	return nil, nil
+}
+
+// splitCheck splits a "<path> :: <arg>" style check string used by the
+// file.contains and file.regex assertions.
+func splitCheck(check string) (path, arg string, err error) {
+	parts := strings.SplitN(check, "::", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("check %q: expected \"<path> :: <arg>\"", check)
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), nil
+}
+
+func assertFileContains(check string, vars Vars) (*Failure, error) {
+	path, substr, err := splitCheck(check)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(vars.WorkingDir, path))
+	if err != nil {
+		return &Failure{Reason: "file.contains", Actual: "file not found", Expected: path}, nil
+	}
+	if !strings.Contains(string(data), substr) {
+		return &Failure{Reason: "file.contains", Actual: string(data), Expected: substr}, nil
+	}
+	return nil, nil
+}
+
+func assertFileRegex(check string, vars Vars) (*Failure, error) {
+	path, pattern, err := splitCheck(check)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("check %q: invalid regex: %w", check, err)
+	}
+	data, err := os.ReadFile(filepath.Join(vars.WorkingDir, path))
+	if err != nil {
+		return &Failure{Reason: "file.regex", Actual: "file not found", Expected: path}, nil
+	}
+	if !re.Match(data) {
+		return &Failure{Reason: "file.regex", Actual: string(data), Expected: pattern}, nil
+	}
+	return nil, nil
+}
+
+// assertFileJSON checks a dotted path into a JSON file's contents. The
+// check string has the form "<path>#<dot.path> == <expected>".
+func assertFileJSON(check string, vars Vars) (*Failure, error) {
+	hashParts := strings.SplitN(check, "#", 2)
+	if len(hashParts) != 2 {
+		return nil, fmt.Errorf("check %q: expected \"<path>#<dot.path> == <expected>\"", check)
+	}
+	path := strings.TrimSpace(hashParts[0])
+
+	eqParts := strings.SplitN(hashParts[1], "==", 2)
+	if len(eqParts) != 2 {
+		return nil, fmt.Errorf("check %q: expected \"<dot.path> == <expected>\"", check)
+	}
+	dotPath := strings.TrimSpace(eqParts[0])
+	expected := strings.TrimSpace(eqParts[1])
+
+	data, err := os.ReadFile(filepath.Join(vars.WorkingDir, path))
+	if err != nil {
+		return &Failure{Reason: "file.json", Actual: "file not found", Expected: path}, nil
+	}
+
+	value, ok := lookupJSONPath(data, dotPath)
+	if !ok {
+		return &Failure{Reason: "file.json", Actual: "path not found", Expected: dotPath}, nil
+	}
+	if value != expected {
+		return &Failure{Reason: "file.json", Actual: value, Expected: expected}, nil
+	}
+	return nil, nil
+}
+
+// lookupJSONPath resolves a dotted path (e.g. "data.items.0.status") into
+// a JSON document, returning its value stringified and whether it was
+// found. It's a minimal stand-in for a full JSONPath/gjson implementation.
+func lookupJSONPath(data []byte, dotPath string) (string, bool) {
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "", false
+	}
+
+	cur := doc
+	for _, segment := range strings.Split(dotPath, ".") {
+		switch node := cur.(type) {
+		case map[string]any:
+			v, ok := node[segment]
+			if !ok {
+				return "", false
+			}
+			cur = v
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return "", false
+			}
+			cur = node[idx]
+		default:
+			return "", false
+		}
+	}
+
+	switch v := cur.(type) {
+	case string:
+		return v, true
+	case nil:
+		return "null", true
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+}
+
+func assertShellExitCode(check string, vars Vars) (*Failure, error) {
+	expected, err := strconv.Atoi(strings.TrimSpace(check))
+	if err != nil {
+		return nil, fmt.Errorf("check %q: expected an integer exit code: %w", check, err)
+	}
+	if vars.ExitCode != expected {
+		return &Failure{Reason: "shell.exitcode", Actual: strconv.Itoa(vars.ExitCode), Expected: check}, nil
+	}
+	return nil, nil
+}
+
+func assertShellStdoutContains(check string, vars Vars) (*Failure, error) {
+	substr := strings.TrimSpace(check)
+	if !strings.Contains(vars.Stdout, substr) {
+		return &Failure{Reason: "shell.stdout.contains", Actual: vars.Stdout, Expected: substr}, nil
+	}
+	return nil, nil
+}
+
+// assertExpr evaluates a small boolean expression of "&&"-joined
+// "key==value" / "key!=value" clauses over exit_code/stdout/stderr. It
+// intentionally supports a minimal grammar rather than a full expression
+// language; richer operators can be registered under their own check type.
+func assertExpr(check string, vars Vars) (*Failure, error) {
+	lookup := map[string]string{
+		"exit_code": strconv.Itoa(vars.ExitCode),
+		"stdout":    vars.Stdout,
+		"stderr":    vars.Stderr,
+	}
+
+	for _, clause := range strings.Split(check, "&&") {
+		clause = strings.TrimSpace(clause)
+		op := "=="
+		parts := strings.SplitN(clause, "==", 2)
+		if len(parts) != 2 {
+			parts = strings.SplitN(clause, "!=", 2)
+			op = "!="
+		}
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("clause %q: expected \"key==value\" or \"key!=value\"", clause)
+		}
+
+		key := strings.TrimSpace(parts[0])
+		want := strings.Trim(strings.TrimSpace(parts[1]), `"'`)
+		got, ok := lookup[key]
+		if !ok {
+			return nil, fmt.Errorf("clause %q: unknown variable %q", clause, key)
+		}
+
+		matched := got == want
+		if op == "!=" {
+			matched = !matched
+		}
+		if !matched {
+			return &Failure{Reason: "expr", Actual: clause, Expected: check}, nil
+		}
+	}
+	return nil, nil
+}
+
+// assertFixtureReplay runs the harness.Fixture rooted at check (the
+// fixture's directory, as populated by findFixtureCases) inside the
+// run's own working directory via harness.ExistingDir, so fixture seeding
+// and diffing reuses the same isolated context runEvaluation already
+// created rather than standing up a second one. No real AgentDriver is
+// wired in yet, so fixtures without a driver can only assert on
+// pre-seeded state; a subprocess or API-backed driver can be swapped in
+// here once one exists.
+func assertFixtureReplay(check string, vars Vars) (*Failure, error) {
+	fixture, err := harness.LoadFixture(check)
+	if err != nil {
+		return nil, fmt.Errorf("loading fixture %q: %w", check, err)
+	}
+
+	result, err := harness.RunFixture(harness.ExistingDir(vars.WorkingDir), fixture, harness.NoopAgentDriver{})
+	if err != nil {
+		return nil, fmt.Errorf("running fixture %q: %w", fixture.ID, err)
+	}
+	if !result.Passed {
+		return &Failure{
+			Reason:   "fixture.replay",
+			Actual:   strings.Join(result.Diffs, "; "),
+			Expected: fmt.Sprintf("fixture %s to reproduce with no diffs", fixture.ID),
+		}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stevestomp/yokay-evals/internal/assert"
+)
+
+func TestAssertFileExists(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "out.txt"), []byte("hi"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	vars := Vars{WorkingDir: dir}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.exists", Check: "out.txt"}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.exists", Check: "missing.txt"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure for missing file, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestAssertFileContains(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "out.txt"), []byte("hello world"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	vars := Vars{WorkingDir: dir}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.contains", Check: "out.txt :: world"}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.contains", Check: "out.txt :: nope"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure for missing substring, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestAssertFileRegex(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "out.txt"), []byte("version 1.2.3"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	vars := Vars{WorkingDir: dir}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.regex", Check: `out.txt :: \d+\.\d+\.\d+`}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.regex", Check: `out.txt :: ^nope$`}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure for non-matching regex, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestAssertFileJSON(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"data": {"status": "ok", "items": [1, 2, 3]}}`
+	if err := os.WriteFile(filepath.Join(dir, "result.json"), []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	vars := Vars{WorkingDir: dir}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.json", Check: "result.json#data.status == ok"}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.json", Check: "result.json#data.status == broken"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure on mismatched value, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "file.json", Check: "result.json#data.missing == ok"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure on missing path, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestAssertShellExitCode(t *testing.T) {
+	vars := Vars{ExitCode: 0}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "shell.exitcode", Check: "0"}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "shell.exitcode", Check: "1"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure on mismatched exit code, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestAssertShellStdoutContains(t *testing.T) {
+	vars := Vars{Stdout: "tests passed: 12"}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "shell.stdout.contains", Check: "passed"}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "shell.stdout.contains", Check: "failed"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure on missing substring, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestAssertExpr(t *testing.T) {
+	vars := Vars{ExitCode: 0, Stdout: "ok"}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "expr", Check: "exit_code==0 && stdout==ok"}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "expr", Check: "exit_code!=0"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure, got failure=%v err=%v", failure, err)
+	}
+	if _, err := EvaluateCriterion(EvalCriterion{Type: "expr", Check: "bogus<>0"}, vars); err == nil {
+		t.Error("expected error for malformed clause")
+	}
+}
+
+func TestEvaluateCriterionCodeBasedDispatchesToJudge(t *testing.T) {
+	vars := Vars{ExitCode: 0, Stdout: "build succeeded"}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "code-based", Check: "exit_code==0"}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Type: "code-based", Check: "exit_code==1"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure on mismatched exit_code, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestEvaluateCriterionModelBasedDefaultsToPass(t *testing.T) {
+	// With no YOKAY_JUDGE_PROVIDER configured, model-based criteria fall
+	// back to judge.NoopLLMClient and pass, same as before this criterion
+	// type had a real judge wired in.
+	failure, err := EvaluateCriterion(EvalCriterion{Type: "model-based", Check: "the agent explains its fix"}, Vars{})
+	if err != nil || failure != nil {
+		t.Errorf("expected pass-through, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestEvaluateCriterionBareCheckDesugarsToStdoutRegex(t *testing.T) {
+	vars := Vars{Stdout: "tests passed: 12"}
+
+	if failure, err := EvaluateCriterion(EvalCriterion{Check: `passed: \d+`}, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+	if failure, err := EvaluateCriterion(EvalCriterion{Check: "failed"}, vars); err != nil || failure == nil {
+		t.Errorf("expected failure on non-matching stdout, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestEvaluateCriterionAssertionsList(t *testing.T) {
+	vars := Vars{Stdout: "hello world", ExitCode: 0, Artifacts: map[string]string{"diff": ""}}
+
+	criterion := EvalCriterion{Assertions: []assert.Assertion{
+		{Operator: assert.ShouldContainSubstring, Selector: "result.stdout", Expected: "world"},
+		{Operator: assert.ShouldEqual, Selector: "result.exit_code", Expected: "0"},
+		{Operator: assert.ShouldBeEmpty, Selector: "result.artifacts.diff"},
+	}}
+	if failure, err := EvaluateCriterion(criterion, vars); err != nil || failure != nil {
+		t.Errorf("expected pass, got failure=%v err=%v", failure, err)
+	}
+
+	failing := EvalCriterion{Assertions: []assert.Assertion{
+		{Operator: assert.ShouldContainSubstring, Selector: "result.stdout", Expected: "bogus"},
+	}}
+	if failure, err := EvaluateCriterion(failing, vars); err != nil || failure == nil {
+		t.Errorf("expected failure, got failure=%v err=%v", failure, err)
+	}
+}
+
+func TestValidateFailureCaseRejectsUnknownOperator(t *testing.T) {
+	fc := FailureCase{
+		EvalCriteria: []EvalCriterion{
+			{Name: "typo", Assertions: []assert.Assertion{{Operator: "ShouldEqualz", Selector: "result.stdout", Expected: "x"}}},
+		},
+	}
+	if err := validateFailureCase(&fc); err == nil {
+		t.Error("expected an error for an unknown assertion operator")
+	}
+}
+
+func TestRunEvaluationWithAssertions(t *testing.T) {
+	failureCase := FailureCase{
+		ID:       "MT-100",
+		Category: "missed-tasks",
+		EvalCriteria: []EvalCriterion{
+			{Type: "file.exists", Check: "README.md"},
+		},
+	}
+
+	result, err := runEvaluation(failureCase, 2, 1)
+	if err != nil {
+		t.Fatalf("runEvaluation failed: %v", err)
+	}
+	if len(result.Runs) != 2 || len(result.Failures) != 2 {
+		t.Fatalf("expected 2 runs and 2 failure slots, got %d/%d", len(result.Runs), len(result.Failures))
+	}
+	for i, run := range result.Runs {
+		if run.Passed {
+			t.Errorf("run %d: expected failure since README.md is never seeded, got pass", i)
+		}
+		if len(result.Failures[i]) != 1 {
+			t.Errorf("run %d: expected 1 recorded failure, got %d", i, len(result.Failures[i]))
+		}
+	}
+}
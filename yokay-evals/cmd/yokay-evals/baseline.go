@@ -0,0 +1,322 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// BaselineSnapshot is a stable, versioned JSON snapshot of an
+// EvaluationResult's suite-level metrics and per-test outcomes, saved by
+// `meta baseline save` and diffed against by `meta --compare-baseline`.
+type BaselineSnapshot struct {
+	Agent       string               `json:"agent"`
+	Accuracy    float64              `json:"accuracy"`
+	Consistency float64              `json:"consistency"`
+	TotalTests  int                  `json:"totalTests"`
+	TestResults []BaselineTestResult `json:"testResults"`
+}
+
+// BaselineTestResult is one test case's snapshotted outcome: its
+// majority verdict, the runs that produced it, and the fraction of runs
+// that agreed with it (the same quantity lastRunEntry.Consistency
+// persists for --rerun).
+type BaselineTestResult struct {
+	TestID      string   `json:"testId"`
+	Expected    string   `json:"expected"`
+	Verdict     string   `json:"verdict"`
+	Runs        []string `json:"runs"`
+	Consistency float64  `json:"consistency"`
+}
+
+// buildBaselineSnapshot converts a completed EvaluationResult into the
+// shape meta baseline save persists. A --rerun-skipped case is recorded
+// using its carried-over verdict/consistency rather than Runs (which is
+// empty for a Skipped result), so snapshotting a --rerun run doesn't
+// drop entries.
+func buildBaselineSnapshot(result EvaluationResult) BaselineSnapshot {
+	metrics := calculateMetrics(result.TestResults)
+
+	tests := make([]BaselineTestResult, 0, len(result.TestResults))
+	for _, tr := range result.TestResults {
+		if tr.Skipped {
+			tests = append(tests, BaselineTestResult{
+				TestID:      tr.TestID,
+				Expected:    tr.Expected,
+				Verdict:     tr.CarriedOverVerdict,
+				Consistency: tr.CarriedOverConsistency,
+			})
+			continue
+		}
+		tests = append(tests, BaselineTestResult{
+			TestID:      tr.TestID,
+			Expected:    tr.Expected,
+			Verdict:     getMajorityVerdict(tr.Runs),
+			Runs:        tr.Runs,
+			Consistency: consistencyRatio(tr.Runs),
+		})
+	}
+
+	return BaselineSnapshot{
+		Agent:       result.Agent,
+		Accuracy:    metrics.Accuracy,
+		Consistency: metrics.Consistency,
+		TotalTests:  len(tests),
+		TestResults: tests,
+	}
+}
+
+// defaultBaselinePath is where `meta baseline save` writes a snapshot and
+// where `meta --compare-baseline` looks for one when --path isn't given:
+// one JSON file per agent under <meta-dir>/baselines/.
+func defaultBaselinePath(metaDir, agent string) string {
+	return filepath.Join(metaDir, "baselines", agent+".json")
+}
+
+// saveBaselineSnapshot writes snapshot to path as indented JSON, creating
+// path's parent directory if needed.
+func saveBaselineSnapshot(path string, snapshot BaselineSnapshot) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating baseline directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling baseline: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing baseline: %w", err)
+	}
+	return nil
+}
+
+// loadBaselineSnapshot reads a baseline snapshot previously written by
+// saveBaselineSnapshot.
+func loadBaselineSnapshot(path string) (BaselineSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return BaselineSnapshot{}, fmt.Errorf("reading baseline %s: %w", path, err)
+	}
+
+	var snapshot BaselineSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return BaselineSnapshot{}, fmt.Errorf("parsing baseline %s: %w", path, err)
+	}
+	return snapshot, nil
+}
+
+// TestRegression is one test case whose outcome regressed relative to its
+// baseline entry: either a hard regression (baseline majority-PASS, now
+// majority-FAIL) or a per-run agreement drop past --regression-tolerance
+// (ConsistencyDrop > 0 when so; 0 otherwise).
+type TestRegression struct {
+	TestID          string   `json:"testId"`
+	Expected        string   `json:"expected"`
+	BaselineVerdict string   `json:"baselineVerdict"`
+	CurrentVerdict  string   `json:"currentVerdict"`
+	BaselineRuns    []string `json:"baselineRuns"`
+	CurrentRuns     []string `json:"currentRuns"`
+	Hard            bool     `json:"hard"`
+	ConsistencyDrop float64  `json:"consistencyDrop,omitempty"`
+}
+
+// BaselineComparison is the result of diffing a current EvaluationResult
+// against a BaselineSnapshot.
+type BaselineComparison struct {
+	BaselineAgent string `json:"baselineAgent"`
+	// AccuracyDelta is baseline.Accuracy minus the current run's accuracy;
+	// positive means accuracy dropped.
+	AccuracyDelta       float64          `json:"accuracyDelta"`
+	AccuracyRegressed   bool             `json:"accuracyRegressed"`
+	RegressionTolerance float64          `json:"regressionTolerance"`
+	TestRegressions     []TestRegression `json:"testRegressions,omitempty"`
+}
+
+// Regressed is true when comparison should fail `meta --compare-baseline`:
+// a suite-level accuracy drop past tolerance, a hard per-test regression,
+// or a per-test agreement drop past tolerance.
+func (c BaselineComparison) Regressed() bool {
+	if c.AccuracyRegressed {
+		return true
+	}
+	for _, r := range c.TestRegressions {
+		if r.Hard || r.ConsistencyDrop > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// compareToBaseline diffs result's current TestResults/metrics against
+// baseline, flagging: (a) any test that was majority-PASS in baseline and
+// is now majority-FAIL (a "hard regression"), (b) a suite accuracy drop
+// of more than tolerance, and (c) any test whose per-run agreement
+// dropped below its baseline's by more than tolerance. Test cases absent
+// from baseline (new since it was saved) are never flagged.
+func compareToBaseline(result EvaluationResult, tolerance float64, baseline BaselineSnapshot) BaselineComparison {
+	metrics := calculateMetrics(result.TestResults)
+
+	baselineByID := make(map[string]BaselineTestResult, len(baseline.TestResults))
+	for _, bt := range baseline.TestResults {
+		baselineByID[bt.TestID] = bt
+	}
+
+	comparison := BaselineComparison{
+		BaselineAgent:       baseline.Agent,
+		AccuracyDelta:       baseline.Accuracy - metrics.Accuracy,
+		RegressionTolerance: tolerance,
+	}
+	comparison.AccuracyRegressed = comparison.AccuracyDelta > tolerance
+
+	for _, tr := range result.TestResults {
+		bt, ok := baselineByID[tr.TestID]
+		if !ok {
+			continue
+		}
+
+		verdict := tr.CarriedOverVerdict
+		runs := tr.Runs
+		consistency := tr.CarriedOverConsistency
+		if !tr.Skipped {
+			verdict = getMajorityVerdict(tr.Runs)
+			consistency = consistencyRatio(tr.Runs)
+		}
+
+		hard := bt.Verdict == "PASS" && verdict == "FAIL"
+
+		consistencyDrop := bt.Consistency - consistency
+		if consistencyDrop <= tolerance {
+			consistencyDrop = 0
+		}
+
+		if !hard && consistencyDrop == 0 {
+			continue
+		}
+
+		comparison.TestRegressions = append(comparison.TestRegressions, TestRegression{
+			TestID:          tr.TestID,
+			Expected:        tr.Expected,
+			BaselineVerdict: bt.Verdict,
+			CurrentVerdict:  verdict,
+			BaselineRuns:    bt.Runs,
+			CurrentRuns:     runs,
+			Hard:            hard,
+			ConsistencyDrop: consistencyDrop,
+		})
+	}
+
+	sort.Slice(comparison.TestRegressions, func(i, j int) bool {
+		return comparison.TestRegressions[i].TestID < comparison.TestRegressions[j].TestID
+	})
+
+	return comparison
+}
+
+// formatBaselineComparison renders comparison as a "Baseline Comparison"
+// section to append to a meta report's markdown output, listing each
+// regressed test's expected verdict, baseline vs. current verdict, and
+// baseline vs. current runs.
+func formatBaselineComparison(comparison BaselineComparison) string {
+	var sb strings.Builder
+
+	sb.WriteString("\nBaseline Comparison:\n")
+	sb.WriteString(fmt.Sprintf("  Accuracy: baseline - current = %+.1f%% (tolerance %.1f%%)\n",
+		comparison.AccuracyDelta*100, comparison.RegressionTolerance*100))
+	if comparison.AccuracyRegressed {
+		sb.WriteString("  WARNING: suite accuracy regressed past tolerance\n")
+	}
+
+	if len(comparison.TestRegressions) == 0 {
+		sb.WriteString("  No per-test regressions detected.\n")
+		return sb.String()
+	}
+
+	sb.WriteString("  Regressions:\n")
+	for _, r := range comparison.TestRegressions {
+		kind := "consistency drop"
+		if r.Hard {
+			kind = "hard regression"
+		}
+		sb.WriteString(fmt.Sprintf("    %s (%s): expected %s / baseline %s / current %s / baseline runs %s / current runs %s\n",
+			r.TestID, kind, r.Expected, r.BaselineVerdict, r.CurrentVerdict,
+			strings.Join(r.BaselineRuns, ","), strings.Join(r.CurrentRuns, ",")))
+	}
+
+	return sb.String()
+}
+
+// newMetaBaselineCmd builds the `meta baseline` subcommand, whose only
+// verb today is `save`.
+func newMetaBaselineCmd(metaDirFlag *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "baseline",
+		Short: "Save or inspect meta-eval baseline snapshots for regression detection",
+	}
+	cmd.AddCommand(newMetaBaselineSaveCmd(metaDirFlag))
+	return cmd
+}
+
+// newMetaBaselineSaveCmd builds `meta baseline save`, which runs a suite
+// exactly like `meta run` and serializes the result as a BaselineSnapshot
+// instead of printing a report.
+func newMetaBaselineSaveCmd(metaDirFlag *string) *cobra.Command {
+	var suite, agent, adapter, path string
+	var parallel, maxRetries int
+	var runTimeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Run a suite and save its result as a baseline for `meta --compare-baseline`",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := *metaDirFlag
+			if dir == "" {
+				dir = defaultMetaDir()
+			}
+
+			evalFiles, err := resolveEvalFiles(suite, agent, dir)
+			if err != nil {
+				return err
+			}
+			if path != "" && len(evalFiles) > 1 {
+				return fmt.Errorf("--path requires a single agent (use --agent), not a --suite with multiple agents")
+			}
+
+			state := &lastRunState{Runs: map[string]lastRunEntry{}}
+			for _, evalPath := range evalFiles {
+				result, err := runMetaEvaluation(cmd.Context(), evalPath, adapter, 0, parallel, false, runTimeout, maxRetries, nil, false, state, "", caseFilter{}, 0)
+				if err != nil {
+					return fmt.Errorf("running evaluation for %s: %w", evalPath, err)
+				}
+
+				snapshotPath := path
+				if snapshotPath == "" {
+					snapshotPath = defaultBaselinePath(dir, result.Agent)
+				}
+				if err := saveBaselineSnapshot(snapshotPath, buildBaselineSnapshot(result)); err != nil {
+					return fmt.Errorf("saving baseline for %s: %w", result.Agent, err)
+				}
+				fmt.Printf("Baseline for %s saved to: %s\n", result.Agent, snapshotPath)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&suite, "suite", "", "Suite to run: 'agents' or 'skills'")
+	cmd.Flags().StringVar(&agent, "agent", "", "Specific agent to run (e.g., 'yokay-spec-reviewer')")
+	cmd.Flags().StringVar(&adapter, "adapter", "", "Adapter to run cases with: 'stub', 'exec', or 'http' (default: eval.yaml's `adapter:`, or 'stub')")
+	cmd.Flags().StringVar(&path, "path", "", "Baseline file path (default: <meta-dir>/baselines/<agent>.json); only valid for a single agent")
+	cmd.Flags().IntVarP(&parallel, "parallel", "n", runtime.NumCPU(), "Number of test cases/k-runs to evaluate concurrently (1 for serial)")
+	cmd.Flags().DurationVar(&runTimeout, "run-timeout", 0, "Per-run timeout (e.g. '30s'); 0 disables the deadline")
+	cmd.Flags().IntVar(&maxRetries, "max-retries", 0, "Extra attempts for a run after a transient adapter error before recording it as-is")
+
+	return cmd
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildBaselineSnapshot(t *testing.T) {
+	result := EvaluationResult{
+		Agent: "yokay-test-agent",
+		TestResults: []TestResult{
+			{TestID: "T1", Expected: "PASS", Runs: []string{"PASS", "PASS", "PASS"}},
+			{TestID: "T2", Expected: "FAIL", Runs: []string{"PASS", "FAIL", "FAIL"}},
+			{
+				TestID:                 "T3",
+				Expected:               "PASS",
+				Skipped:                true,
+				CarriedOverVerdict:     "PASS",
+				CarriedOverConsistency: 1,
+			},
+		},
+	}
+
+	snapshot := buildBaselineSnapshot(result)
+
+	if snapshot.Agent != "yokay-test-agent" || snapshot.TotalTests != 3 {
+		t.Fatalf("snapshot = %+v, want agent=yokay-test-agent totalTests=3", snapshot)
+	}
+	if len(snapshot.TestResults) != 3 {
+		t.Fatalf("expected 3 test results, got %d", len(snapshot.TestResults))
+	}
+
+	if bt := snapshot.TestResults[0]; bt.Verdict != "PASS" || bt.Consistency != 1 {
+		t.Errorf("T1 = %+v, want verdict=PASS consistency=1", bt)
+	}
+	if bt := snapshot.TestResults[1]; bt.Verdict != "FAIL" {
+		t.Errorf("T2 = %+v, want majority verdict=FAIL", bt)
+	}
+	// A skipped (--rerun carried-over) case must be snapshotted from its
+	// carried-over verdict/consistency rather than dropped.
+	if bt := snapshot.TestResults[2]; bt.Verdict != "PASS" || bt.Consistency != 1 || bt.Runs != nil {
+		t.Errorf("T3 (skipped) = %+v, want carried-over verdict=PASS consistency=1 runs=nil", bt)
+	}
+}
+
+func TestSaveAndLoadBaselineSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baselines", "yokay-test-agent.json")
+	snapshot := BaselineSnapshot{
+		Agent:      "yokay-test-agent",
+		Accuracy:   0.75,
+		TotalTests: 4,
+		TestResults: []BaselineTestResult{
+			{TestID: "T1", Expected: "PASS", Verdict: "PASS", Runs: []string{"PASS", "PASS"}, Consistency: 1},
+		},
+	}
+
+	if err := saveBaselineSnapshot(path, snapshot); err != nil {
+		t.Fatalf("saveBaselineSnapshot failed: %v", err)
+	}
+
+	loaded, err := loadBaselineSnapshot(path)
+	if err != nil {
+		t.Fatalf("loadBaselineSnapshot failed: %v", err)
+	}
+	if loaded.Agent != snapshot.Agent || loaded.Accuracy != snapshot.Accuracy || len(loaded.TestResults) != 1 {
+		t.Errorf("loaded = %+v, want round-trip of %+v", loaded, snapshot)
+	}
+}
+
+func TestLoadBaselineSnapshotMissingFile(t *testing.T) {
+	if _, err := loadBaselineSnapshot(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatal("expected an error loading a nonexistent baseline file")
+	}
+}
+
+func TestCompareToBaselineHardRegression(t *testing.T) {
+	baseline := BaselineSnapshot{
+		Agent: "yokay-test-agent",
+		TestResults: []BaselineTestResult{
+			{TestID: "T1", Expected: "PASS", Verdict: "PASS", Runs: []string{"PASS", "PASS"}, Consistency: 1},
+		},
+	}
+	result := EvaluationResult{
+		TestResults: []TestResult{
+			{TestID: "T1", Expected: "PASS", Runs: []string{"FAIL", "FAIL"}},
+		},
+	}
+
+	comparison := compareToBaseline(result, 0.05, baseline)
+
+	if !comparison.Regressed() {
+		t.Fatal("expected a hard regression to be detected")
+	}
+	if len(comparison.TestRegressions) != 1 || !comparison.TestRegressions[0].Hard {
+		t.Errorf("TestRegressions = %+v, want a single hard regression for T1", comparison.TestRegressions)
+	}
+}
+
+func TestCompareToBaselineAccuracyRegression(t *testing.T) {
+	baseline := BaselineSnapshot{Agent: "yokay-test-agent", Accuracy: 1.0}
+	result := EvaluationResult{
+		TestResults: []TestResult{
+			{TestID: "T1", Expected: "PASS", Runs: []string{"FAIL"}},
+		},
+	}
+
+	comparison := compareToBaseline(result, 0.05, baseline)
+
+	if !comparison.AccuracyRegressed || !comparison.Regressed() {
+		t.Errorf("comparison = %+v, want an accuracy regression past a 0.05 tolerance", comparison)
+	}
+}
+
+func TestCompareToBaselineConsistencyDrop(t *testing.T) {
+	baseline := BaselineSnapshot{
+		Agent: "yokay-test-agent",
+		TestResults: []BaselineTestResult{
+			{TestID: "T1", Expected: "PASS", Verdict: "PASS", Runs: []string{"PASS", "PASS"}, Consistency: 1},
+		},
+	}
+	result := EvaluationResult{
+		TestResults: []TestResult{
+			{TestID: "T1", Expected: "PASS", Runs: []string{"PASS", "PASS", "FAIL"}},
+		},
+	}
+
+	comparison := compareToBaseline(result, 0.05, baseline)
+
+	if !comparison.Regressed() {
+		t.Fatal("expected a consistency-drop regression to be detected")
+	}
+	if len(comparison.TestRegressions) != 1 || comparison.TestRegressions[0].Hard {
+		t.Errorf("TestRegressions = %+v, want a single non-hard consistency-drop regression", comparison.TestRegressions)
+	}
+}
+
+func TestCompareToBaselineNoRegression(t *testing.T) {
+	baseline := BaselineSnapshot{
+		Agent:    "yokay-test-agent",
+		Accuracy: 1.0,
+		TestResults: []BaselineTestResult{
+			{TestID: "T1", Expected: "PASS", Verdict: "PASS", Runs: []string{"PASS", "PASS"}, Consistency: 1},
+		},
+	}
+	result := EvaluationResult{
+		TestResults: []TestResult{
+			{TestID: "T1", Expected: "PASS", Runs: []string{"PASS", "PASS"}},
+		},
+	}
+
+	comparison := compareToBaseline(result, 0.05, baseline)
+
+	if comparison.Regressed() || len(comparison.TestRegressions) != 0 {
+		t.Errorf("comparison = %+v, want no regressions", comparison)
+	}
+}
+
+func TestCompareToBaselineIgnoresNewTests(t *testing.T) {
+	baseline := BaselineSnapshot{Agent: "yokay-test-agent", Accuracy: 1.0}
+	result := EvaluationResult{
+		TestResults: []TestResult{
+			{TestID: "T-NEW", Expected: "PASS", Runs: []string{"FAIL", "FAIL"}},
+		},
+	}
+
+	comparison := compareToBaseline(result, 0.05, baseline)
+
+	// T-NEW isn't in the baseline, so it can't be flagged as a regression
+	// even though its own verdict disagrees with Expected.
+	if len(comparison.TestRegressions) != 0 {
+		t.Errorf("TestRegressions = %+v, want none for a test absent from the baseline", comparison.TestRegressions)
+	}
+}
+
+func TestFormatBaselineComparisonNoRegressions(t *testing.T) {
+	out := formatBaselineComparison(BaselineComparison{RegressionTolerance: 0.05})
+	if !strings.Contains(out, "No per-test regressions detected.") {
+		t.Errorf("formatBaselineComparison output = %q, want a no-regressions line", out)
+	}
+}
+
+func TestFormatBaselineComparisonWithRegression(t *testing.T) {
+	comparison := BaselineComparison{
+		AccuracyDelta:       0.2,
+		AccuracyRegressed:   true,
+		RegressionTolerance: 0.05,
+		TestRegressions: []TestRegression{
+			{TestID: "T1", Expected: "PASS", BaselineVerdict: "PASS", CurrentVerdict: "FAIL", Hard: true},
+		},
+	}
+
+	out := formatBaselineComparison(comparison)
+
+	if !strings.Contains(out, "WARNING: suite accuracy regressed past tolerance") {
+		t.Errorf("formatBaselineComparison output = %q, want an accuracy regression warning", out)
+	}
+	if !strings.Contains(out, "T1") || !strings.Contains(out, "hard regression") {
+		t.Errorf("formatBaselineComparison output = %q, want T1's hard regression listed", out)
+	}
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stevestomp/yokay-evals/internal/adapters"
+)
+
+// BenchCaseResult is one test case's timing/stability stats from `meta bench`.
+type BenchCaseResult struct {
+	TestID     string
+	Name       string
+	Iterations int
+	P50        time.Duration
+	P95        time.Duration
+	P99        time.Duration
+	// Flaky is true when at least two iterations returned different
+	// verdicts, i.e. the case isn't deterministic under repeated runs.
+	Flaky bool
+}
+
+// BenchResult is the outcome of `meta bench` against one eval.yaml.
+type BenchResult struct {
+	Agent       string
+	AdapterName string
+	Cases       []BenchCaseResult
+	Iterations  int
+	Warmup      int
+	Duration    time.Duration
+	// ThroughputPerSec is total timed runs (cases * Iterations) divided by
+	// Duration.
+	ThroughputPerSec float64
+	// FlakinessScore is the fraction of Cases that are Flaky.
+	FlakinessScore float64
+}
+
+// runBenchCommand loads evalPath and times each test case's Evaluate call
+// iterations times (after warmup untimed runs to let the adapter settle),
+// reporting per-case latency percentiles and a flakiness score: the
+// fraction of cases whose verdict changed across iterations. adapterOverride
+// and stubLatency behave like runMetaEvaluation's --adapter flag and the
+// stub adapter's --stub-latency knob (see newMetaBenchCmd).
+func runBenchCommand(evalPath, adapterOverride string, iterations, warmup int, stubLatency time.Duration) (BenchResult, error) {
+	config, err := loadEvalYAML(evalPath)
+	if err != nil {
+		return BenchResult{}, err
+	}
+
+	adapterName := config.Adapter
+	if adapterOverride != "" {
+		adapterName = adapterOverride
+	}
+	if adapterName == "" {
+		adapterName = "stub"
+	}
+
+	adapterConfig := config.AdapterConfig
+	if adapterName == "stub" && stubLatency > 0 {
+		adapterConfig = withStubLatency(adapterConfig, stubLatency)
+	}
+
+	agent, err := adapters.New(adapterName, adapterConfig)
+	if err != nil {
+		return BenchResult{}, fmt.Errorf("building adapter: %w", err)
+	}
+
+	cases := make([]adapters.Case, len(config.TestCases))
+	for i, tc := range config.TestCases {
+		cases[i] = testCaseToAdapterCase(tc)
+	}
+	if err := agent.Build(cases); err != nil {
+		return BenchResult{}, fmt.Errorf("building adapter %s: %w", adapterName, err)
+	}
+
+	result := BenchResult{Agent: config.Agent, AdapterName: adapterName, Iterations: iterations, Warmup: warmup}
+
+	var flakyCount int
+	start := time.Now()
+	for i, c := range cases {
+		for w := 0; w < warmup; w++ {
+			agent.Evaluate(context.Background(), c, 1)
+		}
+
+		latencies := make([]time.Duration, iterations)
+		var lastVerdict string
+		flaky := false
+		for it := 0; it < iterations; it++ {
+			runStart := time.Now()
+			runResults := agent.Evaluate(context.Background(), c, 1)
+			latencies[it] = time.Since(runStart)
+
+			var verdict string
+			if len(runResults) > 0 {
+				verdict = runResults[0].Verdict
+			}
+			if it > 0 && verdict != lastVerdict {
+				flaky = true
+			}
+			lastVerdict = verdict
+		}
+
+		if flaky {
+			flakyCount++
+		}
+
+		p50, p95, p99 := latencyPercentiles(latencies)
+		result.Cases = append(result.Cases, BenchCaseResult{
+			TestID:     config.TestCases[i].ID,
+			Name:       config.TestCases[i].Name,
+			Iterations: iterations,
+			P50:        p50,
+			P95:        p95,
+			P99:        p99,
+			Flaky:      flaky,
+		})
+	}
+	result.Duration = time.Since(start)
+
+	if totalRuns := len(cases) * iterations; totalRuns > 0 && result.Duration > 0 {
+		result.ThroughputPerSec = float64(totalRuns) / result.Duration.Seconds()
+	}
+	if len(cases) > 0 {
+		result.FlakinessScore = float64(flakyCount) / float64(len(cases))
+	}
+
+	return result, nil
+}
+
+// withStubLatency returns a copy of config with "delay_ms" set from
+// latency, so --stub-latency can inject artificial per-run delay into the
+// stub adapter without requiring an eval.yaml edit.
+func withStubLatency(config map[string]any, latency time.Duration) map[string]any {
+	merged := make(map[string]any, len(config)+1)
+	for k, v := range config {
+		merged[k] = v
+	}
+	merged["delay_ms"] = float64(latency.Milliseconds())
+	return merged
+}
+
+// latencyPercentiles returns the p50/p95/p99 of latencies via nearest-rank
+// on a sorted copy. latencies must be non-empty.
+func latencyPercentiles(latencies []time.Duration) (p50, p95, p99 time.Duration) {
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	percentile := func(p float64) time.Duration {
+		idx := int(p * float64(len(sorted)-1))
+		return sorted[idx]
+	}
+	return percentile(0.50), percentile(0.95), percentile(0.99)
+}
+
+// formatBenchReport formats result as a human-readable benchmark report.
+func formatBenchReport(result BenchResult) string {
+	var sb strings.Builder
+
+	sb.WriteString("Meta-Benchmark Report\n")
+	sb.WriteString("======================\n\n")
+	sb.WriteString(fmt.Sprintf("Agent: %s\n", result.Agent))
+	sb.WriteString(fmt.Sprintf("Adapter: %s\n", result.AdapterName))
+	sb.WriteString(fmt.Sprintf("Iterations: %d (warmup: %d)\n\n", result.Iterations, result.Warmup))
+
+	sb.WriteString("Cases:\n")
+	for _, c := range result.Cases {
+		stability := "stable"
+		if c.Flaky {
+			stability = "FLAKY"
+		}
+		sb.WriteString(fmt.Sprintf("  %s: p50=%s p95=%s p99=%s (%s)\n", c.TestID, c.P50, c.P95, c.P99, stability))
+	}
+
+	sb.WriteString("\nSummary:\n")
+	sb.WriteString(fmt.Sprintf("  Throughput: %.2f cases/sec\n", result.ThroughputPerSec))
+	sb.WriteString(fmt.Sprintf("  Flakiness score: %.1f%% (%d/%d cases flaky)\n",
+		result.FlakinessScore*100, flakyCaseCount(result.Cases), len(result.Cases)))
+
+	return sb.String()
+}
+
+func flakyCaseCount(cases []BenchCaseResult) int {
+	n := 0
+	for _, c := range cases {
+		if c.Flaky {
+			n++
+		}
+	}
+	return n
+}
+
+// buildBenchReportSuite converts a BenchResult into a ReportSuite so
+// --format can render it as markdown, JSON, JUnit, or TAP like `meta run`,
+// letting CI track an agent's throughput/flakiness regressions the same
+// way it tracks accuracy ones.
+func buildBenchReportSuite(result BenchResult) ReportSuite {
+	cases := make([]ReportCase, 0, len(result.Cases))
+	for _, c := range result.Cases {
+		cases = append(cases, ReportCase{
+			ID:      c.TestID,
+			Name:    c.Name,
+			Passed:  !c.Flaky,
+			Message: fmt.Sprintf("p50=%s p95=%s p99=%s", c.P50, c.P95, c.P99),
+		})
+	}
+
+	return ReportSuite{
+		Name:     result.Agent,
+		Markdown: formatBenchReport(result),
+		Cases:    cases,
+		Extra: map[string]any{
+			"agent":            result.Agent,
+			"adapter":          result.AdapterName,
+			"iterations":       result.Iterations,
+			"warmup":           result.Warmup,
+			"throughputPerSec": result.ThroughputPerSec,
+			"flakinessScore":   result.FlakinessScore,
+		},
+	}
+}
+
+// newMetaBenchCmd builds the `meta bench` subcommand. It reuses the same
+// eval.yaml loaded by `meta run` but runs each test case purely for
+// timing and stability, following the pattern of wiring one set of
+// fixtures into both a correctness test and its benchmark counterpart.
+// metaDirFlag is the `meta` parent command's --meta-dir flag variable.
+func newMetaBenchCmd(metaDirFlag *string) *cobra.Command {
+	var suite, agent, adapter, format, output string
+	var iterations, warmup int
+	var stubLatency time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "bench",
+		Short: "Benchmark an agent's adapter throughput and verdict stability",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := *metaDirFlag
+			if dir == "" {
+				dir = defaultMetaDir()
+			}
+
+			formats := strings.Split(format, ",")
+			if err := runMetaBenchCommand(suite, agent, dir, adapter, iterations, warmup, stubLatency, formats, output); err != nil {
+				return fmt.Errorf("Failed to run meta-benchmark: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&suite, "suite", "", "Suite to run: 'agents' or 'skills'")
+	cmd.Flags().StringVar(&agent, "agent", "", "Specific agent to benchmark (e.g., 'yokay-spec-reviewer')")
+	cmd.Flags().StringVar(&adapter, "adapter", "", "Adapter to run cases with: 'stub', 'exec', or 'http' (default: eval.yaml's `adapter:`, or 'stub')")
+	cmd.Flags().IntVar(&iterations, "iterations", 20, "Number of timed iterations per test case")
+	cmd.Flags().IntVar(&warmup, "warmup", 2, "Number of untimed warmup iterations per test case before measuring")
+	cmd.Flags().DurationVar(&stubLatency, "stub-latency", 0, "Artificial per-run delay for the stub adapter, so the benchmark path has something real to measure")
+	cmd.Flags().StringVar(&format, "format", "markdown", "Output format(s), comma-separated: 'markdown', 'json', 'junit', 'tap'")
+	cmd.Flags().StringVar(&output, "output", "", "Write output to this path (prefix, when --format has multiple values) instead of stdout")
+
+	return cmd
+}
+
+// runMetaBenchCommand resolves --suite/--agent to eval.yaml files (see
+// resolveEvalFiles) and benchmarks each.
+func runMetaBenchCommand(suite, agent, metaDir, adapterOverride string, iterations, warmup int, stubLatency time.Duration, formats []string, outputPrefix string) error {
+	evalFiles, err := resolveEvalFiles(suite, agent, metaDir)
+	if err != nil {
+		return err
+	}
+
+	for _, evalPath := range evalFiles {
+		fmt.Printf("\nBenchmarking: %s\n", evalPath)
+		fmt.Println(strings.Repeat("=", 60))
+
+		result, err := runBenchCommand(evalPath, adapterOverride, iterations, warmup, stubLatency)
+		if err != nil {
+			return fmt.Errorf("benchmarking %s: %w", evalPath, err)
+		}
+
+		prefix := outputPrefix
+		if prefix != "" && len(evalFiles) > 1 {
+			prefix = fmt.Sprintf("%s-%s", outputPrefix, result.Agent)
+		}
+
+		if err := writeReportOutputs(buildBenchReportSuite(result), formats, prefix); err != nil {
+			return fmt.Errorf("writing report for %s: %w", evalPath, err)
+		}
+	}
+
+	return nil
+}
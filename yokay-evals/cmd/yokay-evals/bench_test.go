@@ -0,0 +1,109 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeBenchEvalYAML(t *testing.T, dir string) string {
+	t.Helper()
+	content := `agent: yokay-test-agent
+
+test_cases:
+  - id: TST-001
+    name: "Test pass case"
+    input:
+      task_title: "Test Task"
+    expected: PASS
+`
+	path := filepath.Join(dir, "eval.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	return path
+}
+
+func TestRunBenchCommandStubAdapter(t *testing.T) {
+	evalPath := writeBenchEvalYAML(t, t.TempDir())
+
+	result, err := runBenchCommand(evalPath, "", 5, 1, time.Millisecond)
+	if err != nil {
+		t.Fatalf("runBenchCommand failed: %v", err)
+	}
+
+	if result.Agent != "yokay-test-agent" || result.AdapterName != "stub" {
+		t.Errorf("result = %+v, want agent=yokay-test-agent adapter=stub", result)
+	}
+	if len(result.Cases) != 1 {
+		t.Fatalf("expected 1 case, got %d", len(result.Cases))
+	}
+
+	c := result.Cases[0]
+	if c.TestID != "TST-001" || c.Iterations != 5 {
+		t.Errorf("case = %+v, want TestID=TST-001 Iterations=5", c)
+	}
+	if c.P50 < time.Millisecond || c.P99 < c.P50 {
+		t.Errorf("case latencies = p50=%s p95=%s p99=%s, want p50 to reflect the injected delay and p99 >= p50", c.P50, c.P95, c.P99)
+	}
+	// The stub adapter always returns the case's own Expected verdict, so
+	// repeated runs against it are never flaky.
+	if c.Flaky || result.FlakinessScore != 0 {
+		t.Errorf("expected the stub adapter to be stable, got flaky=%v score=%v", c.Flaky, result.FlakinessScore)
+	}
+	if result.ThroughputPerSec <= 0 {
+		t.Errorf("expected a positive throughput, got %v", result.ThroughputPerSec)
+	}
+}
+
+func TestLatencyPercentiles(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	p50, p95, p99 := latencyPercentiles(latencies)
+	if p50 != 50*time.Millisecond {
+		t.Errorf("p50 = %s, want 50ms", p50)
+	}
+	if p95 != 95*time.Millisecond {
+		t.Errorf("p95 = %s, want 95ms", p95)
+	}
+	if p99 != 99*time.Millisecond {
+		t.Errorf("p99 = %s, want 99ms", p99)
+	}
+}
+
+func TestWithStubLatency(t *testing.T) {
+	merged := withStubLatency(map[string]any{"other": "x"}, 50*time.Millisecond)
+	if merged["delay_ms"] != float64(50) {
+		t.Errorf("delay_ms = %v, want 50", merged["delay_ms"])
+	}
+	if merged["other"] != "x" {
+		t.Errorf("expected existing config keys to survive, got %v", merged)
+	}
+}
+
+func TestBuildBenchReportSuite(t *testing.T) {
+	result := BenchResult{
+		Agent:       "yokay-test-agent",
+		AdapterName: "stub",
+		Cases: []BenchCaseResult{
+			{TestID: "TST-001", Name: "case one", P50: time.Millisecond, Flaky: false},
+			{TestID: "TST-002", Name: "case two", P50: time.Millisecond, Flaky: true},
+		},
+		FlakinessScore: 0.5,
+	}
+
+	suite := buildBenchReportSuite(result)
+	if len(suite.Cases) != 2 {
+		t.Fatalf("expected 2 cases, got %d", len(suite.Cases))
+	}
+	if !suite.Cases[0].Passed || suite.Cases[1].Passed {
+		t.Errorf("expected Passed to mirror !Flaky, got %+v", suite.Cases)
+	}
+	if suite.Extra["flakinessScore"] != 0.5 {
+		t.Errorf("expected flakinessScore extra field, got %v", suite.Extra["flakinessScore"])
+	}
+}
@@ -16,11 +16,52 @@ type CommandFrontmatter struct {
 	Skill        string `yaml:"skill,omitempty"`
 }
 
+// getGitRoot returns the root of the git repository yokay-evals is
+// checked out in, found by walking up from the working directory until a
+// .git entry turns up. These tests read plugins/yokay-evals/commands/
+// relative to that root, which only exists in a full pokayokay checkout
+// (not this module's own history) — see commandsDirOrSkip.
+func getGitRoot(t *testing.T) string {
+	t.Helper()
+
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("os.Getwd: %v", err)
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			t.Fatalf("could not find a .git directory above %s", dir)
+		}
+		dir = parent
+	}
+}
+
+// commandsDirOrSkip returns gitRoot's plugins/yokay-evals/commands
+// directory, skipping the test when it doesn't exist. yokay-evals is
+// developed as a subtree of the larger pokayokay monorepo, where that
+// directory holds the command definition files these tests check; a
+// checkout of just this module (e.g. this repository) has no pokayokay
+// plugin layout to check against.
+func commandsDirOrSkip(t *testing.T, gitRoot string) string {
+	t.Helper()
+
+	commandsDir := filepath.Join(gitRoot, "plugins/yokay-evals/commands")
+	if _, err := os.Stat(commandsDir); os.IsNotExist(err) {
+		t.Skipf("plugins/yokay-evals/commands not found under %s; skipping outside a pokayokay monorepo checkout", gitRoot)
+	}
+	return commandsDir
+}
+
 // TestYokayEvalsCommandFiles verifies that all yokay-evals command definition files exist
 // and have valid YAML frontmatter
 func TestYokayEvalsCommandFiles(t *testing.T) {
 	gitRoot := getGitRoot(t)
-	commandsDir := filepath.Join(gitRoot, "plugins/yokay-evals/commands")
+	commandsDir := commandsDirOrSkip(t, gitRoot)
 
 	expectedCommands := []struct {
 		name        string
@@ -122,7 +163,7 @@ func TestYokayEvalsCommandFiles(t *testing.T) {
 // TestCommandDescriptions verifies each command has appropriate description length
 func TestCommandDescriptions(t *testing.T) {
 	gitRoot := getGitRoot(t)
-	commandsDir := filepath.Join(gitRoot, "plugins/yokay-evals/commands")
+	commandsDir := commandsDirOrSkip(t, gitRoot)
 
 	commandFiles := []string{"grade.md", "eval.md", "report.md"}
 
@@ -160,7 +201,7 @@ func TestCommandDescriptions(t *testing.T) {
 // TestCommandMarkdownStructure verifies command files follow expected structure
 func TestCommandMarkdownStructure(t *testing.T) {
 	gitRoot := getGitRoot(t)
-	commandsDir := filepath.Join(gitRoot, "plugins/yokay-evals/commands")
+	commandsDir := commandsDirOrSkip(t, gitRoot)
 
 	commandFiles := []string{"grade.md", "eval.md", "report.md"}
 
@@ -227,7 +268,7 @@ func TestCommandMarkdownStructure(t *testing.T) {
 // TestCommandExamplesIncludeActualPaths verifies examples use realistic paths
 func TestCommandExamplesIncludeActualPaths(t *testing.T) {
 	gitRoot := getGitRoot(t)
-	commandsDir := filepath.Join(gitRoot, "plugins/yokay-evals/commands")
+	commandsDir := commandsDirOrSkip(t, gitRoot)
 
 	testCases := []struct {
 		file         string
@@ -1,17 +1,127 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/spf13/cobra"
+	"github.com/stevestomp/yokay-evals/internal/assert"
+	"github.com/stevestomp/yokay-evals/internal/graders/plugin"
 	"github.com/stevestomp/yokay-evals/internal/harness"
+	"github.com/stevestomp/yokay-evals/internal/metrics"
 	"gopkg.in/yaml.v3"
 )
 
+// newEvalCmd builds the `eval` subcommand, which runs the eval suite
+// against documented failure cases.
+func newEvalCmd() *cobra.Command {
+	var failuresDir, category, format, graderDir, candidateDir string
+	var k, parallel int
+	var seed int64
+	var failUnder float64
+
+	cmd := &cobra.Command{
+		Use:   "eval",
+		Short: "Run eval suite against failure cases",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := failuresDir
+			if dir == "" {
+				dir = defaultFailuresDir()
+			}
+			gdir := graderDir
+			if gdir == "" {
+				gdir = defaultYokayEvalsSubdir("graders")
+			}
+
+			if err := runEvalCommand(dir, category, k, format, seed, failUnder, gdir, candidateDir, parallel); err != nil {
+				return fmt.Errorf("Failed to run eval command: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&failuresDir, "failures-dir", "", "Path to failures directory (default: yokay-evals/failures)")
+	cmd.Flags().StringVar(&category, "category", "", "Filter to specific category (e.g., 'missing-tests')")
+	cmd.Flags().IntVar(&k, "k", 1, "Number of evaluation runs (default: 1)")
+	cmd.Flags().StringVar(&format, "format", "table", "Output format: 'table', 'json', 'rubric', 'junit', or 'sarif'")
+	cmd.Flags().Int64Var(&seed, "seed", 1, "Seed for reproducible per-run randomness in the harness")
+	cmd.Flags().Float64Var(&failUnder, "fail-under", -1, "Exit non-zero if the lowest category's 95% CI lower bound falls below this (disabled by default)")
+	cmd.Flags().StringVar(&graderDir, "grader-dir", "", "Directory to discover grader-* plugin executables in (default: yokay-evals/graders/)")
+	cmd.Flags().StringVar(&candidateDir, "candidate-dir", "", "Optional directory of agent-produced artifacts to materialize into each run's working directory for non-clause 'code-based' criteria")
+	cmd.Flags().IntVar(&parallel, "parallel", 1, "Number of a case's k runs to execute concurrently (1 for serial)")
+
+	return cmd
+}
+
+// defaultFailuresDir resolves the failures directory relative to the
+// current working directory when --failures-dir is not given.
+func defaultFailuresDir() string {
+	return defaultYokayEvalsSubdir("failures")
+}
+
+// defaultYokayEvalsSubdir resolves sub relative to the yokay-evals
+// directory found in the current working directory, walking up to the
+// pokayokay checkout root if yokay-evals itself isn't in cwd. Falls back
+// to the bare subdirectory name so callers still get something sensible
+// when run from an unrelated directory (e.g. under `go test`).
+func defaultYokayEvalsSubdir(sub string) string {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return sub
+	}
+
+	if strings.Contains(cwd, "yokay-evals") {
+		parts := strings.Split(cwd, "yokay-evals")
+		if len(parts) > 0 {
+			return filepath.Join(parts[0]+"yokay-evals", sub)
+		}
+	} else if strings.Contains(cwd, "pokayokay") {
+		parts := strings.Split(cwd, "pokayokay")
+		if len(parts) > 0 {
+			return filepath.Join(parts[0]+"pokayokay", "yokay-evals", sub)
+		}
+	}
+
+	return sub
+}
+
+// wilsonZ95 is the two-sided z-score for a 95% confidence level.
+const wilsonZ95 = 1.96
+
+// evalRand is the shared source of per-run randomness for the eval
+// command, seeded via --seed so runs are reproducible.
+var evalRand = rand.New(rand.NewSource(1))
+
+// seedEval reseeds evalRand. Call it once per runEvalCommand invocation
+// before any randomness-dependent work (e.g. future harness sampling)
+// runs.
+func seedEval(seed int64) {
+	evalRand = rand.New(rand.NewSource(seed))
+}
+
+// evalGraders holds the grader plugins discovered for the current
+// runEvalCommand invocation (see internal/graders/plugin). It's nil in
+// direct runEvaluation calls, e.g. from tests, which is equivalent to
+// discovering zero plugins.
+var evalGraders []*plugin.Grader
+
+// evalCandidateDir is the --candidate-dir given to the current
+// runEvalCommand invocation (see runEvaluation), mirroring evalGraders'
+// pattern of a package-level var set once per command rather than
+// threading an extra parameter through every runEvaluation call site
+// (serve.go's also calls runEvaluation directly, with no candidate
+// directory of its own). Empty by default, meaning no candidate tree is
+// materialized for non-clause "code-based" criteria.
+var evalCandidateDir string
+
 // FailureCase represents a documented agent failure case
 type FailureCase struct {
 	ID           string          `yaml:"id"`
@@ -22,6 +132,16 @@ type FailureCase struct {
 	Failure      FailureDetails  `yaml:"failure"`
 	Evidence     FailureEvidence `yaml:"evidence"`
 	EvalCriteria []EvalCriterion `yaml:"eval_criteria"`
+	// Rubric, when set, enables weighted scoring of EvalCriteria instead
+	// of a flat pass/fail vote. It borrows the weighted-criteria pattern
+	// from the skill-clarity reports (see report.go's CriteriaScore) so
+	// both subsystems share a single scoring model.
+	Rubric *Rubric `yaml:"rubric,omitempty"`
+}
+
+// Rubric configures weighted scoring for a FailureCase's EvalCriteria.
+type Rubric struct {
+	PassingScore float64 `yaml:"passing_score"`
 }
 
 // FailureContext contains context about where/when the failure occurred
@@ -46,13 +166,134 @@ type FailureEvidence struct {
 type EvalCriterion struct {
 	Type  string `yaml:"type"`
 	Check string `yaml:"check"`
+	// Name identifies the criterion for rubric scoring (see Rubric); it
+	// falls back to Type when empty so existing cases need no changes.
+	Name string `yaml:"name,omitempty"`
+	// Weight is the criterion's contribution to a rubric-scored run.
+	// Unweighted criteria (the common case) default to 1.
+	Weight int `yaml:"weight,omitempty"`
+	// Threshold is the minimum score (0-1) this criterion must clear on
+	// its own for the run to be considered passing, independent of the
+	// overall weighted score. Zero means no per-criterion threshold.
+	Threshold float64 `yaml:"threshold,omitempty"`
+	// Assertions, when set, evaluates the criterion through the rich
+	// internal/assert DSL instead of the legacy Type/Check dispatch (see
+	// EvaluateCriterion). A bare Check with no Type remains supported as a
+	// shorthand for a single ShouldMatchRegex assertion against
+	// result.stdout.
+	Assertions []assert.Assertion `yaml:"assertions,omitempty"`
+}
+
+// criterionName returns c.Name, falling back to c.Type when unset.
+func (c EvalCriterion) criterionName() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return c.Type
+}
+
+// criterionWeight returns c.Weight, defaulting to 1 for unweighted
+// criteria.
+func (c EvalCriterion) criterionWeight() int {
+	if c.Weight <= 0 {
+		return 1
+	}
+	return c.Weight
+}
+
+// Score is a rubric-weighted score for a single evaluation run.
+type Score struct {
+	Raw      float64 // fraction of criteria that passed, unweighted
+	Weighted float64 // fraction of weight earned
+	Max      float64 // total weight available (for reference/debugging)
+}
+
+// RunOutcome is a single run's outcome within an EvalResult. It replaces
+// a bare pass/fail bool so concurrent runs (see runEvaluation) can each
+// report their own timing and, if the run itself blew up (e.g. couldn't
+// create its isolated context), the error that aborted it - without
+// that one run taking down every other run's result.
+type RunOutcome struct {
+	Passed     bool
+	DurationMS int64
+	// Err holds the error that aborted this run before it could finish
+	// evaluating every criterion, if any. Empty means the run ran to
+	// completion (whether or not it passed).
+	Err string
 }
 
 // EvalResult represents the result of evaluating a failure case
 type EvalResult struct {
 	CaseID   string
 	Category string
-	Runs     []bool // Each run's pass/fail status
+	// Severity, Description, RootCause, and TaskSpec are copied from the
+	// owning FailureCase so report builders (see buildEvalReportSuite)
+	// don't need the original FailureCase slice alongside results.
+	Severity    string
+	Description string
+	RootCause   string
+	TaskSpec    string
+	Runs        []RunOutcome
+	// Failures holds the assertion failures for each run, in parallel with
+	// Runs. A nil entry means the run passed every criterion.
+	Failures [][]*Failure
+	// Scores holds the rubric-weighted score for each run, in parallel
+	// with Runs. Populated regardless of whether FailureCase.Rubric is
+	// set, so callers can opt into weighted scoring without re-running.
+	Scores []Score
+	// CriterionNames and CriterionPassed record, per run, which named
+	// criterion passed or failed, so aggregation can report a
+	// per-criterion breakdown (CategoryMetrics.PerCriterion).
+	CriterionNames  []string
+	CriterionPassed [][]bool
+	// GraderResults holds the verdict from each grader plugin (see
+	// internal/graders/plugin) that supports the "eval-run" kind, in
+	// parallel with Runs. Unlike Failures, a failing grader doesn't flip
+	// Runs[i]: plugin graders assess whole-artifact quality (tone,
+	// security, accessibility, ...) alongside, not instead of, the
+	// FailureCase's own pass/fail criteria.
+	GraderResults [][]GraderResult
+	// TreeDeltas holds, per run, every file the run added, removed, or
+	// modified in its isolated working directory (see
+	// harness.IsolatedContext.DiffTree), as an implicit signal alongside
+	// the explicit pass/fail criteria - e.g. a run that "passes" but left
+	// behind an unexpected file is worth surfacing even though no
+	// criterion caught it.
+	TreeDeltas [][]harness.InodeDelta
+}
+
+// GraderResult is one grader plugin's verdict on a single eval run.
+type GraderResult struct {
+	Grader  string
+	Passed  bool
+	Score   float64
+	Message string
+}
+
+// scoreRun computes the rubric-weighted Score for a single run, given its
+// criteria and a parallel slice recording whether each criterion passed.
+func scoreRun(criteria []EvalCriterion, passed []bool) Score {
+	var totalWeight, earnedWeight float64
+	var totalRaw, passedRaw float64
+	for i, c := range criteria {
+		weight := float64(c.criterionWeight())
+		totalWeight += weight
+		totalRaw++
+
+		if i < len(passed) && passed[i] {
+			earnedWeight += weight
+			passedRaw++
+		}
+	}
+
+	score := Score{Max: totalWeight}
+	if totalRaw > 0 {
+		score.Raw = passedRaw / totalRaw
+	}
+	if totalWeight > 0 {
+		score.Weighted = earnedWeight / totalWeight
+	}
+	return score
 }
 
 // CategoryMetrics represents evaluation metrics for a category
@@ -61,6 +302,29 @@ type CategoryMetrics struct {
 	Pass     int
 	Fail     int
 	PassRate float64
+
+	// PassAt1 is the mean per-run pass rate across all cases in the
+	// category (total successful runs / total runs).
+	PassAt1 float64
+	// PassAtKHalf is the fraction of cases with at least one passing run
+	// among the first half of their k runs.
+	PassAtKHalf float64
+	// PassAtK is the fraction of cases with at least one passing run
+	// across all k runs (the pass@k capability measure).
+	PassAtK float64
+
+	// CILow and CIHigh are the bounds of the 95% Wilson score confidence
+	// interval for PassAt1, computed over every individual run in the
+	// category (n = total_cases * k).
+	CILow  float64
+	CIHigh float64
+
+	// AverageScore is the mean rubric-weighted score (0-100) across every
+	// run in the category. PerCriterion breaks that average down by
+	// criterion name, in the same shape as report.go's CriteriaScore so
+	// the two reporting pipelines can share a rendering path.
+	AverageScore float64
+	PerCriterion map[string]float64
 }
 
 // loadFailureCase loads and parses a failure case from a YAML file
@@ -75,9 +339,28 @@ func loadFailureCase(path string) (*FailureCase, error) {
 		return nil, fmt.Errorf("parsing failure case: %w", err)
 	}
 
+	if err := validateFailureCase(&failureCase); err != nil {
+		return nil, fmt.Errorf("validating failure case %s: %w", path, err)
+	}
+
 	return &failureCase, nil
 }
 
+// validateFailureCase checks every criterion's Assertions against
+// assert.ValidateOperator, so a typo'd operator name in eval_criteria
+// fails the YAML load with a helpful error instead of silently never
+// matching at eval time.
+func validateFailureCase(failureCase *FailureCase) error {
+	for _, c := range failureCase.EvalCriteria {
+		for _, a := range c.Assertions {
+			if err := assert.ValidateOperator(a.Operator); err != nil {
+				return fmt.Errorf("criterion %q: %w", c.criterionName(), err)
+			}
+		}
+	}
+	return nil
+}
+
 // findFailureCases finds all failure case YAML files in the failures directory
 // If category is not empty, only returns cases matching that category
 func findFailureCases(failuresDir string, category string) ([]FailureCase, error) {
@@ -89,6 +372,14 @@ func findFailureCases(failuresDir string, category string) ([]FailureCase, error
 			return err
 		}
 
+		// The fixtures/ subtree is handled separately below by
+		// findFixtureCases, which knows how to turn a harness.Fixture
+		// directory into a FailureCase; walking into it here would load
+		// fixture.yaml a second time as if it were a hand-written case.
+		if info.IsDir() && info.Name() == "fixtures" {
+			return filepath.SkipDir
+		}
+
 		// Skip directories and non-YAML files
 		if info.IsDir() || !strings.HasSuffix(info.Name(), ".yaml") {
 			return nil
@@ -119,78 +410,458 @@ func findFailureCases(failuresDir string, category string) ([]FailureCase, error
 		return nil, err
 	}
 
+	fixtureCases, err := findFixtureCases(failuresDir, category)
+	if err != nil {
+		return nil, err
+	}
+	cases = append(cases, fixtureCases...)
+
+	return cases, nil
+}
+
+// findFixtureCases walks failuresDir/fixtures and converts each
+// harness.Fixture directory into a FailureCase carrying a single
+// "fixture.replay" criterion, so YAML fixture-driven replay cases flow
+// through the same eval loop (and pass@k/rubric reporting) as hand-written
+// FailureCases. If category is not empty, only fixtures in that category
+// are returned.
+func findFixtureCases(failuresDir string, category string) ([]FailureCase, error) {
+	fixtures, err := harness.FindFixtures(filepath.Join(failuresDir, "fixtures"))
+	if err != nil {
+		return nil, fmt.Errorf("finding fixtures: %w", err)
+	}
+
+	var cases []FailureCase
+	for _, fixture := range fixtures {
+		if category != "" && fixture.Category != category {
+			continue
+		}
+
+		cases = append(cases, FailureCase{
+			ID:       fixture.ID,
+			Category: fixture.Category,
+			Context:  FailureContext{Task: fixture.Inputs.Task},
+			EvalCriteria: []EvalCriterion{
+				{Type: "fixture.replay", Name: fixture.ID, Check: fixture.Dir},
+			},
+		})
+	}
+
 	return cases, nil
 }
 
-// runEvaluation runs evaluation on a failure case k times
-// Each run is executed in an isolated context with its own temp directory
-// For now, this is stubbed to always return pass
-func runEvaluation(failureCase FailureCase, k int) (EvalResult, error) {
+// runEvaluation runs evaluation on a failure case k times, up to parallel
+// runs at once (parallel < 1 is treated as 1, i.e. serial). Each run
+// executes in its own harness.IsolatedContext, so concurrent runs cannot
+// interfere with each other's working directory, and writes its result
+// into result's per-run slices at its own index - the same
+// completion-order-independent pattern runParallel (meta.go) uses for
+// meta eval's worker pool. Every criterion in failureCase.EvalCriteria is
+// dispatched through the assertion registry (see assertions.go); the
+// "code-based" and "model-based" types are instead routed to
+// internal/judge.
+func runEvaluation(failureCase FailureCase, k int, parallel int) (EvalResult, error) {
+	if parallel < 1 {
+		parallel = 1
+	}
+
 	result := EvalResult{
-		CaseID:   failureCase.ID,
-		Category: failureCase.Category,
-		Runs:     make([]bool, k),
+		CaseID:          failureCase.ID,
+		Category:        failureCase.Category,
+		Severity:        failureCase.Severity,
+		Description:     failureCase.Failure.Description,
+		RootCause:       failureCase.Failure.RootCause,
+		TaskSpec:        failureCase.Evidence.TaskSpec,
+		Runs:            make([]RunOutcome, k),
+		Failures:        make([][]*Failure, k),
+		Scores:          make([]Score, k),
+		CriterionPassed: make([][]bool, k),
+		TreeDeltas:      make([][]harness.InodeDelta, k),
+	}
+	for _, c := range failureCase.EvalCriteria {
+		result.CriterionNames = append(result.CriterionNames, c.criterionName())
 	}
 
-	// Run evaluation k times, each in its own isolated context
+	var graderResults [][]GraderResult
+	var graderMu sync.Mutex
+
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
 	for i := 0; i < k; i++ {
-		ctx, err := harness.NewIsolatedContext()
-		if err != nil {
-			return result, fmt.Errorf("creating isolated context for run %d: %w", i+1, err)
-		}
-		defer ctx.Cleanup()
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			start := time.Now()
+			seed := seedForRun(failureCase.ID, "eval", i, 0)
+			failures, score, passed, deltas, graders, err := runSingleEval(failureCase, seed)
+
+			outcome := RunOutcome{DurationMS: time.Since(start).Milliseconds()}
+			if err != nil {
+				outcome.Err = err.Error()
+			} else {
+				outcome.Passed = len(failures) == 0
+			}
+			result.Runs[i] = outcome
+			result.Failures[i] = failures
+			result.Scores[i] = score
+			result.CriterionPassed[i] = passed
+			result.TreeDeltas[i] = deltas
 
-		// TODO: Execute eval_criteria in ctx.WorkingDir()
-		// For now, still stubbed to always pass
-		result.Runs[i] = true
+			if graders != nil {
+				graderMu.Lock()
+				if graderResults == nil {
+					graderResults = make([][]GraderResult, k)
+				}
+				graderResults[i] = graders
+				graderMu.Unlock()
+			}
+		}(i)
 	}
+	wg.Wait()
+	result.GraderResults = graderResults
 
 	return result, nil
 }
 
-// calculateEvalMetrics calculates metrics by category from eval results
+// runSingleEval executes one run of failureCase in a fresh isolated
+// context: it seeds/overlays nothing itself (criteria adapters do that),
+// evaluates every criterion, and snapshots the working directory tree
+// before and after so callers get the same before/after delta runEvaluation
+// used to compute inline. seed is forwarded to runEvalGraders so a
+// model-based grader can reproduce a specific run's sampling.
+func runSingleEval(failureCase FailureCase, seed int64) (failures []*Failure, score Score, passed []bool, deltas []harness.InodeDelta, graders []GraderResult, err error) {
+	ctx, err := harness.NewIsolatedContext()
+	if err != nil {
+		return nil, Score{}, nil, nil, nil, fmt.Errorf("creating isolated context: %w", err)
+	}
+	defer ctx.Cleanup()
+
+	beforeTree, err := ctx.SnapshotTree()
+	if err != nil {
+		return nil, Score{}, nil, nil, nil, fmt.Errorf("snapshotting working directory tree: %w", err)
+	}
+
+	vars := Vars{
+		WorkingDir:   ctx.WorkingDir(),
+		Task:         failureCase.Context.Task,
+		RootCause:    failureCase.Failure.RootCause,
+		WhatWasBuilt: failureCase.Evidence.WhatWasBuilt,
+		CandidateDir: evalCandidateDir,
+	}
+
+	passed = make([]bool, len(failureCase.EvalCriteria))
+	for ci, criterion := range failureCase.EvalCriteria {
+		failure, evalErr := EvaluateCriterion(criterion, vars)
+		if evalErr != nil {
+			return nil, Score{}, nil, nil, nil, fmt.Errorf("evaluating criterion %q: %w", criterion.Type, evalErr)
+		}
+		if failure != nil {
+			failure.CriterionIndex = ci
+			failures = append(failures, failure)
+		} else {
+			passed[ci] = true
+		}
+	}
+
+	score = scoreRun(failureCase.EvalCriteria, passed)
+
+	deltas, err = ctx.DiffTree(beforeTree)
+	if err != nil {
+		return failures, score, passed, nil, nil, fmt.Errorf("diffing working directory tree: %w", err)
+	}
+
+	graders = runEvalGraders(failureCase, vars, seed)
+
+	return failures, score, passed, deltas, graders, nil
+}
+
+// runsPassed extracts each RunOutcome's Passed bool, for callers (pass@k
+// tallies, majority vote) that only care about the outcome and not
+// timing/error detail.
+func runsPassed(runs []RunOutcome) []bool {
+	passed := make([]bool, len(runs))
+	for i, r := range runs {
+		passed[i] = r.Passed
+	}
+	return passed
+}
+
+// runEvalGraders runs every discovered grader plugin that declared
+// support for the "eval-run" kind against this run's stdout, returning
+// nil if no graders matched so callers can skip allocating
+// EvalResult.GraderResults for the common case of no plugins installed.
+// seed is exposed to graders as GradeRequest.Context["seed"] so a
+// model-based grader can reproduce a specific run's sampling instead of
+// re-rolling it on every re-grade.
+func runEvalGraders(failureCase FailureCase, vars Vars, seed int64) []GraderResult {
+	var results []GraderResult
+	for _, g := range evalGraders {
+		if !g.SupportsKind("eval-run") {
+			continue
+		}
+		resp, err := g.Grade(context.Background(), plugin.GradeRequest{
+			Content: vars.Stdout,
+			Context: map[string]any{"case_id": failureCase.ID, "seed": seed},
+			Kind:    "eval-run",
+		})
+		if err != nil {
+			results = append(results, GraderResult{Grader: g.Describe.Name, Message: fmt.Sprintf("grader error: %v", err)})
+			continue
+		}
+		results = append(results, GraderResult{Grader: g.Describe.Name, Passed: resp.Passed, Score: resp.Score, Message: resp.Message})
+	}
+	return results
+}
+
+// calculateEvalMetrics calculates metrics by category from eval results.
+// In addition to the majority-vote pass/fail point estimate it reports
+// pass@k-style statistics (pass@1, pass@k/2, pass@k) and a 95% Wilson
+// score confidence interval around pass@1, computed over every individual
+// run in the category rather than the collapsed per-case vote.
 func calculateEvalMetrics(results []EvalResult) map[string]CategoryMetrics {
-	metrics := make(map[string]CategoryMetrics)
+	catMetrics := make(map[string]CategoryMetrics)
+	totalRuns := make(map[string]int)
+	passingRuns := make(map[string]int)
+	halfKPasses := make(map[string]int)
+	kPasses := make(map[string]int)
+	scoreSum := make(map[string]float64)
+	scoreCount := make(map[string]int)
+	criterionSum := make(map[string]map[string]float64)
+	criterionCount := make(map[string]map[string]int)
 
 	for _, result := range results {
 		cat := result.Category
-		m := metrics[cat]
+		m := catMetrics[cat]
 		m.Total++
 
 		// Determine pass/fail based on majority vote
+		passedRuns := runsPassed(result.Runs)
 		passCount := 0
-		for _, run := range result.Runs {
-			if run {
+		for _, passed := range passedRuns {
+			if passed {
 				passCount++
 			}
 		}
-
-		if passCount > len(result.Runs)/2 {
+		if passCount > len(passedRuns)/2 {
 			m.Pass++
 		} else {
 			m.Fail++
 		}
+		catMetrics[cat] = m
+
+		totalRuns[cat] += len(passedRuns)
+		passingRuns[cat] += passCount
+
+		half := (len(passedRuns) + 1) / 2
+		if half > 0 && metrics.PassAtK(passedRuns[:half]) {
+			halfKPasses[cat]++
+		}
+		if metrics.PassAtK(passedRuns) {
+			kPasses[cat]++
+		}
+
+		for _, s := range result.Scores {
+			scoreSum[cat] += s.Weighted * 100
+			scoreCount[cat]++
+		}
 
-		metrics[cat] = m
+		if criterionSum[cat] == nil {
+			criterionSum[cat] = make(map[string]float64)
+			criterionCount[cat] = make(map[string]int)
+		}
+		for _, passedForRun := range result.CriterionPassed {
+			for ci, name := range result.CriterionNames {
+				if ci >= len(passedForRun) {
+					continue
+				}
+				if passedForRun[ci] {
+					criterionSum[cat][name] += 100
+				}
+				criterionCount[cat][name]++
+			}
+		}
 	}
 
-	// Calculate pass rates
-	for cat, m := range metrics {
+	// Calculate pass rates and pass@k-style statistics
+	for cat, m := range catMetrics {
 		if m.Total > 0 {
 			m.PassRate = float64(m.Pass) / float64(m.Total) * 100
+			m.PassAtKHalf = float64(halfKPasses[cat]) / float64(m.Total)
+			m.PassAtK = float64(kPasses[cat]) / float64(m.Total)
+		}
+		if totalRuns[cat] > 0 {
+			m.PassAt1 = float64(passingRuns[cat]) / float64(totalRuns[cat])
+		}
+		m.CILow, m.CIHigh = metrics.WilsonInterval(passingRuns[cat], totalRuns[cat], wilsonZ95)
+
+		if scoreCount[cat] > 0 {
+			m.AverageScore = scoreSum[cat] / float64(scoreCount[cat])
+		}
+		if len(criterionSum[cat]) > 0 {
+			m.PerCriterion = make(map[string]float64, len(criterionSum[cat]))
+			for name, sum := range criterionSum[cat] {
+				m.PerCriterion[name] = sum / float64(criterionCount[cat][name])
+			}
 		}
-		metrics[cat] = m
+
+		catMetrics[cat] = m
 	}
 
-	return metrics
+	return catMetrics
+}
+
+// lowestCategoryCILow returns the smallest Wilson CI lower bound across
+// all categories, used to implement --fail-under gating.
+func lowestCategoryCILow(byCategory map[string]CategoryMetrics) float64 {
+	lowest := 1.0
+	for _, m := range byCategory {
+		if m.CILow < lowest {
+			lowest = m.CILow
+		}
+	}
+	return lowest
 }
 
-// formatEvalSummary formats evaluation results into a summary table or JSON
+// formatEvalSummary formats evaluation results into a summary table or one
+// of the structured formats (json, rubric, junit, sarif). junit/sarif
+// errors are folded into the returned string (consistent with the
+// existing formats here, which have no error return) rather than
+// threading an error up through runEvalCommand.
 func formatEvalSummary(results []EvalResult, format string) string {
-	if format == "json" {
+	switch format {
+	case "json":
 		return formatEvalSummaryJSON(results)
+	case "rubric":
+		return formatReportSummaryMarkdown(rubricReportFromEvalMetrics(calculateEvalMetrics(results)))
+	case "junit", "sarif":
+		rendered, err := renderReportSuite(buildEvalReportSuite(results), format)
+		if err != nil {
+			return fmt.Sprintf("error rendering %s: %v", format, err)
+		}
+		return rendered
+	default:
+		return formatEvalSummaryTable(results)
+	}
+}
+
+// severityToSarifScore maps a FailureCase.Severity string onto the 0-100
+// band the shared formats.sarifRenderer already uses to pick a SARIF
+// level (see sarifLevel in internal/report/formats), so an eval case's
+// SARIF level reflects its documented severity instead of a score eval
+// results don't otherwise produce: "high" (and any unrecognized value)
+// bands as "error", "medium" as "warning", "low" as "note".
+func severityToSarifScore(severity string) float64 {
+	switch severity {
+	case "low":
+		return 90
+	case "medium":
+		return 60
+	default:
+		return 0
+	}
+}
+
+// buildEvalReportSuite converts results into a ReportSuite, one case per
+// FailureCase grouped by Category, so --format can render JUnit (one
+// <testsuite> per category, one <testcase> per failure case, with a
+// <failure> carrying FailureDetails.Description and RootCause when the
+// case didn't pass) and SARIF (one result per failing case, ruleId =
+// CaseID, level derived from Severity, message = Evidence.TaskSpec) via
+// the shared formats registry. A case's Passed is the majority vote
+// across its k runs, mirroring calculateEvalMetrics; Message always
+// carries the per-run pass count and average duration so CI output
+// doesn't need a second pass parsing the JSON format for timing.
+func buildEvalReportSuite(results []EvalResult) ReportSuite {
+	var cases []ReportCase
+	for _, r := range results {
+		passedRuns := runsPassed(r.Runs)
+		passCount := 0
+		var totalMS int64
+		for i, p := range passedRuns {
+			if p {
+				passCount++
+			}
+			totalMS += r.Runs[i].DurationMS
+		}
+		passed := len(passedRuns) > 0 && passCount > len(passedRuns)/2
+		var avgMS int64
+		if len(passedRuns) > 0 {
+			avgMS = totalMS / int64(len(passedRuns))
+		}
+
+		// Message carries Evidence.TaskSpec plus timing/pass-count detail:
+		// it becomes the SARIF result's message.text and the JUnit
+		// <system-out>. A failing case's <failure> element instead comes
+		// from Failures below, so JUnit surfaces Description/RootCause
+		// without losing TaskSpec from the other formats.
+		message := fmt.Sprintf("%s [%d/%d runs passed, avg %dms]", r.TaskSpec, passCount, len(passedRuns), avgMS)
+
+		var failures []ReportCaseFailure
+		if !passed {
+			failures = []ReportCaseFailure{{
+				Message: fmt.Sprintf("%s (root cause: %s)", r.Description, r.RootCause),
+			}}
+		}
+
+		cases = append(cases, ReportCase{
+			ID:       r.CaseID,
+			Name:     r.CaseID,
+			Group:    r.Category,
+			Passed:   passed,
+			Score:    severityToSarifScore(r.Severity),
+			Message:  message,
+			Failures: failures,
+		})
+	}
+
+	return ReportSuite{Name: "eval", Cases: cases}
+}
+
+// rubricReportFromEvalMetrics folds rubric-weighted eval metrics into a
+// GradeReport so formatReportSummaryMarkdown (and, by extension,
+// formatReportSummaryJSON) can render eval results without a second
+// implementation. Categories are merged into a single report the same
+// way gradeSkills merges skills.
+func rubricReportFromEvalMetrics(byCategory map[string]CategoryMetrics) GradeReport {
+	report := GradeReport{PassingThreshold: 70.0}
+
+	criterionSum := make(map[string]float64)
+	criterionCount := make(map[string]int)
+	var scoreSum float64
+
+	for _, m := range byCategory {
+		report.TotalSkills += m.Total
+		scoreSum += m.AverageScore
+		if m.PassRate >= report.PassingThreshold {
+			report.PassRate += float64(m.Total)
+		}
+		for name, avg := range m.PerCriterion {
+			criterionSum[name] += avg
+			criterionCount[name]++
+		}
+	}
+
+	if len(byCategory) > 0 {
+		report.AverageScore = scoreSum / float64(len(byCategory))
+	}
+	if report.TotalSkills > 0 {
+		report.PassRate = report.PassRate / float64(report.TotalSkills) * 100
+	}
+
+	for name, sum := range criterionSum {
+		report.CriteriaScores = append(report.CriteriaScores, CriteriaScore{
+			Name:    name,
+			Average: sum / float64(criterionCount[name]),
+		})
 	}
-	return formatEvalSummaryTable(results)
+	sort.Slice(report.CriteriaScores, func(i, j int) bool {
+		return report.CriteriaScores[i].Name < report.CriteriaScores[j].Name
+	})
+
+	return report
 }
 
 // formatEvalSummaryTable formats results as a table
@@ -201,28 +872,29 @@ func formatEvalSummaryTable(results []EvalResult) string {
 	sb.WriteString("====================\n\n")
 
 	// Calculate metrics by category
-	metrics := calculateEvalMetrics(results)
+	byCategory := calculateEvalMetrics(results)
 
 	// Get sorted category names for consistent output
-	categories := make([]string, 0, len(metrics))
-	for cat := range metrics {
+	categories := make([]string, 0, len(byCategory))
+	for cat := range byCategory {
 		categories = append(categories, cat)
 	}
 	sort.Strings(categories)
 
 	// Print category table
-	sb.WriteString(fmt.Sprintf("%-20s | %-6s | %-6s | %-6s | %-10s\n",
-		"Category", "Cases", "Pass", "Fail", "Pass Rate"))
-	sb.WriteString(strings.Repeat("-", 70) + "\n")
+	sb.WriteString(fmt.Sprintf("%-20s | %-6s | %-6s | %-6s | %-10s | %-8s | %-10s | %-8s | %-18s\n",
+		"Category", "Cases", "Pass", "Fail", "Pass Rate", "Pass@1", "Pass@k/2", "Pass@k", "95% CI"))
+	sb.WriteString(strings.Repeat("-", 115) + "\n")
 
 	totalCases := 0
 	totalPass := 0
 	totalFail := 0
 
 	for _, cat := range categories {
-		m := metrics[cat]
-		sb.WriteString(fmt.Sprintf("%-20s | %-6d | %-6d | %-6d | %9.1f%%\n",
-			cat, m.Total, m.Pass, m.Fail, m.PassRate))
+		m := byCategory[cat]
+		sb.WriteString(fmt.Sprintf("%-20s | %-6d | %-6d | %-6d | %9.1f%% | %6.1f%% | %8.1f%% | %6.1f%% | [%.1f%%, %.1f%%]\n",
+			cat, m.Total, m.Pass, m.Fail, m.PassRate,
+			m.PassAt1*100, m.PassAtKHalf*100, m.PassAtK*100, m.CILow*100, m.CIHigh*100))
 		totalCases += m.Total
 		totalPass += m.Pass
 		totalFail += m.Fail
@@ -237,18 +909,32 @@ func formatEvalSummaryTable(results []EvalResult) string {
 	sb.WriteString(fmt.Sprintf("Total: %d cases, %d pass, %d fail (%.1f%%)\n",
 		totalCases, totalPass, totalFail, overallPassRate))
 
-	sb.WriteString("\nNOTE: Eval criteria execution not yet implemented (using stub)\n")
-	sb.WriteString("      All cases currently return pass for validation\n")
-
 	return sb.String()
 }
 
 // formatEvalSummaryJSON formats results as JSON
 func formatEvalSummaryJSON(results []EvalResult) string {
-	metrics := calculateEvalMetrics(results)
+	byCategory := calculateEvalMetrics(results)
+
+	categories := make(map[string]any, len(byCategory))
+	for cat, m := range byCategory {
+		categories[cat] = map[string]any{
+			"total":       m.Total,
+			"pass":        m.Pass,
+			"fail":        m.Fail,
+			"pass_rate":   m.PassRate,
+			"pass_at_1":   m.PassAt1,
+			"pass_at_k_2": m.PassAtKHalf,
+			"pass_at_k":   m.PassAtK,
+			"confidence_interval": map[string]float64{
+				"low":  m.CILow,
+				"high": m.CIHigh,
+			},
+		}
+	}
 
 	output := map[string]interface{}{
-		"categories": metrics,
+		"categories": categories,
 		"results":    results,
 	}
 
@@ -260,13 +946,36 @@ func formatEvalSummaryJSON(results []EvalResult) string {
 	return string(data)
 }
 
-// runEvalCommand executes the eval CLI command
-func runEvalCommand(failuresDir string, category string, k int, format string) error {
+// runEvalCommand executes the eval CLI command.
+// seed makes per-run randomness in the harness reproducible across
+// invocations. failUnder, when non-negative, makes the command return an
+// error (so callers can exit non-zero for CI gating) if the lowest
+// per-category Wilson CI lower bound falls below it. graderDir is
+// scanned for grader-* plugin executables (see internal/graders/plugin)
+// whose results are folded into each run's EvalResult.GraderResults.
+// candidateDir, if non-empty, is materialized into each run's working
+// directory (see evalCandidateDir) for non-clause "code-based" criteria.
+// parallel bounds how many of a case's k runs execute concurrently
+// (see runEvaluation); values below 1 fall back to serial execution.
+func runEvalCommand(failuresDir string, category string, k int, format string, seed int64, failUnder float64, graderDir string, candidateDir string, parallel int) error {
+	evalCandidateDir = candidateDir
 	// Check if failures directory exists
 	if _, err := os.Stat(failuresDir); os.IsNotExist(err) {
 		return fmt.Errorf("failures directory not found: %s", failuresDir)
 	}
 
+	allowlist, err := plugin.LoadAllowlist(filepath.Join(graderDir, "graders.yaml"))
+	if err != nil {
+		return fmt.Errorf("loading graders allowlist: %w", err)
+	}
+	evalGraders, err = plugin.Discover(graderDir, allowlist)
+	if err != nil {
+		return fmt.Errorf("discovering grader plugins: %w", err)
+	}
+	if len(evalGraders) > 0 {
+		fmt.Printf("Discovered %d grader plugin(s) in %s\n", len(evalGraders), graderDir)
+	}
+
 	// Find failure cases
 	cases, err := findFailureCases(failuresDir, category)
 	if err != nil {
@@ -284,12 +993,14 @@ func runEvalCommand(failuresDir string, category string, k int, format string) e
 
 	fmt.Printf("Found %d failure case(s) to evaluate...\n\n", len(cases))
 
+	seedEval(seed)
+
 	// Run evaluation on each case
 	results := make([]EvalResult, 0, len(cases))
 	for i, failureCase := range cases {
 		fmt.Printf("[%d/%d] Evaluating %s...\n", i+1, len(cases), failureCase.ID)
 
-		result, err := runEvaluation(failureCase, k)
+		result, err := runEvaluation(failureCase, k, parallel)
 		if err != nil {
 			fmt.Printf("Warning: Failed to evaluate %s: %v\n", failureCase.ID, err)
 			continue
@@ -303,5 +1014,12 @@ func runEvalCommand(failuresDir string, category string, k int, format string) e
 	summary := formatEvalSummary(results, format)
 	fmt.Println(summary)
 
+	if failUnder >= 0 {
+		lowest := lowestCategoryCILow(calculateEvalMetrics(results))
+		if lowest < failUnder {
+			return fmt.Errorf("lowest category CI lower bound %.3f is below --fail-under threshold %.3f", lowest, failUnder)
+		}
+	}
+
 	return nil
 }
@@ -192,6 +192,46 @@ eval_criteria:
 	}
 }
 
+// TestFindFailureCasesIncludesFixtures verifies that a fixtures/ subtree
+// alongside the hand-written failure cases is picked up as additional
+// cases, each carrying a single fixture.replay criterion.
+func TestFindFailureCasesIncludesFixtures(t *testing.T) {
+	tmpDir := t.TempDir()
+	failuresDir := filepath.Join(tmpDir, "failures")
+	fixtureDir := filepath.Join(failuresDir, "fixtures", "greet")
+	if err := os.MkdirAll(fixtureDir, 0755); err != nil {
+		t.Fatalf("Failed to create fixture dir: %v", err)
+	}
+
+	manifest := `id: greet
+category: smoke
+inputs:
+  task: "hello fixture"
+expected:
+  files:
+    - path: output.txt
+      equals: "hello fixture\n"
+`
+	if err := os.WriteFile(filepath.Join(fixtureDir, "fixture.yaml"), []byte(manifest), 0644); err != nil {
+		t.Fatalf("Failed to write fixture.yaml: %v", err)
+	}
+
+	cases, err := findFailureCases(failuresDir, "")
+	if err != nil {
+		t.Fatalf("findFailureCases failed: %v", err)
+	}
+
+	if len(cases) != 1 {
+		t.Fatalf("expected 1 case, got %d", len(cases))
+	}
+	if cases[0].ID != "greet" || cases[0].Category != "smoke" {
+		t.Errorf("case = %+v, want ID=greet Category=smoke", cases[0])
+	}
+	if len(cases[0].EvalCriteria) != 1 || cases[0].EvalCriteria[0].Type != "fixture.replay" {
+		t.Errorf("EvalCriteria = %+v, want a single fixture.replay criterion", cases[0].EvalCriteria)
+	}
+}
+
 // TestRunEvaluation tests running evaluation on failure cases (stubbed)
 func TestRunEvaluation(t *testing.T) {
 	// Create sample failure case
@@ -206,7 +246,7 @@ func TestRunEvaluation(t *testing.T) {
 	}
 
 	// Execute evaluation (stubbed, should always pass for now)
-	result, err := runEvaluation(failureCase, 1)
+	result, err := runEvaluation(failureCase, 1, 1)
 	if err != nil {
 		t.Fatalf("runEvaluation failed: %v", err)
 	}
@@ -231,7 +271,7 @@ func TestRunEvaluationMultipleRuns(t *testing.T) {
 	}
 
 	// Execute with k=5
-	result, err := runEvaluation(failureCase, 5)
+	result, err := runEvaluation(failureCase, 5, 1)
 	if err != nil {
 		t.Fatalf("runEvaluation failed: %v", err)
 	}
@@ -242,15 +282,84 @@ func TestRunEvaluationMultipleRuns(t *testing.T) {
 	}
 }
 
+// TestRunEvaluationParallelMatchesSerial verifies that running k copies of
+// a case concurrently produces the same per-run results, in the same
+// index order, as running them serially - the defining property of the
+// completion-order-independent write pattern runEvaluation shares with
+// runParallel (meta.go).
+func TestRunEvaluationParallelMatchesSerial(t *testing.T) {
+	failureCase := FailureCase{
+		ID:       "MT-001",
+		Category: "missed-tasks",
+		EvalCriteria: []EvalCriterion{
+			{Type: "code-based", Check: "test()"},
+		},
+	}
+
+	serial, err := runEvaluation(failureCase, 8, 1)
+	if err != nil {
+		t.Fatalf("serial runEvaluation failed: %v", err)
+	}
+	parallel, err := runEvaluation(failureCase, 8, 8)
+	if err != nil {
+		t.Fatalf("parallel runEvaluation failed: %v", err)
+	}
+
+	if len(serial.Runs) != len(parallel.Runs) {
+		t.Fatalf("serial has %d runs, parallel has %d", len(serial.Runs), len(parallel.Runs))
+	}
+	for i := range serial.Runs {
+		if serial.Runs[i].Passed != parallel.Runs[i].Passed {
+			t.Errorf("run %d: serial.Passed=%v, parallel.Passed=%v", i, serial.Runs[i].Passed, parallel.Runs[i].Passed)
+		}
+	}
+}
+
+// TestRunEvaluationRunOutcomeTiming verifies each RunOutcome records a
+// non-negative duration and no error for a run that completes normally.
+func TestRunEvaluationRunOutcomeTiming(t *testing.T) {
+	failureCase := FailureCase{
+		ID:       "MT-001",
+		Category: "missed-tasks",
+		EvalCriteria: []EvalCriterion{
+			{Type: "code-based", Check: "test()"},
+		},
+	}
+
+	result, err := runEvaluation(failureCase, 1, 1)
+	if err != nil {
+		t.Fatalf("runEvaluation failed: %v", err)
+	}
+
+	outcome := result.Runs[0]
+	if outcome.Err != "" {
+		t.Errorf("Err = %q, want empty", outcome.Err)
+	}
+	if outcome.DurationMS < 0 {
+		t.Errorf("DurationMS = %d, want >= 0", outcome.DurationMS)
+	}
+}
+
+// runOutcomes builds a []RunOutcome from a terse pass/fail bool list, for
+// tests that only care about each run's outcome and not its timing or
+// error detail.
+func runOutcomes(passed ...bool) []RunOutcome {
+	runs := make([]RunOutcome, len(passed))
+	for i, p := range passed {
+		runs[i] = RunOutcome{Passed: p}
+	}
+	return runs
+}
+
 // TestFormatEvalSummary tests summary table generation
 func TestFormatEvalSummary(t *testing.T) {
 	// Create sample eval results
 	results := []EvalResult{
-		{CaseID: "MT-001", Category: "missed-tasks", Runs: []bool{true, true, true}},
-		{CaseID: "MT-002", Category: "missed-tasks", Runs: []bool{false, false, false}},
-		{CaseID: "WT-001", Category: "missing-tests", Runs: []bool{true, true, true}},
-		{CaseID: "WT-002", Category: "missing-tests", Runs: []bool{true, false, true}},
-		{CaseID: "WP-001", Category: "wrong-product", Runs: []bool{true, true, true}},
+		{CaseID: "MT-001", Category: "missed-tasks", Runs: runOutcomes(true, true, true)},
+		{CaseID: "MT-002", Category: "missed-tasks", Runs: runOutcomes(false, false, false)},
+		{CaseID: "WT-001", Category: "missing-tests", Runs: runOutcomes(true, true, true)},
+		{CaseID: "WT-002", Category: "missing-tests", Runs: runOutcomes(true, false, true)},
+		{CaseID: "WP-001", Category: "wrong-product", Runs: runOutcomes(true, true, true)},
 	}
 
 	// Execute
@@ -278,7 +387,7 @@ func TestFormatEvalSummary(t *testing.T) {
 // TestFormatEvalSummaryJSON tests JSON output format
 func TestFormatEvalSummaryJSON(t *testing.T) {
 	results := []EvalResult{
-		{CaseID: "MT-001", Category: "missed-tasks", Runs: []bool{true, true, true}},
+		{CaseID: "MT-001", Category: "missed-tasks", Runs: runOutcomes(true, true, true)},
 	}
 
 	// Execute
@@ -293,13 +402,76 @@ func TestFormatEvalSummaryJSON(t *testing.T) {
 	}
 }
 
+// TestFormatEvalSummaryJUnit tests that the JUnit format groups cases by
+// category and surfaces the failing case's Description/RootCause.
+func TestFormatEvalSummaryJUnit(t *testing.T) {
+	results := []EvalResult{
+		{
+			CaseID:      "MT-001",
+			Category:    "missed-tasks",
+			Description: "agent skipped the migration step",
+			RootCause:   "task spec did not mention it explicitly",
+			Runs:        runOutcomes(false, false, false),
+		},
+		{CaseID: "MT-002", Category: "missed-tasks", Runs: runOutcomes(true, true, true)},
+	}
+
+	summary := formatEvalSummary(results, "junit")
+
+	if !strings.Contains(summary, `<testsuite name="missed-tasks"`) {
+		t.Errorf("expected a testsuite named by category, got: %s", summary)
+	}
+	if !strings.Contains(summary, "agent skipped the migration step") || !strings.Contains(summary, "task spec did not mention it explicitly") {
+		t.Errorf("expected failure message to include Description and RootCause, got: %s", summary)
+	}
+	if !strings.Contains(summary, "0/3 runs passed") {
+		t.Errorf("expected per-run pass count in message, got: %s", summary)
+	}
+}
+
+// TestFormatEvalSummarySARIF tests that the SARIF format uses CaseID as
+// ruleId, bands the level by Severity, and carries TaskSpec in the
+// message.
+func TestFormatEvalSummarySARIF(t *testing.T) {
+	results := []EvalResult{
+		{
+			CaseID:   "MT-001",
+			Category: "missed-tasks",
+			Severity: "high",
+			TaskSpec: "implement the migration CLI command",
+			Runs:     runOutcomes(false, false),
+		},
+		{
+			CaseID:   "MT-002",
+			Category: "missed-tasks",
+			Severity: "low",
+			Runs:     runOutcomes(true, true),
+		},
+	}
+
+	summary := formatEvalSummary(results, "sarif")
+
+	if !strings.Contains(summary, `"ruleId": "MT-001"`) {
+		t.Errorf("expected ruleId MT-001, got: %s", summary)
+	}
+	if !strings.Contains(summary, `"level": "error"`) {
+		t.Errorf("expected high severity to band as error, got: %s", summary)
+	}
+	if !strings.Contains(summary, "implement the migration CLI command") {
+		t.Errorf("expected TaskSpec in message, got: %s", summary)
+	}
+	if strings.Contains(summary, `"ruleId": "MT-002"`) {
+		t.Errorf("passing case MT-002 should produce no SARIF result, got: %s", summary)
+	}
+}
+
 // TestCalculateEvalMetrics tests metric calculation
 func TestCalculateEvalMetrics(t *testing.T) {
 	results := []EvalResult{
-		{CaseID: "MT-001", Category: "missed-tasks", Runs: []bool{true, true, true}},   // pass
-		{CaseID: "MT-002", Category: "missed-tasks", Runs: []bool{false, false, true}}, // fail (majority false)
-		{CaseID: "WT-001", Category: "missing-tests", Runs: []bool{true, true, true}},  // pass
-		{CaseID: "WT-002", Category: "missing-tests", Runs: []bool{true, false, true}}, // pass (majority true)
+		{CaseID: "MT-001", Category: "missed-tasks", Runs: runOutcomes(true, true, true)},   // pass
+		{CaseID: "MT-002", Category: "missed-tasks", Runs: runOutcomes(false, false, true)}, // fail (majority false)
+		{CaseID: "WT-001", Category: "missing-tests", Runs: runOutcomes(true, true, true)},  // pass
+		{CaseID: "WT-002", Category: "missing-tests", Runs: runOutcomes(true, false, true)}, // pass (majority true)
 	}
 
 	// Execute
@@ -332,6 +504,147 @@ func TestCalculateEvalMetrics(t *testing.T) {
 	}
 }
 
+// TestCalculateEvalMetricsPassAtKStats verifies the pass@k-style
+// statistics and Wilson CI computed per category.
+func TestCalculateEvalMetricsPassAtKStats(t *testing.T) {
+	results := []EvalResult{
+		{CaseID: "MT-001", Category: "missed-tasks", Runs: runOutcomes(true, true, true)},
+		{CaseID: "MT-002", Category: "missed-tasks", Runs: runOutcomes(false, false, true)},
+	}
+
+	metrics := calculateEvalMetrics(results)
+	m := metrics["missed-tasks"]
+
+	// 4 passing runs out of 6 total runs
+	if got, want := m.PassAt1, 4.0/6.0; got != want {
+		t.Errorf("PassAt1 = %v, want %v", got, want)
+	}
+	// Both cases have at least one passing run, so pass@k = 1.0
+	if m.PassAtK != 1.0 {
+		t.Errorf("PassAtK = %v, want 1.0", m.PassAtK)
+	}
+	if m.CILow < 0 || m.CIHigh > 1 || m.CILow > m.CIHigh {
+		t.Errorf("CI bounds invalid: [%v, %v]", m.CILow, m.CIHigh)
+	}
+}
+
+// TestRunEvalCommandFailUnder verifies --fail-under gating returns an
+// error when the lowest category's CI lower bound is too low.
+func TestRunEvalCommandFailUnder(t *testing.T) {
+	tmpDir := t.TempDir()
+	failuresDir := filepath.Join(tmpDir, "failures")
+	catDir := filepath.Join(failuresDir, "missed-tasks")
+	if err := os.MkdirAll(catDir, 0755); err != nil {
+		t.Fatalf("Failed to create category dir: %v", err)
+	}
+
+	content := `id: TEST-001
+category: missed-tasks
+discovered: 2026-01-25
+severity: medium
+
+context:
+  task: "Test"
+
+failure:
+  description: "Test"
+  root_cause: "Test"
+
+evidence:
+  task_spec: "Test"
+  what_was_built: "Test"
+
+eval_criteria:
+  - type: file.exists
+    check: "does-not-exist.txt"
+`
+	filename := filepath.Join(catDir, "TEST-001.yaml")
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write failure case: %v", err)
+	}
+
+	// A criterion that always fails should push the CI lower bound to 0,
+	// which must trip a --fail-under threshold above 0.
+	err := runEvalCommand(failuresDir, "", 1, "table", 1, 0.5, "", "", 1)
+	if err == nil {
+		t.Fatal("expected --fail-under to return an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "fail-under") {
+		t.Errorf("expected error mentioning fail-under, got %q", err.Error())
+	}
+
+	// With gating disabled (-1) the same run should succeed.
+	if err := runEvalCommand(failuresDir, "", 1, "table", 1, -1, "", "", 1); err != nil {
+		t.Errorf("expected no error with --fail-under disabled, got %v", err)
+	}
+}
+
+// TestRunEvaluationRubricScoring verifies per-run and per-criterion
+// rubric scores are computed alongside the plain pass/fail vote.
+func TestRunEvaluationRubricScoring(t *testing.T) {
+	failureCase := FailureCase{
+		ID:       "MT-200",
+		Category: "missed-tasks",
+		EvalCriteria: []EvalCriterion{
+			{Type: "shell.exitcode", Check: "0", Name: "exit_ok", Weight: 3},
+			{Type: "shell.stdout.contains", Check: "nope", Name: "has_marker", Weight: 1},
+		},
+	}
+
+	result, err := runEvaluation(failureCase, 1, 1)
+	if err != nil {
+		t.Fatalf("runEvaluation failed: %v", err)
+	}
+
+	// exit_ok passes (exit code defaults to 0), has_marker fails, so the
+	// run is not a clean pass but should earn 3/4 of the weight.
+	if result.Runs[0].Passed {
+		t.Fatal("expected run to fail overall since one criterion fails")
+	}
+	got := result.Scores[0]
+	if got.Weighted != 0.75 {
+		t.Errorf("Weighted = %v, want 0.75", got.Weighted)
+	}
+	if got.Max != 4 {
+		t.Errorf("Max = %v, want 4", got.Max)
+	}
+
+	metrics := calculateEvalMetrics([]EvalResult{result})
+	m := metrics["missed-tasks"]
+	if m.PerCriterion["exit_ok"] != 100.0 {
+		t.Errorf("PerCriterion[exit_ok] = %v, want 100", m.PerCriterion["exit_ok"])
+	}
+	if m.PerCriterion["has_marker"] != 0.0 {
+		t.Errorf("PerCriterion[has_marker] = %v, want 0", m.PerCriterion["has_marker"])
+	}
+	if m.AverageScore != 75.0 {
+		t.Errorf("AverageScore = %v, want 75", m.AverageScore)
+	}
+}
+
+// TestFormatEvalSummaryRubric verifies the rubric format reuses the
+// report package's markdown rendering.
+func TestFormatEvalSummaryRubric(t *testing.T) {
+	results := []EvalResult{
+		{
+			CaseID:          "MT-001",
+			Category:        "missed-tasks",
+			Runs:            runOutcomes(true),
+			Scores:          []Score{{Raw: 1, Weighted: 1, Max: 1}},
+			CriterionNames:  []string{"exit_ok"},
+			CriterionPassed: [][]bool{{true}},
+		},
+	}
+
+	summary := formatEvalSummary(results, "rubric")
+	if !strings.Contains(summary, "Evaluation Report Summary") {
+		t.Error("rubric summary should reuse formatReportSummaryMarkdown's header")
+	}
+	if !strings.Contains(summary, "exit_ok") {
+		t.Error("rubric summary missing per-criterion breakdown")
+	}
+}
+
 // TestRunEvalCommand tests the eval CLI command
 func TestRunEvalCommand(t *testing.T) {
 	// Setup: Create temp directory with failure cases
@@ -375,7 +688,7 @@ eval_criteria:
 	}
 
 	// Execute - should not return error
-	err := runEvalCommand(failuresDir, "", 1, "table")
+	err := runEvalCommand(failuresDir, "", 1, "table", 1, -1, "", "", 1)
 	if err != nil {
 		t.Errorf("runEvalCommand failed: %v", err)
 	}
@@ -424,7 +737,7 @@ eval_criteria:
 	}
 
 	// Execute with category filter
-	err := runEvalCommand(failuresDir, "missing-tests", 1, "table")
+	err := runEvalCommand(failuresDir, "missing-tests", 1, "table", 1, -1, "", "", 1)
 	if err != nil {
 		t.Errorf("runEvalCommand with category filter failed: %v", err)
 	}
@@ -446,7 +759,7 @@ func TestRunEvalCommandErrors(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := runEvalCommand(tt.failuresDir, "", 1, "table")
+			err := runEvalCommand(tt.failuresDir, "", 1, "table", 1, -1, "", "", 1)
 			if err == nil {
 				t.Fatalf("Expected error containing %q, got nil", tt.expectError)
 			}
@@ -0,0 +1,88 @@
+package main
+
+import "strings"
+
+// caseFilter is meta's test-case selection DSL, modelled on kube-bench's
+// parseSkipIds/RunFilter: --skip and --only narrow the suite by ID, ID
+// prefix, or tag, while --tag and --id are single-criterion conveniences
+// for the common case of filtering by just one of those. Filtered-out
+// cases are never executed; decide reports why so the report can show
+// them as SKIPPED instead of silently vanishing from the suite.
+type caseFilter struct {
+	skip []string
+	only []string
+	tag  []string
+	id   []string
+}
+
+// newCaseFilter builds a caseFilter from the --skip/--only/--tag/--id
+// flag values.
+func newCaseFilter(skip, only, tag, id []string) caseFilter {
+	return caseFilter{skip: skip, only: only, tag: tag, id: id}
+}
+
+// empty reports whether the filter was configured with no criteria at
+// all, in which case every test case runs.
+func (f caseFilter) empty() bool {
+	return len(f.skip) == 0 && len(f.only) == 0 && len(f.tag) == 0 && len(f.id) == 0
+}
+
+// decide reports whether tc should be excluded from the run, and why
+// (e.g. "skip-id", "not-in-only", "not-in-tag", "not-in-id"). An empty
+// reason means tc should run.
+//
+// --only, --tag, and --id are AND'd together: when configured, each one
+// tc fails to satisfy excludes it. --skip is applied last and wins on
+// conflict, so a case matching both --only and --skip is excluded.
+func (f caseFilter) decide(tc TestCase) (exclude bool, reason string) {
+	if len(f.only) > 0 && !matchesAnyToken(tc, f.only) {
+		return true, "not-in-only"
+	}
+	if len(f.tag) > 0 && !hasAnyTag(tc, f.tag) {
+		return true, "not-in-tag"
+	}
+	if len(f.id) > 0 && !containsString(f.id, tc.ID) {
+		return true, "not-in-id"
+	}
+	if len(f.skip) > 0 && matchesAnyToken(tc, f.skip) {
+		return true, "skip-id"
+	}
+	return false, ""
+}
+
+// matchesAnyToken reports whether tc matches at least one of tokens,
+// where a token matches tc when it equals tc.ID exactly, it is an ID
+// prefix of tc.ID (e.g. "3" matching "3.1", "3.2", delimited by "."), or
+// it equals one of tc.Tags.
+func matchesAnyToken(tc TestCase, tokens []string) bool {
+	for _, token := range tokens {
+		if token == tc.ID || strings.HasPrefix(tc.ID, token+".") {
+			return true
+		}
+		if containsString(tc.Tags, token) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnyTag reports whether tc.Tags contains any of tags (exact match
+// only, unlike matchesAnyToken which also matches IDs/ID prefixes).
+func hasAnyTag(tc TestCase, tags []string) bool {
+	for _, tag := range tags {
+		if containsString(tc.Tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsString reports whether s is present in values.
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package main
+
+import "testing"
+
+func TestCaseFilterEmpty(t *testing.T) {
+	if !newCaseFilter(nil, nil, nil, nil).empty() {
+		t.Error("newCaseFilter(nil, nil, nil, nil).empty() = false, want true")
+	}
+	if newCaseFilter([]string{"3.2"}, nil, nil, nil).empty() {
+		t.Error("newCaseFilter with --skip set should not be empty")
+	}
+}
+
+func TestCaseFilterDecideExactID(t *testing.T) {
+	f := newCaseFilter(nil, []string{"3.2"}, nil, nil)
+
+	if exclude, reason := f.decide(TestCase{ID: "3.2"}); exclude {
+		t.Errorf("decide(3.2) excluded unexpectedly, reason %q", reason)
+	}
+	if exclude, reason := f.decide(TestCase{ID: "3.1"}); !exclude || reason != "not-in-only" {
+		t.Errorf("decide(3.1) = %v, %q, want true, \"not-in-only\"", exclude, reason)
+	}
+}
+
+func TestCaseFilterDecideIDPrefix(t *testing.T) {
+	f := newCaseFilter(nil, []string{"3"}, nil, nil)
+
+	for _, id := range []string{"3.1", "3.2"} {
+		if exclude, reason := f.decide(TestCase{ID: id}); exclude {
+			t.Errorf("decide(%s) excluded unexpectedly, reason %q", id, reason)
+		}
+	}
+	if exclude, _ := f.decide(TestCase{ID: "30.1"}); !exclude {
+		t.Error("decide(30.1) should be excluded: \"3\" is not a prefix of \"30.1\" at a \".\" boundary")
+	}
+}
+
+func TestCaseFilterDecideTag(t *testing.T) {
+	f := newCaseFilter(nil, nil, []string{"smoke"}, nil)
+
+	if exclude, reason := f.decide(TestCase{ID: "1.1", Tags: []string{"smoke", "fast"}}); exclude {
+		t.Errorf("decide with matching tag excluded unexpectedly, reason %q", reason)
+	}
+	if exclude, reason := f.decide(TestCase{ID: "1.2", Tags: []string{"slow"}}); !exclude || reason != "not-in-tag" {
+		t.Errorf("decide without matching tag = %v, %q, want true, \"not-in-tag\"", exclude, reason)
+	}
+}
+
+func TestCaseFilterDecideID(t *testing.T) {
+	f := newCaseFilter(nil, nil, nil, []string{"2.1"})
+
+	if exclude, _ := f.decide(TestCase{ID: "2.1"}); exclude {
+		t.Error("decide(2.1) excluded unexpectedly")
+	}
+	// --id matches only the exact ID, unlike --only's ID-prefix matching.
+	if exclude, reason := f.decide(TestCase{ID: "2"}); !exclude || reason != "not-in-id" {
+		t.Errorf("decide(2) = %v, %q, want true, \"not-in-id\"", exclude, reason)
+	}
+}
+
+func TestCaseFilterSkipWinsOnConflict(t *testing.T) {
+	f := newCaseFilter([]string{"3.2", "security"}, []string{"3"}, nil, nil)
+
+	if exclude, reason := f.decide(TestCase{ID: "3.1"}); exclude {
+		t.Errorf("decide(3.1) excluded unexpectedly, reason %q", reason)
+	}
+	if exclude, reason := f.decide(TestCase{ID: "3.2"}); !exclude || reason != "skip-id" {
+		t.Errorf("decide(3.2) = %v, %q, want true, \"skip-id\" (skip should win over --only)", exclude, reason)
+	}
+	if exclude, reason := f.decide(TestCase{ID: "3.3", Tags: []string{"security"}}); !exclude || reason != "skip-id" {
+		t.Errorf("decide(3.3 tagged security) = %v, %q, want true, \"skip-id\"", exclude, reason)
+	}
+}
+
+func TestCaseFilterComposesOnlyAndTag(t *testing.T) {
+	f := newCaseFilter(nil, []string{"3"}, []string{"smoke"}, nil)
+
+	if exclude, reason := f.decide(TestCase{ID: "3.1", Tags: []string{"smoke"}}); exclude {
+		t.Errorf("decide matching both --only and --tag excluded unexpectedly, reason %q", reason)
+	}
+	if exclude, reason := f.decide(TestCase{ID: "3.1", Tags: []string{"slow"}}); !exclude || reason != "not-in-tag" {
+		t.Errorf("decide(3.1, slow) = %v, %q, want true, \"not-in-tag\" (--only and --tag AND together)", exclude, reason)
+	}
+	if exclude, reason := f.decide(TestCase{ID: "4.1", Tags: []string{"smoke"}}); !exclude || reason != "not-in-only" {
+		t.Errorf("decide(4.1, smoke) = %v, %q, want true, \"not-in-only\"", exclude, reason)
+	}
+}
@@ -0,0 +1,606 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+	"github.com/stevestomp/yokay-evals/internal/graders/plugin"
+	"github.com/stevestomp/yokay-evals/internal/graderspec"
+	"github.com/stevestomp/yokay-evals/internal/history"
+)
+
+type skillResult struct {
+	Name    string
+	Path    string
+	Score   float64
+	Passed  bool
+	Message string
+	Details map[string]any
+}
+
+// newGradeSkillsCmd builds the `grade-skills` subcommand, which grades
+// every pokayokay skill and writes a skill-clarity report.
+func newGradeSkillsCmd() *cobra.Command {
+	var skillsDir string
+	var reportPath string
+	var format string
+	var graderDir string
+	var parallelism int
+	var rps float64
+	var timeout time.Duration
+	var graderName string
+	var model string
+	var temperature float64
+	var specPath string
+
+	cmd := &cobra.Command{
+		Use:   "grade-skills",
+		Short: "Grade all pokayokay skills and generate report",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			formats := strings.Split(format, ",")
+
+			output := reportPath
+			if output == "" {
+				execPath, err := os.Executable()
+				if err != nil {
+					return fmt.Errorf("getting executable path: %w", err)
+				}
+				evalsDir := filepath.Join(filepath.Dir(filepath.Dir(execPath)), "..")
+				reportsDir := filepath.Join(evalsDir, "reports")
+
+				if err := os.MkdirAll(reportsDir, 0755); err != nil {
+					return fmt.Errorf("creating reports directory: %w", err)
+				}
+
+				today := time.Now().Format("2006-01-02")
+				output = filepath.Join(reportsDir, fmt.Sprintf("skill-clarity-%s.md", today))
+			}
+
+			dir := graderDir
+			if dir == "" {
+				dir = defaultYokayEvalsSubdir("graders")
+			}
+
+			ctx := context.Background()
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			if specPath != "" && graderName != "" && graderName != "heuristic" {
+				return fmt.Errorf("--spec cannot be combined with --grader %s", graderName)
+			}
+
+			opts, err := llmOptionsFromFlags(graderName, model, temperature)
+			if err != nil {
+				return err
+			}
+
+			if err := gradeSkillsWithFormats(ctx, skillsDir, output, formats, dir, parallelism, rps, specPath, opts...); err != nil {
+				return fmt.Errorf("Failed to grade skills: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&skillsDir, "skills-dir", "/Users/sis4m4/Projects/stevestomp/pokayokay/plugins/pokayokay/skills", "Path to skills directory")
+	cmd.Flags().StringVar(&reportPath, "output", "", "Output report path, or prefix when --format has multiple values (default: yokay-evals/reports/skill-clarity-YYYY-MM-DD.md)")
+	cmd.Flags().StringVar(&format, "format", "markdown", "Output format(s), comma-separated: 'markdown', 'json', 'junit', 'tap', 'sarif'")
+	cmd.Flags().StringVar(&graderDir, "grader-dir", "", "Directory to discover grader-* plugin executables in (default: yokay-evals/graders/)")
+	cmd.Flags().IntVar(&parallelism, "parallelism", 0, "Number of skills to grade concurrently (default: GOMAXPROCS)")
+	cmd.Flags().Float64Var(&rps, "rps", 0, "Per-worker rate limit in Grade calls/sec (default: unlimited)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "Cancel the run after this long, writing a partial report marked incomplete (default: unlimited)")
+	cmd.Flags().StringVar(&graderName, "grader", "heuristic", "Skill-clarity grading backend: 'heuristic' (default, offline), 'openai', 'anthropic', 'ollama'")
+	cmd.Flags().StringVar(&model, "model", "", "Model name to pass to --grader (default: the provider's own default)")
+	cmd.Flags().Float64Var(&temperature, "temperature", 0, "Sampling temperature to pass to --grader")
+	cmd.Flags().StringVar(&specPath, "spec", "", "Grade against a graderspec YAML file of user-defined weighted criteria instead of the built-in SkillClarityGrader (mutually exclusive with --grader)")
+
+	return cmd
+}
+
+// llmOptionsFromFlags turns --grader/--model/--temperature into the
+// modelbased.Option slice NewSkillClarityGrader expects. "heuristic"
+// (the default) returns no options, so Grade keeps using its built-in
+// evaluateCriteria; the hosted providers read their API key from the
+// environment so it never has to be passed on the command line.
+func llmOptionsFromFlags(graderName, model string, temperature float64) ([]modelbased.Option, error) {
+	var provider modelbased.LLMProvider
+
+	switch graderName {
+	case "", "heuristic":
+		return nil, nil
+	case "openai":
+		apiKey := os.Getenv("OPENAI_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("--grader openai requires OPENAI_API_KEY to be set")
+		}
+		provider = modelbased.NewOpenAIProvider(apiKey)
+	case "anthropic":
+		apiKey := os.Getenv("ANTHROPIC_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("--grader anthropic requires ANTHROPIC_API_KEY to be set")
+		}
+		provider = modelbased.NewAnthropicProvider(apiKey)
+	case "ollama":
+		provider = modelbased.NewOllamaProvider()
+	default:
+		return nil, fmt.Errorf("unknown --grader %q: want heuristic, openai, anthropic, or ollama", graderName)
+	}
+
+	opts := []modelbased.Option{modelbased.WithProvider(provider)}
+	if model != "" {
+		opts = append(opts, modelbased.WithModel(model))
+	}
+	if temperature != 0 {
+		opts = append(opts, modelbased.WithTemperature(temperature))
+	}
+	return opts, nil
+}
+
+// gradeSkills finds all skill files, grades them, and writes a markdown
+// report to reportPath. It's a thin single-format wrapper around
+// gradeSkillsWithFormats, kept for callers that only want the original
+// markdown report and the default grader plugin directory.
+func gradeSkills(skillsDir, reportPath string) error {
+	return gradeSkillsWithFormats(context.Background(), skillsDir, reportPath, []string{"markdown"}, defaultYokayEvalsSubdir("graders"), 0, 0, "")
+}
+
+// gradeSkillsWithFormats finds all skill files, grades them with the
+// built-in SkillClarityGrader (or, when specPath is set, a
+// graderspec.Grader loaded from that YAML file instead) plus every
+// grader-* plugin discovered under graderDir, merges the results, and
+// renders them in every format in formats (see writeReportOutputs for
+// how outputPath is used across one vs. several formats). graderOpts
+// configures the SkillClarityGrader itself (see llmOptionsFromFlags) and
+// is ignored when specPath is set; callers that don't care about either
+// can pass "" and no opts to get the default heuristic grader.
+func gradeSkillsWithFormats(ctx context.Context, skillsDir, outputPath string, formats []string, graderDir string, parallelism int, rps float64, specPath string, graderOpts ...modelbased.Option) error {
+	// Find all SKILL.md files
+	skillFiles, err := findSkillFiles(skillsDir)
+	if err != nil {
+		return fmt.Errorf("finding skill files: %w", err)
+	}
+
+	if len(skillFiles) == 0 {
+		return fmt.Errorf("no skill files found in %s", skillsDir)
+	}
+
+	fmt.Printf("Found %d skills to grade...\n", len(skillFiles))
+
+	allowlist, err := plugin.LoadAllowlist(filepath.Join(graderDir, "graders.yaml"))
+	if err != nil {
+		return fmt.Errorf("loading graders allowlist: %w", err)
+	}
+	plugins, err := plugin.Discover(graderDir, allowlist)
+	if err != nil {
+		return fmt.Errorf("discovering grader plugins: %w", err)
+	}
+	if len(plugins) > 0 {
+		fmt.Printf("Discovered %d grader plugin(s) in %s\n", len(plugins), graderDir)
+	}
+
+	var grader modelbased.Grader
+	if specPath != "" {
+		grader, err = graderspec.NewGraderFromSpec(specPath)
+		if err != nil {
+			return fmt.Errorf("loading grader spec: %w", err)
+		}
+	} else {
+		grader = modelbased.NewSkillClarityGrader(graderOpts...)
+	}
+
+	// Grade every skill concurrently, bounded by parallelism/rps. If ctx
+	// is cancelled (e.g. --timeout) before every skill finishes,
+	// incomplete is true and results only holds what finished in time.
+	results, incomplete := gradeSkillsParallel(ctx, skillFiles, grader, plugins, parallelism, rps)
+	if incomplete {
+		fmt.Println("Warning: grading run cancelled before every skill finished; report reflects a partial result set")
+	}
+
+	if len(results) == 0 {
+		return fmt.Errorf("no skills were successfully graded")
+	}
+
+	suite := buildGradeReportSuite(results, incomplete)
+	if err := writeReportOutputs(suite, formats, outputPath); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+
+	if incomplete {
+		return nil
+	}
+
+	indexPath := filepath.Join(filepath.Dir(outputPath), "index.jsonl")
+	if err := history.AppendRecord(indexPath, buildHistoryRecord(results)); err != nil {
+		return fmt.Errorf("recording history: %w", err)
+	}
+
+	return nil
+}
+
+// buildHistoryRecord reduces results into the history.Record `report
+// trend` appends to reports/index.jsonl, dated to today. Per-criterion
+// averages reuse the same fixed criteria list and Details extraction
+// formatGradeReportMarkdown's Detailed Breakdown section already uses,
+// so the two summaries agree.
+func buildHistoryRecord(results []skillResult) history.Record {
+	totalScore := 0.0
+	passCount := 0
+	skills := make([]history.SkillPoint, 0, len(results))
+	criterionSum := make(map[string]float64)
+	criterionCount := make(map[string]int)
+
+	for _, r := range results {
+		totalScore += r.Score
+		if r.Passed {
+			passCount++
+		}
+		skills = append(skills, history.SkillPoint{Name: r.Name, Score: r.Score, Passed: r.Passed})
+
+		for _, criterion := range []string{"clear_instructions", "actionable_steps", "good_examples", "appropriate_scope"} {
+			details, ok := r.Details[criterion].(map[string]any)
+			if !ok {
+				continue
+			}
+			score, ok := details["score"].(float64)
+			if !ok {
+				continue
+			}
+			name := formatCriterionName(criterion)
+			criterionSum[name] += score
+			criterionCount[name]++
+		}
+	}
+
+	criteria := make([]history.CriterionPoint, 0, len(criterionSum))
+	for name, sum := range criterionSum {
+		criteria = append(criteria, history.CriterionPoint{Name: name, Average: sum / float64(criterionCount[name])})
+	}
+	sort.Slice(criteria, func(i, j int) bool { return criteria[i].Name < criteria[j].Name })
+
+	return history.Record{
+		Date:         time.Now().Format("2006-01-02"),
+		TotalSkills:  len(results),
+		AverageScore: totalScore / float64(len(results)),
+		PassRate:     float64(passCount) / float64(len(results)) * 100,
+		Skills:       skills,
+		Criteria:     criteria,
+	}
+}
+
+// gradeWithPlugins runs every plugin that declared support for kind
+// against content, logging (rather than failing the whole grading run
+// on) any individual plugin error, consistent with how a failed
+// findSkillFiles read is already handled above.
+func gradeWithPlugins(plugins []*plugin.Grader, kind, content string, gradeContext map[string]any) []pluginGrade {
+	var graded []pluginGrade
+	for _, g := range plugins {
+		if !g.SupportsKind(kind) {
+			continue
+		}
+		resp, err := g.Grade(context.Background(), plugin.GradeRequest{Content: content, Context: gradeContext, Kind: kind})
+		if err != nil {
+			log.Printf("Warning: grader plugin %s failed: %v", g.Describe.Name, err)
+			continue
+		}
+		graded = append(graded, pluginGrade{name: g.Describe.Name, resp: resp})
+	}
+	return graded
+}
+
+type pluginGrade struct {
+	name string
+	resp plugin.GradeResponse
+}
+
+// mergeGraderResults folds the built-in grader's Result together with
+// every plugin grade into the single skillResult shape the report
+// renders. The merged score is the mean across every grader that ran;
+// the merged pass/fail is the logical AND across all of them, so one
+// failing grader (built-in or plugin) fails the skill overall, the same
+// "weakest link" semantics report.go already uses for skill-clarity's
+// own criteria.
+func mergeGraderResults(builtin modelbased.Result, plugins []pluginGrade) modelbased.Result {
+	if len(plugins) == 0 {
+		return builtin
+	}
+
+	totalScore := builtin.Score
+	passed := builtin.Passed
+	messages := []string{builtin.Message}
+	details := make(map[string]any, len(builtin.Details)+len(plugins))
+	for k, v := range builtin.Details {
+		details[k] = v
+	}
+
+	for _, pg := range plugins {
+		totalScore += pg.resp.Score
+		passed = passed && pg.resp.Passed
+		messages = append(messages, fmt.Sprintf("%s: %s", pg.name, pg.resp.Message))
+		details["grader:"+pg.name] = map[string]any{
+			"score":    pg.resp.Score,
+			"passed":   pg.resp.Passed,
+			"message":  pg.resp.Message,
+			"criteria": pg.resp.Criteria,
+		}
+	}
+
+	return modelbased.Result{
+		Passed:  passed,
+		Score:   totalScore / float64(1+len(plugins)),
+		Message: strings.Join(messages, " | "),
+		Details: details,
+	}
+}
+
+// buildGradeReportSuite converts graded skill results into a ReportSuite
+// so --format can render them as markdown (the report below, unchanged),
+// JSON, JUnit, TAP, or SARIF via the shared formats registry. Each case
+// carries the skill's SKILL.md path so SARIF results point at it.
+// incomplete marks a report produced from a --timeout-cancelled run, so
+// consumers of --format json know the result set is partial.
+func buildGradeReportSuite(results []skillResult, incomplete bool) ReportSuite {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	cases := make([]ReportCase, 0, len(results))
+	for _, r := range results {
+		cases = append(cases, ReportCase{
+			ID:      r.Name,
+			Name:    r.Name,
+			Passed:  r.Passed,
+			Message: r.Message,
+			Score:   r.Score,
+			Path:    r.Path,
+		})
+	}
+
+	totalScore := 0.0
+	passCount := 0
+	for _, r := range results {
+		totalScore += r.Score
+		if r.Passed {
+			passCount++
+		}
+	}
+	avgScore := totalScore / float64(len(results))
+	passRate := float64(passCount) / float64(len(results)) * 100
+
+	return ReportSuite{
+		Name:       "skill-clarity",
+		Markdown:   formatGradeReportMarkdown(results, avgScore, passRate, passCount, incomplete),
+		Cases:      cases,
+		SarifCases: buildGradeCriteriaCases(results),
+		Extra: map[string]any{
+			"totalSkills":      len(results),
+			"averageScore":     avgScore,
+			"passRate":         passRate,
+			"passingThreshold": 70.0,
+			"incomplete":       incomplete,
+		},
+	}
+}
+
+// gradeCriterionPassingScore is the per-criterion score below which
+// buildGradeCriteriaCases treats a criterion as a SARIF-worthy finding,
+// matching the overall 70.0 passing threshold buildGradeReportSuite
+// already reports via Extra["passingThreshold"].
+const gradeCriterionPassingScore = 70.0
+
+// buildGradeCriteriaCases flattens results into one ReportCase per
+// (skill, criterion) pair, used only for --format=sarif (via
+// ReportSuite.SarifCases) so a below-threshold criterion becomes its own
+// SARIF result with ruleId=criterion name and a location pointing at
+// that skill's SKILL.md, rather than one coarse result per skill. Grouped
+// by skill name so results from the same skill read as a unit; entries
+// without a recognized score/feedback shape (e.g. a plugin grader's
+// "grader:<name>" details) are skipped, same as formatGradeReportMarkdown's
+// Detailed Breakdown section.
+func buildGradeCriteriaCases(results []skillResult) []ReportCase {
+	var cases []ReportCase
+	for _, r := range results {
+		criteria := make([]string, 0, len(r.Details))
+		for name := range r.Details {
+			criteria = append(criteria, name)
+		}
+		sort.Strings(criteria)
+
+		for _, criterion := range criteria {
+			details, ok := r.Details[criterion].(map[string]any)
+			if !ok {
+				continue
+			}
+			score, scoreOk := details["score"].(float64)
+			feedback, feedbackOk := details["feedback"].(string)
+			if !scoreOk || !feedbackOk {
+				continue
+			}
+
+			cases = append(cases, ReportCase{
+				// Name deliberately stays skill-agnostic: sarifRenderer
+				// uses the first case seen for a given ID as that rule's
+				// permanent name/shortDescription, so a skill-specific
+				// Name here would mislabel the rule for every other skill
+				// sharing this criterion. Which skill failed is carried by
+				// Message/Path/Group on each individual result instead.
+				ID:      criterion,
+				Name:    formatCriterionName(criterion),
+				Group:   r.Name,
+				Passed:  score >= gradeCriterionPassingScore,
+				Message: fmt.Sprintf("%s: %s", r.Name, feedback),
+				Score:   score,
+				Path:    r.Path,
+			})
+		}
+	}
+	return cases
+}
+
+// findSkillFiles recursively finds all SKILL.md files in the given directory
+func findSkillFiles(rootDir string) ([]string, error) {
+	relFiles, err := findSkillFilesFS(os.DirFS(rootDir))
+	if err != nil {
+		return nil, err
+	}
+
+	skillFiles := make([]string, len(relFiles))
+	for i, rel := range relFiles {
+		skillFiles[i] = filepath.Join(rootDir, rel)
+	}
+	return skillFiles, nil
+}
+
+// generateReport creates a markdown report from grading results
+func generateReport(results []skillResult, reportPath string) error {
+	// Sort results by score (highest to lowest)
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	// Calculate summary statistics
+	totalScore := 0.0
+	passCount := 0
+	for _, r := range results {
+		totalScore += r.Score
+		if r.Passed {
+			passCount++
+		}
+	}
+	avgScore := totalScore / float64(len(results))
+	passRate := float64(passCount) / float64(len(results)) * 100
+
+	content := formatGradeReportMarkdown(results, avgScore, passRate, passCount, false)
+
+	// Write report to file
+	if err := os.WriteFile(reportPath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("writing report file: %w", err)
+	}
+
+	return nil
+}
+
+// formatGradeReportMarkdown renders results (already sorted highest to
+// lowest score) as the skill-clarity markdown report. avgScore, passRate,
+// and passCount are passed in rather than recomputed since both
+// generateReport and buildGradeReportSuite already have them.
+func formatGradeReportMarkdown(results []skillResult, avgScore, passRate float64, passCount int, incomplete bool) string {
+	// Build report content
+	var sb strings.Builder
+
+	// Header
+	sb.WriteString("# Skill Clarity Report\n\n")
+	sb.WriteString(fmt.Sprintf("Generated: %s\n\n", time.Now().Format("2006-01-02 15:04:05")))
+	sb.WriteString("This report evaluates pokayokay skills using the Skill Clarity Grader.\n")
+	sb.WriteString("**Note**: Scores come from the built-in heuristic grader unless grade-skills was run with an LLM --grader or a --spec rubric.\n\n")
+	if incomplete {
+		sb.WriteString("**WARNING**: This run was cancelled by --timeout before every skill finished grading; the results below are a partial set.\n\n")
+	}
+
+	// Summary
+	sb.WriteString("## Summary\n\n")
+	sb.WriteString(fmt.Sprintf("- **Total Skills**: %d\n", len(results)))
+	sb.WriteString(fmt.Sprintf("- **Average Score**: %.1f/100\n", avgScore))
+	sb.WriteString(fmt.Sprintf("- **Pass Rate**: %.1f%% (%d/%d)\n", passRate, passCount, len(results)))
+	sb.WriteString(fmt.Sprintf("- **Passing Threshold**: 70.0\n\n"))
+
+	// Skills below threshold
+	belowThreshold := []skillResult{}
+	for _, r := range results {
+		if r.Score < 80.0 {
+			belowThreshold = append(belowThreshold, r)
+		}
+	}
+
+	if len(belowThreshold) > 0 {
+		sb.WriteString("## Skills Below Threshold (< 80%)\n\n")
+		sb.WriteString("These skills need improvement:\n\n")
+		for _, r := range belowThreshold {
+			status := "Needs Improvement"
+			if r.Score < 70.0 {
+				status = "**FAILED**"
+			}
+			sb.WriteString(fmt.Sprintf("- **%s** - %.1f/100 - %s\n", r.Name, r.Score, status))
+		}
+		sb.WriteString("\n")
+	}
+
+	// Ranked list
+	sb.WriteString("## Skills by Score\n\n")
+	sb.WriteString("All skills ranked from highest to lowest:\n\n")
+	sb.WriteString("| Rank | Skill | Score | Status |\n")
+	sb.WriteString("|------|-------|-------|--------|\n")
+
+	for i, r := range results {
+		status := "✅ Pass"
+		if !r.Passed {
+			status = "❌ Fail"
+		} else if r.Score < 80.0 {
+			status = "⚠️  Pass (Low)"
+		}
+		sb.WriteString(fmt.Sprintf("| %d | %s | %.1f | %s |\n", i+1, r.Name, r.Score, status))
+	}
+	sb.WriteString("\n")
+
+	// Detailed breakdown
+	sb.WriteString("## Detailed Breakdown\n\n")
+	for _, r := range results {
+		sb.WriteString(fmt.Sprintf("### %s\n\n", r.Name))
+		sb.WriteString(fmt.Sprintf("**Overall Score**: %.1f/100 - %s\n\n", r.Score, r.Message))
+		sb.WriteString("**Criteria Scores**:\n\n")
+
+		// Extract and display criteria details. Criteria names come from
+		// whatever the grader produced (the built-in SkillClarityGrader's
+		// fixed four, or a graderspec.Grader's user-defined names), sorted
+		// for deterministic output; entries without a recognized
+		// score/feedback/weight shape (e.g. a plugin grader's
+		// "grader:<name>" details) are skipped below.
+		criteria := make([]string, 0, len(r.Details))
+		for name := range r.Details {
+			criteria = append(criteria, name)
+		}
+		sort.Strings(criteria)
+		for _, criterion := range criteria {
+			if details, ok := r.Details[criterion].(map[string]any); ok {
+				// Safely extract fields with type checking
+				score, scoreOk := details["score"].(float64)
+				feedback, feedbackOk := details["feedback"].(string)
+				weight, weightOk := details["weight"].(float64)
+
+				// Skip this criterion if any field is missing or has wrong type
+				if !scoreOk || !feedbackOk || !weightOk {
+					continue
+				}
+
+				sb.WriteString(fmt.Sprintf("- **%s** (weight: %.0f%%): %.1f/100\n",
+					formatCriterionName(criterion), weight*100, score))
+				sb.WriteString(fmt.Sprintf("  - %s\n", feedback))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// formatCriterionName converts snake_case to Title Case
+func formatCriterionName(name string) string {
+	parts := strings.Split(name, "_")
+	for i, part := range parts {
+		if len(part) > 0 {
+			// Manually title case: capitalize first letter, lowercase the rest
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		}
+	}
+	return strings.Join(parts, " ")
+}
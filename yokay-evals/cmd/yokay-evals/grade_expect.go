@@ -0,0 +1,221 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// expectCommentPattern matches a single inline grading assertion, following
+// the `/* ERROR "rx" */` convention go/analysistest and go/types' check_test.go
+// use for diagnostics: an HTML comment like
+// "<!-- EXPECT overall score>=80 -->" or
+// "<!-- EXPECT criterion=clear_instructions score>=85 feedback~=/clear/i -->".
+var expectCommentPattern = regexp.MustCompile(`<!--\s*EXPECT\s+(.*?)\s*-->`)
+
+// expectationKeys is the set of left-hand-side fields an EXPECT assertion
+// can check, matched against a token's prefix so splitExpectationToken only
+// looks for an operator immediately after the key name -- not anywhere in
+// the token, which would otherwise mis-split a feedback~=/regex/ value
+// that happens to contain ">=" or "<=" itself.
+var expectationKeys = []string{"score", "feedback", "passed"}
+
+// expectationOperators is the set of comparison operators an EXPECT
+// assertion can use, checked longest-first so ">="/"<="/"~=" aren't
+// mistaken for a bare "=".
+var expectationOperators = []string{">=", "<=", "~=", "="}
+
+// verifyExpectations scans md for "<!-- EXPECT ... -->" comments and
+// checks each one against got, returning one error per unmet expectation
+// (annotated with the 1-based line it appeared on, mirroring how
+// analysistest reports "unmet expectation at line N"). A SKILL.md with no
+// EXPECT comments returns no errors.
+func verifyExpectations(md []byte, got skillResult) []error {
+	var errs []error
+
+	for i, line := range strings.Split(string(md), "\n") {
+		m := expectCommentPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		fields := strings.Fields(m[1])
+		if len(fields) == 0 {
+			continue
+		}
+
+		criterion := ""
+		switch {
+		case fields[0] == "overall":
+			fields = fields[1:]
+		case strings.HasPrefix(fields[0], "criterion="):
+			criterion = strings.TrimPrefix(fields[0], "criterion=")
+			fields = fields[1:]
+			if criterion == "" {
+				errs = append(errs, fmt.Errorf("line %d: empty criterion= name", i+1))
+				continue
+			}
+		}
+
+		for _, tok := range fields {
+			if err := checkExpectation(criterion, tok, got); err != nil {
+				errs = append(errs, fmt.Errorf("line %d: %w", i+1, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+// checkExpectation evaluates a single "key<op>value" token (e.g.
+// "score>=80", "feedback~=/clear/i", "passed=true") against either got's
+// overall fields (criterion == "") or the named criterion's Details entry.
+func checkExpectation(criterion, tok string, got skillResult) error {
+	key, op, value, err := splitExpectationToken(tok)
+	if err != nil {
+		return err
+	}
+
+	switch key {
+	case "passed":
+		if op != "=" {
+			return fmt.Errorf("passed only supports = (got %q)", op)
+		}
+		want, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid passed value %q: %w", value, err)
+		}
+		if got.Passed != want {
+			return fmt.Errorf("passed = %v, want %v", got.Passed, want)
+		}
+		return nil
+
+	case "score":
+		if op != ">=" && op != "<=" {
+			return fmt.Errorf("score only supports >= and <= (exact equality on a computed float is fragile), got %q", op)
+		}
+		score, label, err := expectationScore(criterion, got)
+		if err != nil {
+			return err
+		}
+		want, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid score value %q: %w", value, err)
+		}
+		if !compareScore(score, op, want) {
+			return fmt.Errorf("%s score %.1f does not satisfy %s%s", label, score, op, value)
+		}
+		return nil
+
+	case "feedback":
+		if op != "~=" {
+			return fmt.Errorf("feedback only supports ~= (regex), got %q", op)
+		}
+		feedback, label, err := expectationFeedback(criterion, got)
+		if err != nil {
+			return err
+		}
+		re, err := parseExpectationRegex(value)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(feedback) {
+			return fmt.Errorf("%s feedback %q does not match /%s/", label, feedback, value)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown expectation key %q", key)
+	}
+}
+
+// splitExpectationToken splits "key<op>value" into its three parts. It
+// only looks for an operator right after a known key name (expectationKeys)
+// rather than anywhere in tok, so a feedback~=/regex/ value containing its
+// own ">=" or "<=" isn't mistaken for the assertion's own operator.
+func splitExpectationToken(tok string) (key, op, value string, err error) {
+	for _, k := range expectationKeys {
+		rest, ok := strings.CutPrefix(tok, k)
+		if !ok {
+			continue
+		}
+		for _, candidate := range expectationOperators {
+			if v, ok := strings.CutPrefix(rest, candidate); ok {
+				return k, candidate, v, nil
+			}
+		}
+	}
+	return "", "", "", fmt.Errorf("no operator found in expectation %q", tok)
+}
+
+// compareScore applies op to got and want. Callers only reach this with
+// op == ">=" or "<=" (checkExpectation rejects score's other operators
+// before calling it), but it still returns false rather than panicking on
+// anything else.
+func compareScore(got float64, op string, want float64) bool {
+	switch op {
+	case ">=":
+		return got >= want
+	case "<=":
+		return got <= want
+	default:
+		return false
+	}
+}
+
+// expectationScore resolves a score>=N assertion's left-hand side: got's
+// overall Score when criterion is empty, otherwise the named criterion's
+// score from got.Details.
+func expectationScore(criterion string, got skillResult) (score float64, label string, err error) {
+	if criterion == "" {
+		return got.Score, "overall", nil
+	}
+	details, ok := got.Details[criterion].(map[string]any)
+	if !ok {
+		return 0, "", fmt.Errorf("criterion %q not found in graded Details", criterion)
+	}
+	score, ok = details["score"].(float64)
+	if !ok {
+		return 0, "", fmt.Errorf("criterion %q has no numeric score", criterion)
+	}
+	return score, criterion, nil
+}
+
+// expectationFeedback resolves a feedback~=/rx/ assertion's left-hand
+// side: got's overall Message when criterion is empty, otherwise the
+// named criterion's feedback string from got.Details.
+func expectationFeedback(criterion string, got skillResult) (feedback, label string, err error) {
+	if criterion == "" {
+		return got.Message, "overall", nil
+	}
+	details, ok := got.Details[criterion].(map[string]any)
+	if !ok {
+		return "", "", fmt.Errorf("criterion %q not found in graded Details", criterion)
+	}
+	feedback, ok = details["feedback"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("criterion %q has no feedback", criterion)
+	}
+	return feedback, criterion, nil
+}
+
+// parseExpectationRegex compiles a feedback~= value. A "/pattern/flags"
+// literal (e.g. "/clear/i") applies flags as a regexp inline flag group;
+// a bare pattern with no slashes is compiled as-is.
+func parseExpectationRegex(raw string) (*regexp.Regexp, error) {
+	if !strings.HasPrefix(raw, "/") {
+		return regexp.Compile(raw)
+	}
+
+	end := strings.LastIndex(raw, "/")
+	if end <= 0 {
+		return nil, fmt.Errorf("malformed /regex/flags literal %q", raw)
+	}
+
+	pattern := raw[1:end]
+	if flags := raw[end+1:]; flags != "" {
+		pattern = "(?" + flags + ")" + pattern
+	}
+	return regexp.Compile(pattern)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+)
+
+func TestVerifyExpectations(t *testing.T) {
+	result := skillResult{
+		Score:   75.0,
+		Passed:  true,
+		Message: "Skill clarity evaluation passed with score 75.0/100.",
+		Details: map[string]any{
+			"clear_instructions": map[string]any{
+				"score":    75.0,
+				"feedback": "Stub evaluation: Found instruction section",
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		comment string
+		wantErr bool
+	}{
+		{
+			name:    "overall score met",
+			comment: "<!-- EXPECT overall score>=70 -->",
+			wantErr: false,
+		},
+		{
+			name:    "overall score unmet",
+			comment: "<!-- EXPECT overall score>=90 -->",
+			wantErr: true,
+		},
+		{
+			name:    "passed matches",
+			comment: "<!-- EXPECT passed=true -->",
+			wantErr: false,
+		},
+		{
+			name:    "passed mismatches",
+			comment: "<!-- EXPECT passed=false -->",
+			wantErr: true,
+		},
+		{
+			name:    "criterion score and regex feedback both met",
+			comment: "<!-- EXPECT criterion=clear_instructions score>=70 feedback~=/instruction/i -->",
+			wantErr: false,
+		},
+		{
+			name:    "criterion feedback regex unmet",
+			comment: "<!-- EXPECT criterion=clear_instructions feedback~=/nonexistent/ -->",
+			wantErr: true,
+		},
+		{
+			name:    "unknown criterion",
+			comment: "<!-- EXPECT criterion=no_such_criterion score>=0 -->",
+			wantErr: true,
+		},
+		{
+			name:    "non-EXPECT comment is ignored",
+			comment: "<!-- just a regular comment -->",
+			wantErr: false,
+		},
+		{
+			name:    "passed with unsupported operator",
+			comment: "<!-- EXPECT passed>=true -->",
+			wantErr: true,
+		},
+		{
+			name:    "empty criterion name",
+			comment: "<!-- EXPECT criterion= score>=0 -->",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := []byte("# Skill\n\n" + tt.comment + "\n")
+			errs := verifyExpectations(md, result)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("expected an unmet-expectation error, got none")
+			}
+			if !tt.wantErr && len(errs) != 0 {
+				t.Errorf("expected no errors, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestSplitExpectationToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		tok       string
+		wantKey   string
+		wantOp    string
+		wantValue string
+		wantErr   bool
+	}{
+		{name: "score >=", tok: "score>=80", wantKey: "score", wantOp: ">=", wantValue: "80"},
+		{name: "score <=", tok: "score<=80", wantKey: "score", wantOp: "<=", wantValue: "80"},
+		{name: "passed =", tok: "passed=true", wantKey: "passed", wantOp: "=", wantValue: "true"},
+		{
+			// A regex value containing its own ">=" must not be mis-split:
+			// the operator lookup only happens right after the known key
+			// name, not anywhere in the token.
+			name:      "feedback regex containing its own >=",
+			tok:       `feedback~=/\d+>=\d+/`,
+			wantKey:   "feedback",
+			wantOp:    "~=",
+			wantValue: `/\d+>=\d+/`,
+		},
+		{name: "no operator", tok: "score", wantErr: true},
+		{name: "unknown key", tok: "bogus>=1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, op, value, err := splitExpectationToken(tt.tok)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got key=%q op=%q value=%q", key, op, value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if key != tt.wantKey || op != tt.wantOp || value != tt.wantValue {
+				t.Errorf("splitExpectationToken(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					tt.tok, key, op, value, tt.wantKey, tt.wantOp, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestVerifyExpectationsReportsLineNumber(t *testing.T) {
+	md := []byte("# Skill\n\nSome text.\n\n<!-- EXPECT overall score>=90 -->\n")
+	errs := verifyExpectations(md, skillResult{Score: 50.0})
+	if len(errs) != 1 {
+		t.Fatalf("expected exactly one error, got %d: %v", len(errs), errs)
+	}
+	if got, want := errs[0].Error(), "line 5:"; len(got) < len(want) || got[:len(want)] != want {
+		t.Errorf("error = %q, want it to start with %q", got, want)
+	}
+}
+
+// TestSkillsSelfAssert grades every SKILL.md under skills/ and checks its
+// inline "<!-- EXPECT ... -->" comments against the result, turning each
+// SKILL.md into its own executable regression test. Skipped when skills/
+// isn't checked into this tree (the pokayokay skill bundles this command
+// normally grades live outside this repo by default, see --skills-dir).
+func TestSkillsSelfAssert(t *testing.T) {
+	const root = "skills"
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		t.Skip("no skills/ directory checked into this repo to self-assert against")
+	}
+
+	skillFiles, err := findSkillFiles(root)
+	if err != nil {
+		t.Fatalf("findSkillFiles: %v", err)
+	}
+	if len(skillFiles) == 0 {
+		t.Skip("skills/ exists but contains no SKILL.md files")
+	}
+
+	grader := modelbased.NewSkillClarityGrader()
+	for _, path := range skillFiles {
+		path := path
+		t.Run(filepath.Base(filepath.Dir(path)), func(t *testing.T) {
+			md, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading %s: %v", path, err)
+			}
+
+			got, ok := gradeOneSkill(path, grader, nil)
+			if !ok {
+				t.Fatalf("gradeOneSkill failed for %s", path)
+			}
+
+			for _, err := range verifyExpectations(md, got) {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+	"golang.org/x/tools/txtar"
+)
+
+// skillFixtureExpectation is the "-- expected.json --" section of a
+// testdata/skills/*.txtar fixture: a band the graded skillResult's overall
+// score must fall in, the expected Passed flag, a band per named criterion,
+// and an optional substring the result Message must contain.
+type skillFixtureExpectation struct {
+	ScoreMin        float64                         `json:"scoreMin"`
+	ScoreMax        float64                         `json:"scoreMax"`
+	Passed          bool                            `json:"passed"`
+	Criteria        map[string]criterionExpectation `json:"criteria"`
+	MessageContains string                          `json:"messageContains"`
+}
+
+// criterionExpectation is one named criterion's expected score band within
+// a skillFixtureExpectation.
+type criterionExpectation struct {
+	ScoreMin float64 `json:"scoreMin"`
+	ScoreMax float64 `json:"scoreMax"`
+}
+
+// unpackSkillFixture parses the txtar archive at path, writes every section
+// except "expected.json" to t.TempDir() (preserving each section's relative
+// path, so a fixture can bundle a skill's SKILL.md alongside referenced
+// examples or supporting docs), and parses "expected.json" into a
+// skillFixtureExpectation. It fails the test if the archive has no
+// expected.json section.
+func unpackSkillFixture(t *testing.T, path string) (skillsDir string, want skillFixtureExpectation) {
+	t.Helper()
+
+	archive, err := txtar.ParseFile(path)
+	if err != nil {
+		t.Fatalf("parsing archive: %v", err)
+	}
+
+	dir := t.TempDir()
+	var expectedJSON []byte
+	for _, f := range archive.Files {
+		if f.Name == "expected.json" {
+			expectedJSON = f.Data
+			continue
+		}
+
+		dest := filepath.Join(dir, f.Name)
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			t.Fatalf("creating %s: %v", filepath.Dir(dest), err)
+		}
+		if err := os.WriteFile(dest, f.Data, 0644); err != nil {
+			t.Fatalf("writing %s: %v", dest, err)
+		}
+	}
+
+	if expectedJSON == nil {
+		t.Fatalf("archive %s has no expected.json section", path)
+	}
+	if err := json.Unmarshal(expectedJSON, &want); err != nil {
+		t.Fatalf("parsing expected.json: %v", err)
+	}
+
+	return dir, want
+}
+
+// TestGradeSkillsFixtures walks testdata/skills/*.txtar, each of which
+// bundles one skill's SKILL.md (plus any referenced supporting files)
+// alongside its expected grading outcome, and checks that the heuristic
+// SkillClarityGrader still produces it. This follows the same txtar
+// fixture pattern as TestGoldenReports: add a rubric case by dropping in
+// an archive rather than hand-building a skill directory with
+// os.MkdirAll/os.WriteFile in Go code.
+func TestGradeSkillsFixtures(t *testing.T) {
+	archives, err := filepath.Glob("testdata/skills/*.txtar")
+	if err != nil {
+		t.Fatalf("globbing testdata/skills: %v", err)
+	}
+	if len(archives) == 0 {
+		t.Fatal("no .txtar fixtures found under testdata/skills")
+	}
+
+	for _, archivePath := range archives {
+		archivePath := archivePath
+		t.Run(filepath.Base(archivePath), func(t *testing.T) {
+			skillsDir, want := unpackSkillFixture(t, archivePath)
+
+			skillFiles, err := findSkillFiles(skillsDir)
+			if err != nil {
+				t.Fatalf("findSkillFiles: %v", err)
+			}
+			if len(skillFiles) != 1 {
+				t.Fatalf("expected exactly one SKILL.md in fixture, found %d: %v", len(skillFiles), skillFiles)
+			}
+
+			got, ok := gradeOneSkill(skillFiles[0], modelbased.NewSkillClarityGrader(), nil)
+			if !ok {
+				t.Fatal("gradeOneSkill failed")
+			}
+
+			if got.Score < want.ScoreMin || got.Score > want.ScoreMax {
+				t.Errorf("Score = %.1f, want in [%.1f, %.1f]", got.Score, want.ScoreMin, want.ScoreMax)
+			}
+			if got.Passed != want.Passed {
+				t.Errorf("Passed = %v, want %v", got.Passed, want.Passed)
+			}
+			if want.MessageContains != "" && !strings.Contains(got.Message, want.MessageContains) {
+				t.Errorf("Message = %q, want it to contain %q", got.Message, want.MessageContains)
+			}
+
+			for name, wantCriterion := range want.Criteria {
+				details, ok := got.Details[name].(map[string]any)
+				if !ok {
+					t.Errorf("Details[%q] missing or not a map[string]any", name)
+					continue
+				}
+				score, ok := details["score"].(float64)
+				if !ok {
+					t.Errorf("Details[%q][\"score\"] missing or not a float64", name)
+					continue
+				}
+				if score < wantCriterion.ScoreMin || score > wantCriterion.ScoreMax {
+					t.Errorf("Details[%q] score = %.1f, want in [%.1f, %.1f]", name, score, wantCriterion.ScoreMin, wantCriterion.ScoreMax)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"path"
+
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+	"github.com/stevestomp/yokay-evals/internal/graders/plugin"
+)
+
+// findSkillFilesFS recursively finds every SKILL.md in fsys, returning
+// paths relative to fsys's root (fs.FS slash-separated form, suitable for
+// fs.ReadFile). findSkillFiles is a thin os.DirFS wrapper around this so
+// tests can cover the walk with a testing/fstest.MapFS instead of writing
+// real files to a temp dir.
+func findSkillFilesFS(fsys fs.FS) ([]string, error) {
+	var skillFiles []string
+
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == "SKILL.md" {
+			skillFiles = append(skillFiles, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return skillFiles, nil
+}
+
+// Options configures gradeSkillsFS: Grader defaults to a plain
+// modelbased.NewSkillClarityGrader() when left nil, and Plugins is
+// merged into each result the same way gradeSkillsWithFormats' plugin
+// discovery already is.
+type Options struct {
+	Grader  modelbased.Grader
+	Plugins []*plugin.Grader
+}
+
+// gradeOneSkillFS is gradeOneSkill's fs.FS-based core: it reads path from
+// fsys rather than the filesystem directly, so embedded bundles
+// (//go:embed skills/*), zip archives, or an in-memory testing/fstest.MapFS
+// can be graded without ever touching disk.
+func gradeOneSkillFS(fsys fs.FS, skillPath string, grader modelbased.Grader, plugins []*plugin.Grader) (skillResult, bool) {
+	return gradeOneSkillFSDisplay(fsys, skillPath, skillPath, grader, plugins)
+}
+
+// gradeOneSkillFSDisplay is gradeOneSkillFS's implementation, taking a
+// separate displayPath for log messages, the GradeInput path context, and
+// the skillResult's Name/Path. gradeOneSkillFS passes skillPath for both;
+// gradeOneSkill's disk-based wrapper roots fsys at skillPath's directory
+// (so fs.FS only ever sees the base name) but still wants the full disk
+// path in anything user-visible.
+func gradeOneSkillFSDisplay(fsys fs.FS, skillPath, displayPath string, grader modelbased.Grader, plugins []*plugin.Grader) (skillResult, bool) {
+	content, err := fs.ReadFile(fsys, skillPath)
+	if err != nil {
+		log.Printf("Warning: Failed to read %s: %v", displayPath, err)
+		return skillResult{}, false
+	}
+
+	result, err := grader.Grade(modelbased.GradeInput{
+		Content: string(content),
+		Context: map[string]any{
+			"path": displayPath,
+		},
+		Kind: "skill",
+	})
+	if err != nil {
+		log.Printf("Warning: Failed to grade %s: %v", displayPath, err)
+		return skillResult{}, false
+	}
+
+	merged := mergeGraderResults(result, gradeWithPlugins(plugins, "skill", string(content), map[string]any{"path": displayPath}))
+	skillName := path.Base(path.Dir(displayPath))
+
+	return skillResult{
+		Name:    skillName,
+		Path:    displayPath,
+		Score:   merged.Score,
+		Passed:  merged.Passed,
+		Message: merged.Message,
+		Details: merged.Details,
+	}, true
+}
+
+// gradeSkillsFS finds and grades every skill in fsys, serially (callers
+// that need gradeSkillsParallel's worker pool, rate limiting, or
+// --timeout cancellation still go through gradeSkillsWithFormats on a
+// real directory). opts.Grader defaults to the heuristic
+// SkillClarityGrader when unset.
+func gradeSkillsFS(fsys fs.FS, opts Options) ([]skillResult, error) {
+	skillFiles, err := findSkillFilesFS(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("finding skill files: %w", err)
+	}
+	if len(skillFiles) == 0 {
+		return nil, fmt.Errorf("no skill files found")
+	}
+
+	grader := opts.Grader
+	if grader == nil {
+		grader = modelbased.NewSkillClarityGrader()
+	}
+
+	var results []skillResult
+	for _, skillPath := range skillFiles {
+		result, ok := gradeOneSkillFS(fsys, skillPath, grader, opts.Plugins)
+		if ok {
+			results = append(results, result)
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no skills were successfully graded")
+	}
+
+	return results, nil
+}
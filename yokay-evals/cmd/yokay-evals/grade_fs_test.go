@@ -0,0 +1,93 @@
+package main
+
+import (
+	"sort"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+)
+
+func TestFindSkillFilesFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"clear-skill/SKILL.md":           {Data: []byte("# Clear Skill")},
+		"sparse-skill/SKILL.md":          {Data: []byte("# Sparse Skill")},
+		"clear-skill/examples/sample.md": {Data: []byte("not a skill file")},
+		"README.md":                      {Data: []byte("not a skill file either")},
+	}
+
+	got, err := findSkillFilesFS(fsys)
+	if err != nil {
+		t.Fatalf("findSkillFilesFS: %v", err)
+	}
+	sort.Strings(got)
+
+	want := []string{"clear-skill/SKILL.md", "sparse-skill/SKILL.md"}
+	if len(got) != len(want) {
+		t.Fatalf("findSkillFilesFS = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("findSkillFilesFS[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestGradeSkillsFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"clear-skill/SKILL.md": {Data: []byte(`---
+name: clear-skill
+description: A well-documented skill
+---
+
+# Clear Skill
+
+## Instructions
+
+1. First step
+2. Second step
+
+## Examples
+
+Here's an example of how to use this skill.
+`)},
+	}
+
+	results, err := gradeSkillsFS(fsys, Options{})
+	if err != nil {
+		t.Fatalf("gradeSkillsFS: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("gradeSkillsFS returned %d results, want 1", len(results))
+	}
+	if results[0].Name != "clear-skill" {
+		t.Errorf("Name = %q, want %q", results[0].Name, "clear-skill")
+	}
+	if results[0].Path != "clear-skill/SKILL.md" {
+		t.Errorf("Path = %q, want %q", results[0].Path, "clear-skill/SKILL.md")
+	}
+}
+
+func TestGradeSkillsFSNoSkills(t *testing.T) {
+	fsys := fstest.MapFS{
+		"README.md": {Data: []byte("nothing to grade here")},
+	}
+
+	if _, err := gradeSkillsFS(fsys, Options{}); err == nil {
+		t.Fatal("expected an error when fsys has no SKILL.md files")
+	}
+}
+
+func TestGradeSkillsFSUsesProvidedGrader(t *testing.T) {
+	fsys := fstest.MapFS{
+		"my-skill/SKILL.md": {Data: []byte("# My Skill")},
+	}
+
+	results, err := gradeSkillsFS(fsys, Options{Grader: modelbased.NewSkillClarityGrader()})
+	if err != nil {
+		t.Fatalf("gradeSkillsFS: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("gradeSkillsFS returned %d results, want 1", len(results))
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+	"github.com/stevestomp/yokay-evals/internal/graders/plugin"
+)
+
+// gradeOneSkill reads and grades a single skill, merging the built-in
+// grader's result with every plugin that supports the "skill" kind. The
+// second return value is false when the skill couldn't be read or
+// graded (already logged), mirroring the `continue` behavior the
+// original serial loop used. It's a thin os.DirFS wrapper over
+// gradeOneSkillFSDisplay, rooting fsys at skillPath's directory (since
+// fs.FS paths can't be absolute) while passing the full disk path through
+// as displayPath so log output, plugin context, and the result's
+// Name/Path all still reflect it.
+func gradeOneSkill(skillPath string, grader modelbased.Grader, plugins []*plugin.Grader) (skillResult, bool) {
+	dir := filepath.Dir(skillPath)
+	return gradeOneSkillFSDisplay(os.DirFS(dir), filepath.Base(skillPath), skillPath, grader, plugins)
+}
+
+// gradeProgress prints per-skill completion lines through a single writer
+// goroutine, so progress lines can't interleave mid-line the way
+// concurrent fmt.Printf calls would. done is an atomic counter, so report
+// is safe to call from several goroutines; gradeSkillsParallel calls it
+// from its one result-collecting loop.
+type gradeProgress struct {
+	total   int
+	done    int64
+	updates chan string
+	wg      sync.WaitGroup
+}
+
+func newGradeProgress(total int) *gradeProgress {
+	p := &gradeProgress{total: total, updates: make(chan string)}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		for line := range p.updates {
+			fmt.Println(line)
+		}
+	}()
+	return p
+}
+
+// report records one skill's completion and sends its progress line to
+// the writer goroutine.
+func (p *gradeProgress) report(skillName string) {
+	n := atomic.AddInt64(&p.done, 1)
+	p.updates <- fmt.Sprintf("[%d/%d] Graded %s", n, p.total, skillName)
+}
+
+// close stops accepting updates and waits for the writer goroutine to
+// drain them.
+func (p *gradeProgress) close() {
+	close(p.updates)
+	p.wg.Wait()
+}
+
+// perWorkerLimiter paces a single worker to at most rps Grade calls per
+// second. Each worker gets its own limiter (hence "per-worker"), so
+// total throughput scales with parallelism * rps.
+type perWorkerLimiter struct {
+	ticker *time.Ticker
+}
+
+func newPerWorkerLimiter(rps float64) *perWorkerLimiter {
+	return &perWorkerLimiter{ticker: time.NewTicker(time.Duration(float64(time.Second) / rps))}
+}
+
+// wait blocks until the next tick or ctx is done, whichever comes first.
+func (l *perWorkerLimiter) wait(ctx context.Context) error {
+	select {
+	case <-l.ticker.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *perWorkerLimiter) stop() {
+	l.ticker.Stop()
+}
+
+// gradeSkillsParallel fans skillFiles out across a bounded worker pool
+// (parallelism workers, defaulting to GOMAXPROCS when <= 0), each
+// optionally rate-limited to rps Grade calls/sec (unlimited when <= 0).
+// If ctx is cancelled (e.g. by the caller's --timeout) before every
+// skill finishes, it stops dispatching new work and returns whatever
+// results had already completed, with incomplete=true so the caller can
+// mark the report as partial.
+func gradeSkillsParallel(ctx context.Context, skillFiles []string, grader modelbased.Grader, plugins []*plugin.Grader, parallelism int, rps float64) (results []skillResult, incomplete bool) {
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+
+	jobs := make(chan int)
+	type outcome struct {
+		name   string
+		result skillResult
+		ok     bool
+	}
+	outcomes := make(chan outcome, len(skillFiles))
+
+	var workers sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+
+			var limiter *perWorkerLimiter
+			if rps > 0 {
+				limiter = newPerWorkerLimiter(rps)
+				defer limiter.stop()
+			}
+
+			for idx := range jobs {
+				if limiter != nil {
+					if err := limiter.wait(ctx); err != nil {
+						return
+					}
+				} else if ctx.Err() != nil {
+					return
+				}
+
+				name := filepath.Base(filepath.Dir(skillFiles[idx]))
+				result, ok := gradeOneSkill(skillFiles[idx], grader, plugins)
+				outcomes <- outcome{name: name, result: result, ok: ok}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i := range skillFiles {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		workers.Wait()
+		close(outcomes)
+	}()
+
+	progress := newGradeProgress(len(skillFiles))
+	for o := range outcomes {
+		if o.ok {
+			results = append(results, o.result)
+		}
+		progress.report(o.name)
+	}
+	progress.close()
+
+	return results, ctx.Err() != nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestBuildGradeReportSuiteSarifCasesPerCriterion verifies that
+// --format=sarif surfaces one result per below-threshold criterion
+// (ruleId=criterion name, location=that skill's SKILL.md), not one
+// coarse result per skill the way Cases (used by json/junit) do.
+func TestBuildGradeReportSuiteSarifCasesPerCriterion(t *testing.T) {
+	results := []skillResult{
+		{
+			Name:   "weak-skill",
+			Path:   "skills/weak-skill/SKILL.md",
+			Score:  55.0,
+			Passed: false,
+			Details: map[string]any{
+				"clear_instructions": map[string]any{
+					"score":    40.0,
+					"feedback": "Instructions are too vague",
+				},
+				"good_examples": map[string]any{
+					"score":    90.0,
+					"feedback": "Plenty of examples",
+				},
+			},
+		},
+		{
+			Name:   "strong-skill",
+			Path:   "skills/strong-skill/SKILL.md",
+			Score:  95.0,
+			Passed: true,
+			Details: map[string]any{
+				"clear_instructions": map[string]any{
+					"score":    95.0,
+					"feedback": "Very clear",
+				},
+			},
+		},
+	}
+
+	suite := buildGradeReportSuite(results, false)
+
+	// Cases stays one-per-skill, for json/junit's CI-friendly pass/fail
+	// semantics.
+	if len(suite.Cases) != 2 {
+		t.Fatalf("Cases: got %d entries, want 2 (one per skill)", len(suite.Cases))
+	}
+
+	// SarifCases is the finer-grained one-per-criterion breakdown.
+	if len(suite.SarifCases) != 3 {
+		t.Fatalf("SarifCases: got %d entries, want 3 (one per criterion across both skills)", len(suite.SarifCases))
+	}
+
+	rendered, err := renderReportSuite(suite, "sarif")
+	if err != nil {
+		t.Fatalf("rendering sarif: %v", err)
+	}
+
+	if !strings.Contains(rendered, `"ruleId": "clear_instructions"`) {
+		t.Errorf("expected ruleId clear_instructions, got: %s", rendered)
+	}
+	if !strings.Contains(rendered, `"uri": "skills/weak-skill/SKILL.md"`) {
+		t.Errorf("expected location pointing at weak-skill's SKILL.md, got: %s", rendered)
+	}
+	if !strings.Contains(rendered, `"level": "error"`) {
+		t.Errorf("expected a score of 40 to band as error, got: %s", rendered)
+	}
+	if strings.Count(rendered, `"id": "good_examples"`) == 0 {
+		t.Errorf("expected a rule entry for good_examples (a passing criterion still gets a rule, just no result), got: %s", rendered)
+	}
+	if strings.Contains(rendered, "Very clear") {
+		t.Errorf("passing criterion clear_instructions on strong-skill should produce no SARIF result, got: %s", rendered)
+	}
+	if !strings.Contains(rendered, `"name": "Clear Instructions"`) {
+		t.Errorf("expected the clear_instructions rule name to stay skill-agnostic, got: %s", rendered)
+	}
+	if strings.Contains(rendered, "strong-skill: Clear Instructions") {
+		t.Errorf("rule name/shortDescription must not be baked from whichever skill instance is seen first, got: %s", rendered)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+	"github.com/stevestomp/yokay-evals/internal/graderspec"
+	"gopkg.in/yaml.v3"
+)
+
+// graderTestRange is an inclusive [Min, Max] tolerance band for a score
+// comparison in expected.yaml; a fixture that should score exactly N
+// writes min and max both as N.
+type graderTestRange struct {
+	Min float64 `yaml:"min"`
+	Max float64 `yaml:"max"`
+}
+
+func (r graderTestRange) contains(score float64) bool {
+	return score >= r.Min && score <= r.Max
+}
+
+type graderTestCriterion struct {
+	Score            graderTestRange `yaml:"score"`
+	FeedbackContains string          `yaml:"feedbackContains"`
+}
+
+// graderTestExpectation is the shape of a graderTests/*/expected.yaml
+// file: the overall result expected from grading that case's skill.md
+// against its rubric.yaml.
+type graderTestExpectation struct {
+	Passed          bool                           `yaml:"passed"`
+	Score           graderTestRange                `yaml:"score"`
+	MessageContains string                         `yaml:"messageContains"`
+	Criteria        map[string]graderTestCriterion `yaml:"criteria"`
+}
+
+// TestGraderCorpus walks graderTests/, grading each subdirectory's
+// skill.md against its rubric.yaml and checking the result against
+// expected.yaml. Set TEST_ONLY to a subdirectory name to run a single
+// case while iterating on it.
+func TestGraderCorpus(t *testing.T) {
+	const corpusDir = "graderTests"
+
+	entries, err := os.ReadDir(corpusDir)
+	if err != nil {
+		t.Fatalf("reading %s: %v", corpusDir, err)
+	}
+
+	only := os.Getenv("TEST_ONLY")
+	var ran int
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if only != "" && entry.Name() != only {
+			continue
+		}
+		ran++
+
+		dir := filepath.Join(corpusDir, entry.Name())
+		t.Run(entry.Name(), func(t *testing.T) {
+			grader, err := graderspec.NewGraderFromSpec(filepath.Join(dir, "rubric.yaml"))
+			if err != nil {
+				t.Fatalf("loading rubric: %v", err)
+			}
+
+			content, err := os.ReadFile(filepath.Join(dir, "skill.md"))
+			if err != nil {
+				t.Fatalf("reading skill.md: %v", err)
+			}
+
+			result, err := grader.Grade(modelbased.GradeInput{Content: string(content), Kind: "skill"})
+			if err != nil {
+				t.Fatalf("grading skill.md: %v", err)
+			}
+
+			expectedData, err := os.ReadFile(filepath.Join(dir, "expected.yaml"))
+			if err != nil {
+				t.Fatalf("reading expected.yaml: %v", err)
+			}
+			var want graderTestExpectation
+			if err := yaml.Unmarshal(expectedData, &want); err != nil {
+				t.Fatalf("parsing expected.yaml: %v", err)
+			}
+
+			if result.Passed != want.Passed {
+				t.Errorf("Passed = %v, want %v", result.Passed, want.Passed)
+			}
+			if !want.Score.contains(result.Score) {
+				t.Errorf("Score = %v, want in [%v, %v]", result.Score, want.Score.Min, want.Score.Max)
+			}
+			if want.MessageContains != "" && !strings.Contains(result.Message, want.MessageContains) {
+				t.Errorf("Message = %q, want substring %q", result.Message, want.MessageContains)
+			}
+
+			for name, wantCriterion := range want.Criteria {
+				details, ok := result.Details[name].(map[string]any)
+				if !ok {
+					t.Errorf("Details[%q] missing or not a map", name)
+					continue
+				}
+				score, _ := details["score"].(float64)
+				if !wantCriterion.Score.contains(score) {
+					t.Errorf("Details[%q].score = %v, want in [%v, %v]", name, score, wantCriterion.Score.Min, wantCriterion.Score.Max)
+				}
+				if wantCriterion.FeedbackContains != "" {
+					feedback, _ := details["feedback"].(string)
+					if !strings.Contains(feedback, wantCriterion.FeedbackContains) {
+						t.Errorf("Details[%q].feedback = %q, want substring %q", name, feedback, wantCriterion.FeedbackContains)
+					}
+				}
+			}
+		})
+	}
+
+	if only != "" && ran == 0 {
+		t.Fatalf("TEST_ONLY=%q matched no cases in %s", only, corpusDir)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// sampleDraftInput is a full set of answers for promptSkillDraft's seven
+// prompts, in order: name, purpose, triggers, example input, example
+// output, in scope, out of scope.
+const sampleDraftInput = "widget-namer\n" +
+	"Generates a consistent name for a new UI widget.\n" +
+	"When a developer is adding a new widget and needs a name.\n" +
+	"component type: button\n" +
+	"widget-button-primary\n" +
+	"Naming UI widgets.\n" +
+	"Naming API endpoints.\n"
+
+func TestRunInitCommandRefusesBelowMinScore(t *testing.T) {
+	skillsDir := t.TempDir()
+	var out bytes.Buffer
+
+	err := runInitCommand(strings.NewReader(sampleDraftInput), &out, skillsDir, "", "", false, 80)
+	if err == nil {
+		t.Fatal("expected an error refusing to write a draft below --min-score, got nil")
+	}
+	if !strings.Contains(err.Error(), "below --min-score") {
+		t.Errorf("expected a min-score refusal error, got: %v", err)
+	}
+
+	if _, statErr := os.Stat(filepath.Join(skillsDir, "widget-namer", "SKILL.md")); !os.IsNotExist(statErr) {
+		t.Errorf("expected no SKILL.md to be written, stat returned: %v", statErr)
+	}
+}
+
+func TestRunInitCommandWritesWhenScorePasses(t *testing.T) {
+	skillsDir := t.TempDir()
+	var out bytes.Buffer
+
+	// The stub SkillClarityGrader's four criteria each cap at 75 (see
+	// skill_clarity.go), so 70 - the grader's own passing threshold - is
+	// the min-score that the default template can actually clear today.
+	if err := runInitCommand(strings.NewReader(sampleDraftInput), &out, skillsDir, "", "", false, 70); err != nil {
+		t.Fatalf("runInitCommand: %v", err)
+	}
+
+	skillPath := filepath.Join(skillsDir, "widget-namer", "SKILL.md")
+	content, err := os.ReadFile(skillPath)
+	if err != nil {
+		t.Fatalf("expected %s to be written: %v", skillPath, err)
+	}
+	if !strings.Contains(string(content), "widget-button-primary") {
+		t.Errorf("expected rendered SKILL.md to contain the example output, got:\n%s", content)
+	}
+}
+
+func TestRunInitCommandDryRunDoesNotWrite(t *testing.T) {
+	skillsDir := t.TempDir()
+	var out bytes.Buffer
+
+	if err := runInitCommand(strings.NewReader(sampleDraftInput), &out, skillsDir, "", "", true, 80); err != nil {
+		t.Fatalf("runInitCommand: %v", err)
+	}
+	if !strings.Contains(out.String(), "dry run: not written") {
+		t.Errorf("expected dry-run output to note the draft wasn't written, got:\n%s", out.String())
+	}
+	if _, statErr := os.Stat(filepath.Join(skillsDir, "widget-namer", "SKILL.md")); !os.IsNotExist(statErr) {
+		t.Errorf("expected no SKILL.md to be written in dry-run mode, stat returned: %v", statErr)
+	}
+}
+
+func TestRunInitCommandRefusesExistingFile(t *testing.T) {
+	skillsDir := t.TempDir()
+	var out bytes.Buffer
+
+	if err := runInitCommand(strings.NewReader(sampleDraftInput), &out, skillsDir, "", "", false, 70); err != nil {
+		t.Fatalf("first runInitCommand: %v", err)
+	}
+
+	out.Reset()
+	err := runInitCommand(strings.NewReader(sampleDraftInput), &out, skillsDir, "", "", false, 70)
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected an 'already exists' error on the second write, got: %v", err)
+	}
+}
@@ -1,18 +1,43 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hash/fnv"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/stevestomp/yokay-evals/internal/failurecase"
+	"github.com/stevestomp/yokay-evals/internal/testsupport"
+	"gopkg.in/yaml.v3"
+)
+
+// Flags for TestFailureCaseRegression, following the pattern of Go's
+// cmd/internal/testdir runner: -shard/-shards split a large failure-case
+// corpus across CI runners, and -n bounds how many cases are validated
+// concurrently within a shard.
+var (
+	regressionShard   = flag.Int("shard", 0, "0-based shard index for TestFailureCaseRegression")
+	regressionShards  = flag.Int("shards", 1, "total number of shards for TestFailureCaseRegression")
+	regressionWorkers = flag.Int("n", 1, "number of failure cases to validate concurrently within this shard")
+
+	// updateExpectations mirrors Go testdir's -update_errors: when set,
+	// TestFailureCaseLiveExpectations rewrites each case's eval_criteria
+	// check fields from a live binary run instead of failing on a
+	// mismatch.
+	updateExpectations = flag.Bool("update", false, "regenerate eval_criteria check fields from live runs instead of failing on mismatch")
 )
 
 // TestGradeSkillsCommandIntegration tests the grade-skills command end-to-end
 func TestGradeSkillsCommandIntegration(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	// Setup: Create temp directory for test output
 	tmpDir := t.TempDir()
@@ -63,8 +88,8 @@ Here's an example of how to use this skill.
 
 	// Verify stdout contains success message
 	outputStr := string(output)
-	if !strings.Contains(outputStr, "Report generated") {
-		t.Errorf("Output missing 'Report generated' message. Got: %s", outputStr)
+	if !strings.Contains(outputStr, "written to:") {
+		t.Errorf("Output missing 'written to:' message. Got: %s", outputStr)
 	}
 
 	// Verify report was created
@@ -98,8 +123,7 @@ Here's an example of how to use this skill.
 // TestGradeSkillsCommandInvalidDirectory tests error handling for non-existent directory
 func TestGradeSkillsCommandInvalidDirectory(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	tmpDir := t.TempDir()
 	reportPath := filepath.Join(tmpDir, "report.md")
@@ -127,8 +151,7 @@ func TestGradeSkillsCommandInvalidDirectory(t *testing.T) {
 // TestGradeSkillsCommandNoSkills tests error handling when no skills found
 func TestGradeSkillsCommandNoSkills(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	tmpDir := t.TempDir()
 	reportPath := filepath.Join(tmpDir, "report.md")
@@ -163,8 +186,7 @@ func TestGradeSkillsCommandNoSkills(t *testing.T) {
 // TestMetaCommandIntegration tests the meta command end-to-end
 func TestMetaCommandIntegration(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	// Setup: Create temp directory with test meta structure
 	tmpDir := t.TempDir()
@@ -234,8 +256,7 @@ test_cases:
 // TestMetaCommandWithKOverride tests that --k flag overrides YAML k value
 func TestMetaCommandWithKOverride(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	// Setup
 	tmpDir := t.TempDir()
@@ -287,11 +308,72 @@ test_cases:
 	}
 }
 
+// TestMetaCommandParallelSpeedup tests that --parallel N evaluates a suite
+// faster than --parallel 1 by wiring the stub adapter's delay_ms into
+// several slow-running test cases.
+func TestMetaCommandParallelSpeedup(t *testing.T) {
+	// Build the binary first
+	binaryPath := testsupport.BuildYokayEvals(t)
+
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	agentDir := filepath.Join(metaDir, "agents", "test-agent")
+	err := os.MkdirAll(agentDir, 0755)
+	if err != nil {
+		t.Fatalf("Failed to create test agent dir: %v", err)
+	}
+
+	// 6 test cases x k=3 runs x 50ms/run = 900ms serial, but each run is
+	// independent so --parallel should shrink that a lot.
+	var sb strings.Builder
+	sb.WriteString("agent: yokay-test-agent\n")
+	sb.WriteString("consistency_threshold: 0.95\n")
+	sb.WriteString("adapter: stub\n")
+	sb.WriteString("adapter_config:\n  delay_ms: 50\n")
+	sb.WriteString("test_cases:\n")
+	for i := 1; i <= 6; i++ {
+		sb.WriteString(fmt.Sprintf(`  - id: TST-%03d
+    name: "Slow case %d"
+    input:
+      task_title: "Test"
+      task_description: "Test description"
+      implementation: "code"
+    expected: PASS
+    k: 3
+    rationale: "Should pass"
+`, i, i))
+	}
+
+	evalPath := filepath.Join(agentDir, "eval.yaml")
+	if err := os.WriteFile(evalPath, []byte(sb.String()), 0644); err != nil {
+		t.Fatalf("Failed to write test eval.yaml: %v", err)
+	}
+
+	serialStart := time.Now()
+	serialCmd := exec.Command(binaryPath, "meta", "--agent", "test-agent", "--meta-dir", metaDir, "--parallel", "1")
+	serialOutput, err := serialCmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Serial command failed: %v\nOutput: %s", err, string(serialOutput))
+	}
+	serialElapsed := time.Since(serialStart)
+
+	parallelStart := time.Now()
+	parallelCmd := exec.Command(binaryPath, "meta", "--agent", "test-agent", "--meta-dir", metaDir, "--parallel", "6")
+	parallelOutput, err := parallelCmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Parallel command failed: %v\nOutput: %s", err, string(parallelOutput))
+	}
+	parallelElapsed := time.Since(parallelStart)
+
+	if parallelElapsed >= serialElapsed {
+		t.Errorf("Expected --parallel 6 (%s) to be faster than --parallel 1 (%s)", parallelElapsed, serialElapsed)
+	}
+}
+
 // TestMetaCommandInvalidAgent tests error handling for non-existent agent
 func TestMetaCommandInvalidAgent(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	tmpDir := t.TempDir()
 	metaDir := filepath.Join(tmpDir, "meta")
@@ -323,8 +405,7 @@ func TestMetaCommandInvalidAgent(t *testing.T) {
 // TestMetaCommandNoSuiteOrAgent tests error handling when neither suite nor agent specified
 func TestMetaCommandNoSuiteOrAgent(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	tmpDir := t.TempDir()
 	metaDir := filepath.Join(tmpDir, "meta")
@@ -356,8 +437,7 @@ func TestMetaCommandNoSuiteOrAgent(t *testing.T) {
 // TestUnknownCommand tests error handling for unknown commands
 func TestUnknownCommand(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	// Execute with unknown command
 	cmd := exec.Command(binaryPath, "unknown-command")
@@ -372,18 +452,17 @@ func TestUnknownCommand(t *testing.T) {
 		t.Error("Expected non-zero exit code for unknown command")
 	}
 
-	// Verify error message
+	// Verify error message (cobra-generated usage text)
 	outputStr := string(output)
-	if !strings.Contains(outputStr, "Unknown command") {
-		t.Errorf("Expected 'Unknown command' error. Got: %s", outputStr)
+	if !strings.Contains(outputStr, "unknown command") {
+		t.Errorf("Expected 'unknown command' error. Got: %s", outputStr)
 	}
 }
 
 // TestNoCommand tests error handling when no command is provided
 func TestNoCommand(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	// Execute with no arguments
 	cmd := exec.Command(binaryPath)
@@ -413,8 +492,7 @@ func TestNoCommand(t *testing.T) {
 // TestGradeSkillsCommandMultipleSkills tests grading multiple skills at once
 func TestGradeSkillsCommandMultipleSkills(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	// Setup: Create temp directory for test output
 	tmpDir := t.TempDir()
@@ -553,8 +631,7 @@ Detailed examples here.
 // TestMetaCommandMultipleTestCases tests running meta eval with multiple test cases
 func TestMetaCommandMultipleTestCases(t *testing.T) {
 	// Build the binary first
-	binaryPath := buildBinary(t)
-	defer os.Remove(binaryPath)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
 	// Setup
 	tmpDir := t.TempDir()
@@ -640,118 +717,67 @@ test_cases:
 
 // TestLoadActualFailureCase tests that the CLI can load and parse an actual failure case
 func TestLoadActualFailureCase(t *testing.T) {
-	// This test verifies that actual failure case YAML files from /yokay-evals/failures/
-	// can be properly loaded and parsed by the system
-
-	failureCasePath := filepath.Join("/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures/missed-tasks/MT-002.yaml")
-
-	// Verify the failure case file exists
-	if _, err := os.Stat(failureCasePath); os.IsNotExist(err) {
-		t.Fatalf("Failure case file does not exist: %s", failureCasePath)
-	}
-
-	// Read the failure case file
-	content, err := os.ReadFile(failureCasePath)
+	failuresDir, err := testsupport.FailuresDir()
 	if err != nil {
-		t.Fatalf("Failed to read failure case: %v", err)
+		t.Fatalf("resolving failures dir: %v", err)
 	}
-
-	// Verify content is not empty
-	if len(content) == 0 {
-		t.Fatal("Failure case file is empty")
-	}
-
-	contentStr := string(content)
-
-	// Verify required fields are present in the YAML
-	requiredFields := []string{
-		"id:", "category:", "discovered:", "severity:",
-		"context:", "task:", "failure:", "description:",
-		"root_cause:", "evidence:", "task_spec:", "what_was_built:",
-		"eval_criteria:",
+	if _, err := os.Stat(failuresDir); os.IsNotExist(err) {
+		t.Skipf("failures directory not available in this environment: %s", failuresDir)
 	}
+	failureCasePath := filepath.Join(failuresDir, "missed-tasks", "MT-002.yaml")
 
-	for _, field := range requiredFields {
-		if !strings.Contains(contentStr, field) {
-			t.Errorf("Failure case missing required field: %s", field)
-		}
+	fc, diags, err := failurecase.Load(failureCasePath)
+	if err != nil {
+		t.Fatalf("Failed to load failure case: %v", err)
 	}
-
-	// Verify specific values for MT-002
-	expectedValues := map[string]string{
-		"id:":       "MT-002",
-		"category:": "missed-tasks",
-		"severity:": "high",
+	for _, d := range diags {
+		t.Errorf("unexpected diagnostic: %s", d)
 	}
 
-	for field, expected := range expectedValues {
-		if !strings.Contains(contentStr, field) || !strings.Contains(contentStr, expected) {
-			t.Errorf("Expected %s to contain '%s'", field, expected)
-		}
+	if fc.ID != "MT-002" || fc.Category != "missed-tasks" || fc.Severity != "high" {
+		t.Errorf("Load(%s) = %+v, want ID=MT-002 Category=missed-tasks Severity=high", failureCasePath, fc)
 	}
 }
 
 // TestLoadMultipleFailureCases tests loading failure cases from different categories
 func TestLoadMultipleFailureCases(t *testing.T) {
-	// Test that we can load failure cases from different categories
+	failuresDir, err := testsupport.FailuresDir()
+	if err != nil {
+		t.Fatalf("resolving failures dir: %v", err)
+	}
+	if _, err := os.Stat(failuresDir); os.IsNotExist(err) {
+		t.Skipf("failures directory not available in this environment: %s", failuresDir)
+	}
+
 	testCases := []struct {
 		name     string
-		path     string
+		relPath  string
 		id       string
 		category string
 	}{
-		{
-			name:     "Missed Task",
-			path:     "/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures/missed-tasks/MT-002.yaml",
-			id:       "MT-002",
-			category: "missed-tasks",
-		},
-		{
-			name:     "Wrong Product",
-			path:     "/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures/wrong-product/WP-002.yaml",
-			id:       "WP-002",
-			category: "wrong-product",
-		},
-		{
-			name:     "Security Flaw",
-			path:     "/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures/security-flaw/SF-001.yaml",
-			id:       "SF-001",
-			category: "security-flaw",
-		},
+		{name: "Missed Task", relPath: filepath.Join("missed-tasks", "MT-002.yaml"), id: "MT-002", category: "missed-tasks"},
+		{name: "Wrong Product", relPath: filepath.Join("wrong-product", "WP-002.yaml"), id: "WP-002", category: "wrong-product"},
+		{name: "Security Flaw", relPath: filepath.Join("security-flaw", "SF-001.yaml"), id: "SF-001", category: "security-flaw"},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			// Verify file exists
-			if _, err := os.Stat(tc.path); os.IsNotExist(err) {
-				t.Fatalf("Failure case file does not exist: %s", tc.path)
-			}
-
-			// Read file
-			content, err := os.ReadFile(tc.path)
+			path := filepath.Join(failuresDir, tc.relPath)
+			fc, diags, err := failurecase.Load(path)
 			if err != nil {
-				t.Fatalf("Failed to read failure case %s: %v", tc.name, err)
+				t.Fatalf("Failed to load failure case %s: %v", tc.name, err)
 			}
-
-			contentStr := string(content)
-
-			// Verify ID matches
-			if !strings.Contains(contentStr, "id: "+tc.id) {
-				t.Errorf("Expected ID %s not found in %s", tc.id, tc.name)
+			for _, d := range diags {
+				t.Errorf("unexpected diagnostic: %s", d)
 			}
 
-			// Verify category matches
-			if !strings.Contains(contentStr, "category: "+tc.category) {
-				t.Errorf("Expected category %s not found in %s", tc.category, tc.name)
+			if fc.ID != tc.id {
+				t.Errorf("Expected ID %s not found in %s, got %s", tc.id, tc.name, fc.ID)
 			}
-
-			// Verify eval_criteria section exists and has items
-			if !strings.Contains(contentStr, "eval_criteria:") {
-				t.Errorf("Missing eval_criteria in %s", tc.name)
+			if fc.Category != tc.category {
+				t.Errorf("Expected category %s not found in %s, got %s", tc.category, tc.name, fc.Category)
 			}
-
-			// Verify it has at least one type: field in eval_criteria
-			if !strings.Contains(contentStr, "- type:") {
+			if len(fc.EvalCriteria) == 0 {
 				t.Errorf("eval_criteria should have at least one criterion in %s", tc.name)
 			}
 		})
@@ -760,34 +786,11 @@ func TestLoadMultipleFailureCases(t *testing.T) {
 
 // TestDiscoverAllFailureCases tests discovering all failure case files in the directory
 func TestDiscoverAllFailureCases(t *testing.T) {
-	failuresDir := "/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures"
-
-	// Verify failures directory exists
-	if _, err := os.Stat(failuresDir); os.IsNotExist(err) {
-		t.Fatalf("Failures directory does not exist: %s", failuresDir)
-	}
-
-	// Find all .yaml files except schema.yaml and templates
-	var failureCases []string
-	err := filepath.Walk(failuresDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		// Skip schema.yaml and example templates
-		if !info.IsDir() && filepath.Ext(path) == ".yaml" {
-			base := filepath.Base(path)
-			if base != "schema.yaml" && base != "template.yaml" && base != ".gitkeep" {
-				failureCases = append(failureCases, path)
-			}
-		}
-		return nil
+	var failureCases []failurecase.FailureCase
+	testsupport.WalkFailureCases(t, func(fc failurecase.FailureCase) {
+		failureCases = append(failureCases, fc)
 	})
 
-	if err != nil {
-		t.Fatalf("Failed to walk failures directory: %v", err)
-	}
-
 	// Verify we found some failure cases
 	if len(failureCases) == 0 {
 		t.Fatal("No failure case YAML files found in failures directory")
@@ -797,16 +800,8 @@ func TestDiscoverAllFailureCases(t *testing.T) {
 
 	// Verify we have cases from different categories
 	categoriesFound := make(map[string]int)
-	for _, path := range failureCases {
-		// Extract category from path (e.g., .../missed-tasks/MT-002.yaml -> missed-tasks)
-		parts := strings.Split(path, string(filepath.Separator))
-		for i, part := range parts {
-			if part == "failures" && i+1 < len(parts) {
-				category := parts[i+1]
-				categoriesFound[category]++
-				break
-			}
-		}
+	for _, fc := range failureCases {
+		categoriesFound[fc.Category]++
 	}
 
 	// Verify we have multiple categories
@@ -817,155 +812,384 @@ func TestDiscoverAllFailureCases(t *testing.T) {
 	t.Logf("Categories found: %v", categoriesFound)
 }
 
-// TestFailureCaseStructure tests that failure cases follow the expected schema
+// TestFailureCaseStructure tests that failure cases follow the expected
+// schema. It loads the case through failurecase.Load instead of scanning
+// the raw YAML bytes for field names, so a misnested field or an unknown
+// key fails the decode outright rather than merely missing a substring
+// check, and a bad ID or criterion type surfaces as a Diagnostic.
 func TestFailureCaseStructure(t *testing.T) {
-	// Test with a known failure case
-	failureCasePath := filepath.Join("/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures/security-flaw/SF-001.yaml")
-
-	content, err := os.ReadFile(failureCasePath)
+	failuresDir, err := testsupport.FailuresDir()
 	if err != nil {
-		t.Fatalf("Failed to read failure case: %v", err)
+		t.Fatalf("resolving failures dir: %v", err)
+	}
+	if _, err := os.Stat(failuresDir); os.IsNotExist(err) {
+		t.Skipf("failures directory not available in this environment: %s", failuresDir)
 	}
+	failureCasePath := filepath.Join(failuresDir, "security-flaw", "SF-001.yaml")
 
-	contentStr := string(content)
+	fc, diags, err := failurecase.Load(failureCasePath)
+	if err != nil {
+		t.Fatalf("failed to load failure case: %v", err)
+	}
+	for _, d := range diags {
+		t.Errorf("unexpected diagnostic: %s", d)
+	}
 
-	// Verify required top-level fields
-	topLevelFields := []string{"id:", "category:", "discovered:", "severity:", "context:", "failure:", "evidence:", "eval_criteria:"}
-	for _, field := range topLevelFields {
-		if !strings.Contains(contentStr, field) {
-			t.Errorf("Missing required top-level field: %s", field)
+	if fc.Context.Task == "" {
+		t.Error("context.task is empty")
+	}
+	if fc.Failure.Description == "" || fc.Failure.RootCause == "" {
+		t.Error("failure.description or failure.root_cause is empty")
+	}
+	if fc.Evidence.TaskSpec == "" || fc.Evidence.WhatWasBuilt == "" {
+		t.Error("evidence.task_spec or evidence.what_was_built is empty")
+	}
+	if len(fc.EvalCriteria) == 0 {
+		t.Fatal("eval_criteria is empty")
+	}
+	for i, c := range fc.EvalCriteria {
+		if c.Check == "" {
+			t.Errorf("eval_criteria[%d].check is empty", i)
+		}
+		if c.Type != failurecase.CodeBased && c.Type != failurecase.ModelBased {
+			t.Errorf("eval_criteria[%d].type is %q, want %q or %q", i, c.Type, failurecase.CodeBased, failurecase.ModelBased)
 		}
 	}
+}
+
+// failureCaseIDPattern is failurecase.IDPattern under its pre-existing
+// name in this file, kept so the regression/sharding tests below didn't
+// need touching beyond the schema switch.
+var failureCaseIDPattern = failurecase.IDPattern
+
+// caseShard returns the 0-based shard a failure case ID belongs to out of
+// shards total, via a stable hash/fnv hash so assignment doesn't depend on
+// run order or need coordination between CI runners.
+func caseShard(id string, shards int) int {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return int(h.Sum32() % uint32(shards))
+}
 
-	// Verify context subfields
-	contextFields := []string{"task:"}
-	for _, field := range contextFields {
-		if !strings.Contains(contentStr, field) {
-			t.Errorf("Missing required context field: %s", field)
+// selectShard returns the subset of cases whose ID hashes to shard out of
+// shards total (see caseShard).
+func selectShard(cases []FailureCase, shard, shards int) []FailureCase {
+	var selected []FailureCase
+	for _, c := range cases {
+		if caseShard(c.ID, shards) == shard {
+			selected = append(selected, c)
 		}
 	}
+	return selected
+}
 
-	// Verify failure subfields
-	failureFields := []string{"description:", "root_cause:"}
-	for _, field := range failureFields {
-		if !strings.Contains(contentStr, field) {
-			t.Errorf("Missing required failure field: %s", field)
-		}
+// caseCheckResult is one failure case's outcome from runIDFormatChecks.
+type caseCheckResult struct {
+	id     string
+	passed bool
+	// skipReason is set instead of passed/failed when a case can't be
+	// checked at all (e.g. an empty ID).
+	skipReason string
+}
+
+// runIDFormatChecks validates every case's ID against failureCaseIDPattern
+// concurrently, bounded by a worker pool of size workers (workers < 1 runs
+// serially), and returns one result per case in the same order as cases.
+func runIDFormatChecks(cases []FailureCase, workers int) []caseCheckResult {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]caseCheckResult, len(cases))
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+
+	for i, c := range cases {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, c FailureCase) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if c.ID == "" {
+				results[i] = caseCheckResult{skipReason: "case has no id"}
+				return
+			}
+			results[i] = caseCheckResult{id: c.ID, passed: failureCaseIDPattern.MatchString(c.ID)}
+		}(i, c)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func TestSelectShardPartitionsCasesExactlyOnce(t *testing.T) {
+	cases := make([]FailureCase, 0, 50)
+	for i := 0; i < 50; i++ {
+		cases = append(cases, FailureCase{ID: fmt.Sprintf("MT-%03d", i)})
 	}
 
-	// Verify evidence subfields
-	evidenceFields := []string{"task_spec:", "what_was_built:"}
-	for _, field := range evidenceFields {
-		if !strings.Contains(contentStr, field) {
-			t.Errorf("Missing required evidence field: %s", field)
+	const shards = 4
+	seen := make(map[string]int)
+	for shard := 0; shard < shards; shard++ {
+		for _, c := range selectShard(cases, shard, shards) {
+			seen[c.ID]++
 		}
 	}
 
-	// Verify eval_criteria has both type and check fields
-	if !strings.Contains(contentStr, "type:") {
-		t.Error("eval_criteria missing type field")
+	if len(seen) != len(cases) {
+		t.Fatalf("expected every case to be assigned to exactly one shard, got %d of %d covered", len(seen), len(cases))
 	}
-	if !strings.Contains(contentStr, "check:") {
-		t.Error("eval_criteria missing check field")
+	for id, count := range seen {
+		if count != 1 {
+			t.Errorf("case %s was assigned to %d shards, want 1", id, count)
+		}
+	}
+}
+
+func TestRunIDFormatChecks(t *testing.T) {
+	cases := []FailureCase{
+		{ID: "MT-001"},
+		{ID: "bogus-id"},
+		{ID: ""},
 	}
 
-	// Verify eval_criteria has valid types (code-based or model-based)
-	hasValidType := strings.Contains(contentStr, "type: code-based") ||
-	                strings.Contains(contentStr, "type: model-based")
-	if !hasValidType {
-		t.Error("eval_criteria should have type: code-based or type: model-based")
+	results := runIDFormatChecks(cases, 2)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if !results[0].passed {
+		t.Errorf("expected MT-001 to pass the ID format check, got %+v", results[0])
+	}
+	if results[1].passed {
+		t.Errorf("expected bogus-id to fail the ID format check, got %+v", results[1])
+	}
+	if results[2].skipReason == "" {
+		t.Errorf("expected an empty ID to be skipped, got %+v", results[2])
 	}
 }
 
-// TestFailureCaseIDFormat tests that failure case IDs follow the expected pattern
-func TestFailureCaseIDFormat(t *testing.T) {
-	testCases := []struct {
-		filePath       string
-		expectedID     string
-		expectedPrefix string
-	}{
-		{
-			filePath:       "/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures/missed-tasks/MT-002.yaml",
-			expectedID:     "MT-002",
-			expectedPrefix: "MT",
-		},
-		{
-			filePath:       "/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures/wrong-product/WP-002.yaml",
-			expectedID:     "WP-002",
-			expectedPrefix: "WP",
-		},
-		{
-			filePath:       "/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/failures/security-flaw/SF-001.yaml",
-			expectedID:     "SF-001",
-			expectedPrefix: "SF",
-		},
+// TestFailureCaseRegression discovers every failure case under
+// yokay-evals/failures/*, selects the ones assigned to -shard out of
+// -shards, checks each case's ID format with a worker pool bounded by -n,
+// and reports a pass/fail/skip summary. Splitting the corpus by hashed ID
+// (rather than a fixed list of cases) lets CI spread an arbitrarily large
+// failures/ directory across runners without maintaining per-shard lists.
+func TestFailureCaseRegression(t *testing.T) {
+	failuresDir, err := testsupport.FailuresDir()
+	if err != nil {
+		t.Fatalf("resolving failures dir: %v", err)
+	}
+	cases, err := findFailureCases(failuresDir, "")
+	if err != nil || len(cases) == 0 {
+		t.Skipf("failures directory not available in this environment: %v", err)
 	}
 
-	for _, tc := range testCases {
-		t.Run(tc.expectedID, func(t *testing.T) {
-			content, err := os.ReadFile(tc.filePath)
-			if err != nil {
-				t.Fatalf("Failed to read file: %v", err)
+	shard := selectShard(cases, *regressionShard, *regressionShards)
+	results := runIDFormatChecks(shard, *regressionWorkers)
+
+	var passed, failed, skipped int
+	for _, r := range results {
+		switch {
+		case r.skipReason != "":
+			skipped++
+		case r.passed:
+			passed++
+		default:
+			failed++
+		}
+	}
+	t.Logf("shard %d/%d: %d total, %d passed, %d failed, %d skipped",
+		*regressionShard, *regressionShards, len(results), passed, failed, skipped)
+
+	for _, r := range results {
+		r := r
+		name := r.id
+		if name == "" {
+			name = "unknown"
+		}
+		t.Run(name, func(t *testing.T) {
+			if *regressionWorkers > 1 {
+				t.Parallel()
 			}
+			if r.skipReason != "" {
+				t.Skip(r.skipReason)
+			}
+			if !r.passed {
+				t.Errorf("ID %q does not match the expected <PREFIX>-<NNN> pattern", r.id)
+			}
+		})
+	}
+}
+
+// TestFailureCaseLiveExpectations checks each failure case's eval_criteria
+// checks against a live run of the built binary. With -update it instead
+// rewrites the mismatching checks in place and passes, following
+// -update_errors from Go's cmd/internal/testdir runner: run once with
+// -update after changing a criterion's intent, inspect the diff, then run
+// again without the flag to confirm it's locked in.
+func TestFailureCaseLiveExpectations(t *testing.T) {
+	failuresDir, err := testsupport.FailuresDir()
+	if err != nil {
+		t.Fatalf("resolving failures dir: %v", err)
+	}
+	pathByID, err := mapFailureCasePaths(failuresDir)
+	if err != nil || len(pathByID) == 0 {
+		t.Skipf("failures directory not available in this environment: %v", err)
+	}
 
-			contentStr := string(content)
+	binaryPath := testsupport.BuildYokayEvals(t)
 
-			// Verify ID is present
-			if !strings.Contains(contentStr, "id: "+tc.expectedID) {
-				t.Errorf("Expected ID %s not found in file", tc.expectedID)
+	for id, path := range pathByID {
+		id, path := id, path
+		t.Run(id, func(t *testing.T) {
+			updates, err := runEvalCriteriaLive(binaryPath, path)
+			if err != nil {
+				t.Fatalf("running live eval for %s: %v", id, err)
+			}
+			if len(updates) == 0 {
+				return // every criterion already passed
 			}
 
-			// Verify ID format matches pattern: XX-NNN (2-3 letters, dash, 3 digits)
-			// This is a simple check - a full YAML parser would be better but this tests basic structure
-			lines := strings.Split(contentStr, "\n")
-			var idLine string
-			for _, line := range lines {
-				if strings.HasPrefix(strings.TrimSpace(line), "id:") {
-					idLine = strings.TrimSpace(line)
-					break
+			if !*updateExpectations {
+				for idx, actual := range updates {
+					t.Errorf("criterion %d check is stale, live run produced %q (rerun with -update to regenerate)", idx, actual)
 				}
+				return
 			}
 
-			if idLine == "" {
-				t.Fatal("No 'id:' line found")
+			if err := rewriteEvalCriteriaChecks(path, updates); err != nil {
+				t.Fatalf("rewriting %s: %v", path, err)
 			}
+			t.Logf("updated %d criteria in %s", len(updates), path)
+		})
+	}
+}
 
-			// Extract ID value
-			parts := strings.SplitN(idLine, ":", 2)
-			if len(parts) != 2 {
-				t.Fatal("Invalid id line format")
-			}
+// mapFailureCasePaths walks failuresDir the same way findFailureCases does,
+// but records each case's source path (keyed by ID) instead of its parsed
+// contents, so -update mode knows which file to rewrite.
+func mapFailureCasePaths(failuresDir string) (map[string]string, error) {
+	paths := make(map[string]string)
 
-			idValue := strings.TrimSpace(parts[1])
+	err := filepath.Walk(failuresDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".yaml") {
+			return nil
+		}
+		if info.Name() == "schema.yaml" || strings.Contains(path, "examples") {
+			return nil
+		}
 
-			// Verify prefix
-			if !strings.HasPrefix(idValue, tc.expectedPrefix) {
-				t.Errorf("ID %s should start with prefix %s", idValue, tc.expectedPrefix)
-			}
+		failureCase, err := loadFailureCase(path)
+		if err != nil {
+			return nil
+		}
+		paths[failureCase.ID] = path
+		return nil
+	})
 
-			// Verify format: should be like "XX-NNN"
-			if !strings.Contains(idValue, "-") {
-				t.Errorf("ID %s should contain a dash", idValue)
-			}
-		})
+	return paths, err
+}
+
+// runEvalCriteriaLive runs the built binary's `eval` command against the
+// single failure case at casePath, in isolation, and returns the live
+// Actual value for every criterion whose check did not pass, keyed by
+// criterion index.
+func runEvalCriteriaLive(binaryPath, casePath string) (map[int]string, error) {
+	tmpDir, err := os.MkdirTemp("", "yokay-update-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating scratch dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	data, err := os.ReadFile(casePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", casePath, err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, filepath.Base(casePath)), data, 0644); err != nil {
+		return nil, fmt.Errorf("staging %s: %w", casePath, err)
+	}
+
+	cmd := exec.Command(binaryPath, "eval", "--failures-dir", tmpDir, "--format", "json")
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running %s eval: %w", binaryPath, err)
+	}
+
+	var parsed struct {
+		Results []struct {
+			Failures [][]struct {
+				Actual         string `json:"Actual"`
+				CriterionIndex int    `json:"CriterionIndex"`
+			} `json:"Failures"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		return nil, fmt.Errorf("parsing eval output: %w", err)
+	}
+
+	updates := make(map[int]string)
+	for _, result := range parsed.Results {
+		if len(result.Failures) == 0 {
+			continue
+		}
+		for _, f := range result.Failures[0] {
+			updates[f.CriterionIndex] = f.Actual
+		}
 	}
+	return updates, nil
 }
 
-// buildBinary builds the yokay-evals binary and returns its path
-// The binary is built in a temp directory and should be removed by the caller
-func buildBinary(t *testing.T) string {
-	t.Helper()
+// rewriteEvalCriteriaChecks rewrites the "check" field of the eval_criteria
+// entries named in updates (keyed by index), round-tripping the YAML
+// through yaml.Node so comments and key order survive untouched.
+func rewriteEvalCriteriaChecks(path string, updates map[int]string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
 
-	tmpDir := t.TempDir()
-	binaryPath := filepath.Join(tmpDir, "yokay-evals")
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return fmt.Errorf("%s: empty document", path)
+	}
 
-	// Build the binary
-	cmd := exec.Command("go", "build", "-o", binaryPath, ".")
-	cmd.Dir = "/Users/sis4m4/Projects/stevestomp/pokayokay/yokay-evals/cmd/yokay-evals"
-	output, err := cmd.CombinedOutput()
+	criteria := mappingValueNode(doc.Content[0], "eval_criteria")
+	if criteria == nil {
+		return fmt.Errorf("%s: no eval_criteria section", path)
+	}
+
+	for idx, actual := range updates {
+		if idx < 0 || idx >= len(criteria.Content) {
+			continue
+		}
+		checkNode := mappingValueNode(criteria.Content[idx], "check")
+		if checkNode == nil {
+			continue
+		}
+		checkNode.Value = actual
+	}
+
+	out, err := yaml.Marshal(&doc)
 	if err != nil {
-		t.Fatalf("Failed to build binary: %v\nOutput: %s", err, string(output))
+		return fmt.Errorf("re-encoding %s: %w", path, err)
 	}
+	return os.WriteFile(path, out, 0644)
+}
 
-	return binaryPath
+// mappingValueNode returns the value node for key within a YAML mapping
+// node, or nil if the key is absent.
+func mappingValueNode(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
 }
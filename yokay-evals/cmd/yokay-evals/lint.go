@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stevestomp/yokay-evals/internal/failurecase"
+)
+
+// newLintCmd builds the `lint` subcommand, which validates every failure
+// case YAML against the typed internal/failurecase schema so authors can
+// catch a malformed case locally instead of waiting on `eval` to skip it
+// silently.
+func newLintCmd() *cobra.Command {
+	var failuresDir string
+
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Validate failure case YAML against the failurecase schema",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := failuresDir
+			if dir == "" {
+				dir = defaultFailuresDir()
+			}
+			return runLintCommand(cmd.OutOrStdout(), dir)
+		},
+	}
+
+	cmd.Flags().StringVar(&failuresDir, "failures-dir", "", "Path to failures directory (default: yokay-evals/failures)")
+
+	return cmd
+}
+
+// runLintCommand loads every failure case under failuresDir and prints its
+// diagnostics, returning an error if any case failed to parse or carried
+// at least one diagnostic.
+func runLintCommand(w io.Writer, failuresDir string) error {
+	if _, err := os.Stat(failuresDir); os.IsNotExist(err) {
+		return fmt.Errorf("failures directory not found: %s", failuresDir)
+	}
+
+	var dirty bool
+	err := filepath.Walk(failuresDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".yaml") {
+			return nil
+		}
+		if info.Name() == "schema.yaml" || strings.Contains(path, "examples") {
+			return nil
+		}
+
+		_, diags, err := failurecase.Load(path)
+		if err != nil {
+			dirty = true
+			fmt.Fprintf(w, "%s: %v\n", path, err)
+			return nil
+		}
+		for _, d := range diags {
+			dirty = true
+			fmt.Fprintln(w, d.String())
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("walking %s: %w", failuresDir, err)
+	}
+
+	if dirty {
+		return fmt.Errorf("one or more failure cases failed lint")
+	}
+	fmt.Fprintln(w, "all failure cases passed lint")
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeLintCase(t *testing.T, failuresDir, category, id, body string) {
+	t.Helper()
+	catDir := filepath.Join(failuresDir, category)
+	if err := os.MkdirAll(catDir, 0755); err != nil {
+		t.Fatalf("creating %s: %v", catDir, err)
+	}
+	path := filepath.Join(catDir, id+".yaml")
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatalf("writing %s: %v", path, err)
+	}
+}
+
+const lintValidCase = `
+id: MT-001
+category: missed-tasks
+context:
+  task: do the thing
+failure:
+  description: it didn't
+  root_cause: reasons
+evidence:
+  task_spec: spec
+  what_was_built: nothing
+eval_criteria:
+  - type: code-based
+    check: "go test ./..."
+`
+
+func TestRunLintCommandPasses(t *testing.T) {
+	failuresDir := t.TempDir()
+	writeLintCase(t, failuresDir, "missed-tasks", "MT-001", lintValidCase)
+
+	var buf bytes.Buffer
+	if err := runLintCommand(&buf, failuresDir); err != nil {
+		t.Fatalf("runLintCommand failed: %v\noutput:\n%s", err, buf.String())
+	}
+}
+
+func TestRunLintCommandReportsBadID(t *testing.T) {
+	failuresDir := t.TempDir()
+	writeLintCase(t, failuresDir, "missed-tasks", "bogus", `
+id: bogus
+category: missed-tasks
+context:
+  task: x
+failure:
+  description: x
+  root_cause: x
+evidence:
+  task_spec: x
+  what_was_built: x
+eval_criteria:
+  - type: code-based
+    check: x
+`)
+
+	var buf bytes.Buffer
+	if err := runLintCommand(&buf, failuresDir); err == nil {
+		t.Error("runLintCommand: expected an error for a malformed ID, got nil")
+	}
+}
+
+func TestRunLintCommandMissingDir(t *testing.T) {
+	var buf bytes.Buffer
+	if err := runLintCommand(&buf, filepath.Join(t.TempDir(), "nope")); err == nil {
+		t.Error("runLintCommand: expected an error for a missing failures dir, got nil")
+	}
+}
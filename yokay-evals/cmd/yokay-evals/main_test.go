@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -85,6 +86,55 @@ Here's an example of how to use this skill.
 	}
 }
 
+func TestGradeSkillsWithSpec(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportPath := filepath.Join(tmpDir, "test-report.md")
+
+	skillsDir := filepath.Join(tmpDir, "skills")
+	if err := os.MkdirAll(filepath.Join(skillsDir, "test-skill"), 0755); err != nil {
+		t.Fatalf("Failed to create test skills dir: %v", err)
+	}
+	sampleSkill := "# Instructions\n\nDo the thing carefully every time.\n\n```bash\necho hi\n```\n"
+	if err := os.WriteFile(filepath.Join(skillsDir, "test-skill", "SKILL.md"), []byte(sampleSkill), 0644); err != nil {
+		t.Fatalf("Failed to write test skill: %v", err)
+	}
+
+	specPath := filepath.Join(tmpDir, "spec.yaml")
+	spec := `
+criteria:
+  - name: has_instructions
+    weight: 0.5
+    assertions:
+      - operator: ShouldHaveHeading
+        expected: Instructions
+  - name: has_example
+    weight: 0.5
+    assertions:
+      - operator: ShouldHaveCodeBlock
+        expected: bash
+`
+	if err := os.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("Failed to write grader spec: %v", err)
+	}
+
+	err := gradeSkillsWithFormats(context.Background(), skillsDir, reportPath, []string{"markdown"}, defaultYokayEvalsSubdir("graders"), 0, 0, specPath)
+	if err != nil {
+		t.Fatalf("gradeSkillsWithFormats failed: %v", err)
+	}
+
+	content, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+
+	reportStr := string(content)
+	for _, want := range []string{"Has Instructions", "Has Example", "100.0/100"} {
+		if !strings.Contains(reportStr, want) {
+			t.Errorf("Report missing expected content %q:\n%s", want, reportStr)
+		}
+	}
+}
+
 func TestFindSkillFiles(t *testing.T) {
 	// Setup: Create temp directory with multiple skills
 	tmpDir := t.TempDir()
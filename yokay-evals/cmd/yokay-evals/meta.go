@@ -1,37 +1,405 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"os"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/spf13/cobra"
+	"github.com/stevestomp/yokay-evals/internal/adapters"
+	"github.com/stevestomp/yokay-evals/internal/metrics"
 	"gopkg.in/yaml.v3"
 )
 
-// EvalConfig represents the structure of an eval.yaml file
+// EvalConfig represents the structure of an eval.yaml file. Fields carry
+// both yaml and json tags with matching names so EvalConfigSchema (see
+// schema.go) generates JSON Schema properties that actually match what
+// eval.yaml authors write.
 type EvalConfig struct {
-	Agent                 string     `yaml:"agent"`
-	ConsistencyThreshold  float64    `yaml:"consistency_threshold"`
-	TestCases             []TestCase `yaml:"test_cases"`
+	Agent                string     `yaml:"agent" json:"agent"`
+	ConsistencyThreshold float64    `yaml:"consistency_threshold" json:"consistency_threshold"`
+	TestCases            []TestCase `yaml:"test_cases" json:"test_cases"`
+	// Adapter names the adapters.Adapter used to run test cases (e.g.
+	// "stub", "exec", "http"). Defaults to "stub" when empty. Overridable
+	// per-run via the --adapter CLI flag.
+	Adapter string `yaml:"adapter,omitempty" json:"adapter,omitempty"`
+	// AdapterConfig is the adapter-specific config block, shaped however
+	// the named adapter expects (e.g. exec's "command"/"args").
+	AdapterConfig map[string]any `yaml:"adapter_config,omitempty" json:"adapter_config,omitempty"`
+	// Judges names the judge configurations (e.g. different LLMs or
+	// prompt variants) each test case is graded by. Empty means the
+	// suite has a single implicit judge, recorded under
+	// defaultJudgeName, and every metric behaves exactly as it did
+	// before multi-judge grading existed.
+	Judges []string `yaml:"judges,omitempty" json:"judges,omitempty"`
 }
 
 // TestCase represents a single test case in the eval.yaml
 type TestCase struct {
-	ID        string    `yaml:"id"`
-	Name      string    `yaml:"name"`
-	Input     TaskInput `yaml:"input"`
-	Expected  string    `yaml:"expected"`
-	K         int       `yaml:"k"`
-	Rationale string    `yaml:"rationale"`
+	ID    string    `yaml:"id" json:"id"`
+	Name  string    `yaml:"name" json:"name"`
+	Input TaskInput `yaml:"input" json:"input"`
+	// Assertions is the rich, multi-check form of what a passing run must
+	// satisfy (see Assertion): a run passes iff every assertion holds.
+	// Populated either directly by the eval.yaml author, or from the
+	// legacy Expected shorthand by normalizeAssertions at load time, so
+	// every TestCase that reaches runMetaEvaluation has Assertions set.
+	Assertions []Assertion `yaml:"assertions,omitempty" json:"assertions,omitempty"`
+	// Expected is the legacy shorthand for a single "verdict equals
+	// Expected" assertion. Most of the harness (reports,
+	// --compare-baseline, --rerun) still reasons about a case's single
+	// expected verdict string, so normalizeAssertions keeps this in sync
+	// with Assertions rather than retiring it.
+	Expected  string `yaml:"expected,omitempty" json:"expected,omitempty"`
+	K         int    `yaml:"k" json:"k"`
+	Rationale string `yaml:"rationale" json:"rationale"`
+	// Tags categorizes this case for --only/--skip/--tag selection (see
+	// filter.go), e.g. ["smoke", "security"]. Empty means the case has no
+	// tags and can only be matched by ID.
+	Tags []string `yaml:"tags,omitempty" json:"tags,omitempty"`
 }
 
-// TaskInput represents the input to the agent being tested
+// Assertion is a single check against one run's MetaRunOutcome, borrowing
+// Venom's target/op/value step-assertion shape: Target names what to
+// check (e.g. "verdict", "reasoning", "duration_ms", or a JSON path into
+// the adapter's raw output like "tool_calls[0].name"), Op is the
+// comparison (see assertionOps), and Value is what Op compares the
+// resolved Target against.
+type Assertion struct {
+	Target string `yaml:"target" json:"target"`
+	Op     string `yaml:"op" json:"op"`
+	Value  string `yaml:"value" json:"value"`
+}
+
+// assertionOps is the set of Op values Assertion accepts.
+var assertionOps = map[string]bool{
+	"equals":   true,
+	"contains": true,
+	"regex":    true,
+	"jsonpath": true,
+	"lt":       true,
+	"gt":       true,
+	"oneof":    true,
+}
+
+// Validate reports an error naming the invalid op (and listing the valid
+// ones) if a.Op isn't one of assertionOps, so a typo fails at load time
+// rather than silently never matching.
+func (a Assertion) Validate() error {
+	if !assertionOps[a.Op] {
+		return fmt.Errorf("unknown assertion op %q (want one of equals, contains, regex, jsonpath, lt, gt, oneof)", a.Op)
+	}
+	return nil
+}
+
+// legacyExpectedAssertion translates a TestCase's legacy `expected: PASS`
+// shorthand into the single assertion it always meant.
+func legacyExpectedAssertion(expected string) Assertion {
+	return Assertion{Target: "verdict", Op: "equals", Value: expected}
+}
+
+// normalizeAssertions reconciles a just-parsed TestCase's legacy Expected
+// shorthand with its Assertions list. A case with no Assertions gets one
+// synthesized from Expected (legacyExpectedAssertion), so every TestCase
+// loadEvalYAML returns has Assertions set. A case that sets Assertions
+// directly but not Expected has Expected backfilled from its first
+// "verdict equals ..." assertion, if any, so code that still reads
+// Expected (reports, --compare-baseline, --rerun) keeps working.
+func normalizeAssertions(tc *TestCase) {
+	if len(tc.Assertions) == 0 {
+		if tc.Expected != "" {
+			tc.Assertions = []Assertion{legacyExpectedAssertion(tc.Expected)}
+		}
+		return
+	}
+
+	if tc.Expected == "" {
+		for _, a := range tc.Assertions {
+			if a.Target == "verdict" && a.Op == "equals" {
+				tc.Expected = a.Value
+				break
+			}
+		}
+	}
+}
+
+// TaskInput represents the input to the agent being tested. Only
+// TaskTitle is universally required; TaskDescription, Implementation, and
+// AcceptanceCriteria are each optional here since which of them a given
+// test case actually needs depends on the agent's AgentValidator (see
+// agent_validator.go) — EvalConfigSchema must not be stricter than that.
 type TaskInput struct {
-	TaskTitle          string   `yaml:"task_title"`
-	TaskDescription    string   `yaml:"task_description"`
-	AcceptanceCriteria []string `yaml:"acceptance_criteria"`
-	Implementation     string   `yaml:"implementation"`
+	TaskTitle          string   `yaml:"task_title" json:"task_title"`
+	TaskDescription    string   `yaml:"task_description,omitempty" json:"task_description,omitempty"`
+	AcceptanceCriteria []string `yaml:"acceptance_criteria,omitempty" json:"acceptance_criteria,omitempty"`
+	Implementation     string   `yaml:"implementation,omitempty" json:"implementation,omitempty"`
+}
+
+// defaultJudgeName keys RunsByJudge when an eval.yaml sets no `judges:`,
+// so the harness always has at least one judge to group runs under.
+const defaultJudgeName = "default"
+
+// substantialAgreementKappa is the Cohen's kappa below which a judge
+// pair's agreement is considered weaker than "substantial" (Landis &
+// Koch 1977), which more often points at an ambiguous grading rubric
+// than an actual model regression.
+const substantialAgreementKappa = 0.6
+
+// MetaRunOutcome is one run's full result, not just its verdict: how long it
+// took, the adapter's reported exit code (0 for adapters with no concept
+// of one), any error the run itself hit (as opposed to simply
+// disagreeing with Expected), and where its raw stdout/stderr was
+// persisted when `meta --artifacts-dir` is set (empty otherwise).
+type MetaRunOutcome struct {
+	Verdict    string
+	Duration   time.Duration
+	ExitCode   int
+	Err        string
+	OutputPath string
+	// Output is the adapter's raw output this run's Verdict was parsed
+	// from, kept in memory (in addition to OutputPath, which only holds
+	// it when --artifacts-dir is set) so Assertions can check fields
+	// beyond Verdict without re-reading it from disk.
+	Output string
+	// AssertionsPassed is true iff every one of the owning TestCase's
+	// Assertions held against this run (see evaluateTestCaseAssertions),
+	// and true when the TestCase has no Assertions. calculateMetrics only
+	// reads it when the TestResult carries both Assertions and Outcomes,
+	// so a TestResult built by hand without them (bypassing
+	// runMetaEvaluation) falls back to verdict-vs-Expected comparison.
+	AssertionsPassed bool
+	// Assertions carries each individual Assertion's outcome against this
+	// run, for reporting which assertion (if any) is flaky.
+	Assertions []AssertionOutcome
+}
+
+// AssertionOutcome is one Assertion's result against a single run's
+// MetaRunOutcome.
+type AssertionOutcome struct {
+	Assertion Assertion
+	Passed    bool
+	Actual    string
+	// Err is set when Target or Op couldn't even be evaluated (e.g. an
+	// unresolvable JSON path, a non-numeric lt/gt comparand), distinct
+	// from Passed being false because the check genuinely didn't hold.
+	Err string
+}
+
+// evaluateTestCaseAssertions applies every assertion in assertions against
+// outcome, returning whether the run passed overall (every assertion
+// held) and each assertion's individual AssertionOutcome. An empty
+// assertions list trivially passes.
+func evaluateTestCaseAssertions(assertions []Assertion, outcome MetaRunOutcome) (bool, []AssertionOutcome) {
+	allPassed := true
+	results := make([]AssertionOutcome, 0, len(assertions))
+	for _, a := range assertions {
+		actual, err := resolveAssertionTarget(a.Target, outcome)
+		if err != nil {
+			allPassed = false
+			results = append(results, AssertionOutcome{Assertion: a, Err: err.Error()})
+			continue
+		}
+
+		passed, err := applyAssertionOp(a.Op, actual, a.Value)
+		if err != nil {
+			allPassed = false
+			results = append(results, AssertionOutcome{Assertion: a, Actual: actual, Err: err.Error()})
+			continue
+		}
+
+		if !passed {
+			allPassed = false
+		}
+		results = append(results, AssertionOutcome{Assertion: a, Actual: actual, Passed: passed})
+	}
+	return allPassed, results
+}
+
+// resolveAssertionTarget resolves an Assertion.Target against outcome:
+// "verdict", "reasoning" (the adapter's raw Output), and "duration_ms"
+// read MetaRunOutcome fields directly; anything else is treated as a
+// dotted/bracket JSON path (e.g. "tool_calls[0].name") into Output.
+func resolveAssertionTarget(target string, outcome MetaRunOutcome) (string, error) {
+	switch target {
+	case "verdict":
+		return outcome.Verdict, nil
+	case "reasoning":
+		return outcome.Output, nil
+	case "duration_ms":
+		return strconv.FormatInt(outcome.Duration.Milliseconds(), 10), nil
+	default:
+		return resolveJSONPath(outcome.Output, target)
+	}
+}
+
+// resolveJSONPath resolves a dotted/bracket path like "tool_calls[0].name"
+// against raw, which must unmarshal as JSON. A scalar result is rendered
+// as a string (numbers without trailing zeros, bools as "true"/"false");
+// resolving into a map/slice renders as that value's compact JSON.
+func resolveJSONPath(raw, path string) (string, error) {
+	var doc any
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		return "", fmt.Errorf("target %q: output is not valid JSON: %w", path, err)
+	}
+
+	cur := doc
+	for _, segment := range jsonPathSegments(path) {
+		if idx, isIndex := segment.index(); isIndex {
+			arr, ok := cur.([]any)
+			if !ok || idx < 0 || idx >= len(arr) {
+				return "", fmt.Errorf("target %q: index %d out of range", path, idx)
+			}
+			cur = arr[idx]
+			continue
+		}
+
+		obj, ok := cur.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("target %q: %q is not an object", path, segment.key)
+		}
+		val, ok := obj[segment.key]
+		if !ok {
+			return "", fmt.Errorf("target %q: field %q not found", path, segment.key)
+		}
+		cur = val
+	}
+
+	switch v := cur.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("target %q: encoding resolved value: %w", path, err)
+		}
+		return string(encoded), nil
+	}
+}
+
+// jsonPathStep is one "key" or "[index]" step of a dotted/bracket JSON
+// path, e.g. "tool_calls[0].name" is [{key: "tool_calls"}, {idxSet: true,
+// idx: 0}, {key: "name"}].
+type jsonPathStep struct {
+	key    string
+	idx    int
+	idxSet bool
+}
+
+func (s jsonPathStep) index() (int, bool) {
+	return s.idx, s.idxSet
+}
+
+// jsonPathSegments splits a dotted/bracket path into its steps.
+func jsonPathSegments(path string) []jsonPathStep {
+	var steps []jsonPathStep
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			open := strings.IndexByte(part, '[')
+			if open < 0 {
+				steps = append(steps, jsonPathStep{key: part})
+				break
+			}
+			if open > 0 {
+				steps = append(steps, jsonPathStep{key: part[:open]})
+			}
+			closeIdx := strings.IndexByte(part, ']')
+			if closeIdx < 0 {
+				break
+			}
+			idx, err := strconv.Atoi(part[open+1 : closeIdx])
+			if err == nil {
+				steps = append(steps, jsonPathStep{idx: idx, idxSet: true})
+			}
+			part = part[closeIdx+1:]
+		}
+	}
+	return steps
+}
+
+// applyAssertionOp compares actual (Target's resolved value) against
+// value using op. "jsonpath" treats value itself as a further dotted/
+// bracket path resolved against actual (which must then be JSON),
+// passing iff that path resolves to anything -- an existence check, for
+// asserting a field is present without pinning its exact content.
+func applyAssertionOp(op, actual, value string) (bool, error) {
+	switch op {
+	case "equals":
+		return actual == value, nil
+	case "contains":
+		return strings.Contains(actual, value), nil
+	case "regex":
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return false, fmt.Errorf("invalid regex %q: %w", value, err)
+		}
+		return re.MatchString(actual), nil
+	case "jsonpath":
+		if _, err := resolveJSONPath(actual, value); err != nil {
+			return false, nil
+		}
+		return true, nil
+	case "lt", "gt":
+		actualNum, err := strconv.ParseFloat(strings.TrimSpace(actual), 64)
+		if err != nil {
+			return false, fmt.Errorf("actual value %q is not a number: %w", actual, err)
+		}
+		wantNum, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return false, fmt.Errorf("value %q is not a number: %w", value, err)
+		}
+		if op == "lt" {
+			return actualNum < wantNum, nil
+		}
+		return actualNum > wantNum, nil
+	case "oneof":
+		for _, want := range strings.Split(value, ",") {
+			if actual == strings.TrimSpace(want) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown assertion op %q", op)
+	}
+}
+
+// assertionLabel renders an Assertion as a short "target op value" label
+// for report output and AssertionPassRates map keys.
+func assertionLabel(a Assertion) string {
+	return fmt.Sprintf("%s %s %s", a.Target, a.Op, a.Value)
+}
+
+// assertionPassLabel is the majority-vote "verdict" calculateMetrics
+// compares assertionPassLabels against: a case is correct when a
+// majority of its runs have every assertion hold.
+const assertionPassLabel = "PASS"
+
+// assertionPassLabels renders each outcome's AssertionsPassed as a
+// "PASS"/"FAIL" label, so getMajorityVerdict/areAllRunsConsistent (which
+// operate on []string) can judge assertion-based correctness/consistency
+// exactly as they already do for raw verdict strings.
+func assertionPassLabels(outcomes []MetaRunOutcome) []string {
+	labels := make([]string, len(outcomes))
+	for i, o := range outcomes {
+		if o.AssertionsPassed {
+			labels[i] = assertionPassLabel
+		} else {
+			labels[i] = "FAIL"
+		}
+	}
+	return labels
 }
 
 // TestResult represents the result of running a test case k times
@@ -39,13 +407,104 @@ type TestResult struct {
 	TestID   string
 	Name     string
 	Expected string
-	Runs     []string // Each run's verdict
+	// RunsByJudge holds each judge's k verdicts for this case, keyed by
+	// judge name (defaultJudgeName when the suite configures none).
+	// Empty when Skipped.
+	RunsByJudge map[string][]string
+	// Runs is the flattened union of RunsByJudge's judges in sorted
+	// order, kept populated for every existing consumer (metrics,
+	// --rerun, reports) that predates multi-judge grading. With a single
+	// judge it is exactly that judge's runs.
+	Runs []string
+	// OutcomesByJudge mirrors RunsByJudge one-for-one, but carries each
+	// run's full MetaRunOutcome (duration, exit code, error, artifact path)
+	// rather than only its verdict. Empty when Skipped.
+	OutcomesByJudge map[string][]MetaRunOutcome
+	// Outcomes is the flattened union of OutcomesByJudge, in the same
+	// judge order as Runs/RunsByJudge (so Outcomes[i].Verdict ==
+	// Runs[i]).
+	Outcomes  []MetaRunOutcome
+	Rationale string // Carried over from TestCase.Rationale, for report system-out
+	// Assertions is carried over from TestCase.Assertions, so
+	// calculateMetrics and the report can label each Outcomes[i].Assertions
+	// entry without threading the owning TestCase through separately.
+	Assertions []Assertion
+
+	// Skipped is true when `meta --rerun` decided not to re-execute this
+	// case (reusing its last recorded outcome, CarriedOver*) or when a
+	// --skip/--only/--tag/--id filter excluded it entirely (SkipReason
+	// set, no carried-over outcome to report).
+	Skipped                bool
+	CarriedOverVerdict     string
+	CarriedOverConsistency float64
+	// SkipReason is set when a caseFilter excluded this case (e.g.
+	// "skip-id", "not-in-only", "not-in-tag", "not-in-id"), and empty when
+	// Skipped is instead due to --rerun carrying over a prior result.
+	SkipReason string
+}
+
+// unionRuns flattens a RunsByJudge map into a single slice, iterating
+// judges in sorted order so the result is deterministic regardless of Go's
+// map iteration order.
+func unionRuns(runsByJudge map[string][]string) []string {
+	judges := make([]string, 0, len(runsByJudge))
+	for judge := range runsByJudge {
+		judges = append(judges, judge)
+	}
+	sort.Strings(judges)
+
+	var out []string
+	for _, judge := range judges {
+		out = append(out, runsByJudge[judge]...)
+	}
+	return out
+}
+
+// unionOutcomes is unionRuns for OutcomesByJudge: it flattens a
+// map[judge][]MetaRunOutcome into a single slice in sorted-judge order, so
+// TestResult.Outcomes[i].Verdict always matches TestResult.Runs[i].
+func unionOutcomes(outcomesByJudge map[string][]MetaRunOutcome) []MetaRunOutcome {
+	judges := make([]string, 0, len(outcomesByJudge))
+	for judge := range outcomesByJudge {
+		judges = append(judges, judge)
+	}
+	sort.Strings(judges)
+
+	var out []MetaRunOutcome
+	for _, judge := range judges {
+		out = append(out, outcomesByJudge[judge]...)
+	}
+	return out
+}
+
+// judgeNames returns config's configured judges, or a single element
+// slice with defaultJudgeName when it configures none.
+func judgeNames(config *EvalConfig) []string {
+	if len(config.Judges) == 0 {
+		return []string{defaultJudgeName}
+	}
+	return config.Judges
 }
 
 // EvaluationResult represents the complete evaluation result for an agent
 type EvaluationResult struct {
 	Agent       string
 	TestResults []TestResult
+	// AdapterName is the adapters.Adapter used to produce TestResults
+	// (e.g. "stub", "exec", "http").
+	AdapterName string
+	// ConsistencyThreshold is carried over from the eval.yaml so the
+	// report can compare it against ConsistencyCI's lower bound.
+	ConsistencyThreshold float64
+}
+
+// ConfidenceInterval is a two-sided confidence interval around a
+// point estimate, e.g. the Wilson score interval for a binomial
+// proportion.
+type ConfidenceInterval struct {
+	Lower      float64
+	Upper      float64
+	Confidence float64 // e.g. 0.95 for a 95% CI
 }
 
 // Metrics represents calculated metrics for the evaluation
@@ -55,6 +514,144 @@ type Metrics struct {
 	TotalTests      int
 	CorrectCount    int
 	ConsistentCount int
+	// SkippedCount is the number of test cases `meta --rerun` skipped
+	// (carried over from the last run) rather than executing. Metrics
+	// above are computed only over the executed cases.
+	SkippedCount int
+
+	// AccuracyCI is the Wilson score confidence interval around Accuracy,
+	// treating each test case's majority-vote pass/fail as one Bernoulli
+	// trial (n = TotalTests).
+	AccuracyCI ConfidenceInterval
+	// ConsistencyCI is the Wilson score confidence interval around
+	// Consistency, but computed per-run rather than per-case: each of a
+	// test's k Runs that agrees with that test's majority verdict counts
+	// as a success, so n is the sum of Runs across every executed test
+	// (variable k per test) rather than TotalTests.
+	ConsistencyCI ConfidenceInterval
+
+	// MeanKappa is the mean Cohen's kappa across every judge pair and
+	// executed test case, when the suite configures more than one judge.
+	// Zero (and JudgeAgreement empty) for a single-judge suite.
+	MeanKappa float64
+	// JudgeAgreement is each judge pair's mean Cohen's kappa, averaged
+	// across the executed test cases both judges graded.
+	JudgeAgreement map[JudgePair]float64
+
+	// AssertionPassRates is how often each distinct Assertion (keyed by
+	// assertionLabel, e.g. "verdict equals PASS") held across every run
+	// it was checked against, across every executed test case. Lets
+	// report readers see which individual assertion is flaky when a case
+	// has more than one. Empty when no executed TestResult carries
+	// per-run Assertions data (e.g. one built by hand in a test, without
+	// going through runMetaEvaluation).
+	AssertionPassRates map[string]float64
+
+	// PassAtKReports is one entry per passAtKThreshold that at least one
+	// executed test case had enough runs for, each an unbiased pass@k
+	// point estimate (metrics.PassAtKEstimate) averaged across those
+	// cases plus a Wilson CI around the raw per-run pass rate they
+	// contributed -- so a suite run with k: 20 can report pass@1, pass@5,
+	// and pass@10 from the same batch of runs instead of needing a
+	// separate k-run batch per k. Empty when every case's k is below the
+	// smallest threshold.
+	PassAtKReports []PassAtKReport
+}
+
+// passAtKThresholds are the k values calculateMetrics reports an
+// estimated pass@k for, when a test case ran at least that many times.
+var passAtKThresholds = []int{1, 5, 10}
+
+// PassAtKReport is one k threshold's unbiased pass@k estimate, averaged
+// across every executed test case whose run count is >= K (see
+// metrics.PassAtKEstimate), alongside a Wilson-score 95% CI around the
+// raw per-run pass rate those same cases contributed -- letting
+// maintainers judge whether an apparent accuracy difference is
+// statistically meaningful or just sampling noise.
+type PassAtKReport struct {
+	K        int
+	Estimate float64
+	CI       ConfidenceInterval
+}
+
+// JudgePair identifies an unordered pair of judges for inter-rater
+// agreement reporting. Map keys need to be comparable, so this is a
+// struct rather than e.g. a "judgeA/judgeB" string.
+type JudgePair struct {
+	A, B string
+}
+
+// calculateAgreement computes Cohen's kappa between each pair of judges
+// that graded tr, treating the shared run index as the unit of
+// agreement (the j'th run is the same underlying repetition, just
+// labelled by a different judge). Pairs where the two judges have no
+// overlapping run index are omitted.
+func calculateAgreement(tr TestResult) map[JudgePair]float64 {
+	judges := make([]string, 0, len(tr.RunsByJudge))
+	for judge := range tr.RunsByJudge {
+		judges = append(judges, judge)
+	}
+	sort.Strings(judges)
+
+	out := make(map[JudgePair]float64)
+	for i := 0; i < len(judges); i++ {
+		for j := i + 1; j < len(judges); j++ {
+			a, b := judges[i], judges[j]
+			kappa, ok := cohensKappa(tr.RunsByJudge[a], tr.RunsByJudge[b])
+			if !ok {
+				continue
+			}
+			out[JudgePair{A: a, B: b}] = kappa
+		}
+	}
+	return out
+}
+
+// cohensKappa computes Cohen's kappa between two judges' verdicts over
+// their n shared run indices (n = min(len(a), len(b))): p_o is the
+// observed agreement fraction, p_e is the expected agreement under
+// independence (sum over verdict categories of each judge's marginal
+// frequency product), and kappa = (p_o - p_e) / (1 - p_e), defined as 1
+// when p_e = 1 (both judges always agree on the same single category).
+// ok is false when there are no shared run indices to compare.
+func cohensKappa(a, b []string) (kappa float64, ok bool) {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	if n == 0 {
+		return 0, false
+	}
+
+	countsA := make(map[string]int)
+	countsB := make(map[string]int)
+	agree := 0
+	for i := 0; i < n; i++ {
+		if a[i] == b[i] {
+			agree++
+		}
+		countsA[a[i]]++
+		countsB[b[i]]++
+	}
+
+	categories := make(map[string]bool, len(countsA))
+	for c := range countsA {
+		categories[c] = true
+	}
+	for c := range countsB {
+		categories[c] = true
+	}
+
+	po := float64(agree) / float64(n)
+	pe := 0.0
+	for c := range categories {
+		pe += (float64(countsA[c]) / float64(n)) * (float64(countsB[c]) / float64(n))
+	}
+
+	if pe == 1 {
+		return 1, true
+	}
+	return (po - pe) / (1 - pe), true
 }
 
 // loadEvalYAML loads and parses an eval.yaml file
@@ -69,14 +666,26 @@ func loadEvalYAML(path string) (*EvalConfig, error) {
 		return nil, fmt.Errorf("parsing eval.yaml: %w", err)
 	}
 
+	for i := range config.TestCases {
+		normalizeAssertions(&config.TestCases[i])
+		for _, a := range config.TestCases[i].Assertions {
+			if err := a.Validate(); err != nil {
+				return nil, fmt.Errorf("test case %s: %w", config.TestCases[i].ID, err)
+			}
+		}
+	}
+
 	return &config, nil
 }
 
-// findAgentEvalFiles finds all eval.yaml files in the agents directory
-func findAgentEvalFiles(agentsDir string) ([]string, error) {
+// findEvalFiles recursively finds every eval.yaml under dir. It's the
+// consolidated walker findAgentEvalFiles and findSkillEvalFiles both
+// delegate to, since an agents directory and a skills directory are
+// searched identically.
+func findEvalFiles(dir string) ([]string, error) {
 	var evalFiles []string
 
-	err := filepath.Walk(agentsDir, func(path string, info os.FileInfo, err error) error {
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -95,107 +704,527 @@ func findAgentEvalFiles(agentsDir string) ([]string, error) {
 	return evalFiles, nil
 }
 
+// findAgentEvalFiles finds all eval.yaml files in the agents directory
+func findAgentEvalFiles(agentsDir string) ([]string, error) {
+	return findEvalFiles(agentsDir)
+}
+
 // findSkillEvalFiles finds all eval.yaml files in the skills directory
 func findSkillEvalFiles(skillsDir string) ([]string, error) {
-	var evalFiles []string
+	return findEvalFiles(skillsDir)
+}
 
-	err := filepath.Walk(skillsDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+// resolveEvalFiles resolves --suite/--agent to the eval.yaml file(s) they
+// select, shared by `meta run` and `meta bench`. Exactly one of suite and
+// agent must be set.
+func resolveEvalFiles(suite, agent, metaDir string) ([]string, error) {
+	if agent != "" {
+		evalPath := filepath.Join(metaDir, "agents", agent, "eval.yaml")
+		if _, err := os.Stat(evalPath); os.IsNotExist(err) {
+			return nil, fmt.Errorf("eval.yaml not found for agent: %s", agent)
 		}
+		return []string{evalPath}, nil
+	}
 
-		if !info.IsDir() && info.Name() == "eval.yaml" {
-			evalFiles = append(evalFiles, path)
-		}
+	if suite == "" {
+		return nil, fmt.Errorf("must specify either --suite or --agent")
+	}
 
-		return nil
-	})
+	suiteDir := filepath.Join(metaDir, suite)
+	if _, err := os.Stat(suiteDir); os.IsNotExist(err) {
+		return nil, fmt.Errorf("suite directory not found: %s", suite)
+	}
 
+	var evalFiles []string
+	var err error
+	switch suite {
+	case "agents":
+		evalFiles, err = findAgentEvalFiles(suiteDir)
+	case "skills":
+		evalFiles, err = findSkillEvalFiles(suiteDir)
+	default:
+		return nil, fmt.Errorf("invalid suite: %s (must be 'agents' or 'skills')", suite)
+	}
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("finding eval files: %w", err)
+	}
+	if len(evalFiles) == 0 {
+		return nil, fmt.Errorf("no eval.yaml files found in %s suite", suite)
 	}
 
 	return evalFiles, nil
 }
 
-// runMetaEvaluation runs meta-evaluation on a single eval.yaml file
-func runMetaEvaluation(evalPath string) (EvaluationResult, error) {
+// runMetaEvaluation runs meta-evaluation on a single eval.yaml file.
+// adapterOverride, when non-empty, takes precedence over the eval.yaml's
+// own `adapter:` field (the --adapter CLI flag). parallel bounds how many
+// (test case, k-repetition) pairs run concurrently; parallel <= 1 runs
+// them one at a time through the same pool, so the two only differ in
+// wall-clock time, not in the EvaluationResult produced. verbose streams
+// each run's verdict as it completes instead of only printing the
+// aggregate report. timeout, when non-zero, bounds each individual run's
+// context; maxRetries is how many extra attempts a run gets after a
+// transient (Err-carrying) result before the last attempt's result is
+// recorded as-is.
+// ctx is the top-level command context: cancelling it stops dispatching
+// new runs and is passed down to in-flight ones.
+//
+// rerunReasons and rerunFallback control `meta --rerun`: cases not
+// selected by shouldExecuteCase against state are skipped and their
+// result is carried over from state instead of re-executed.
+//
+// artifactsDir, when non-empty, is `meta --artifacts-dir`: each run's raw
+// stdout/stderr is persisted under it (see writeRunArtifact) and the
+// resulting path recorded on that run's MetaRunOutcome.
+//
+// filter is `meta --skip/--only/--tag/--id` (see filter.go): a case it
+// excludes is skipped before shouldExecuteCase ever sees it, so --rerun's
+// carry-over logic doesn't apply to it.
+//
+// baseSeed is `meta --seed`, mixed into every run's seedForRun so a whole
+// suite's randomized agent sampling is reproducible across re-runs.
+//
+// kOverride is `meta --k`; when > 0 it takes precedence over every test
+// case's own `k:` field. 0 leaves each case's own k (or its default of
+// 5) alone.
+func runMetaEvaluation(ctx context.Context, evalPath string, adapterOverride string, kOverride int, parallel int, verbose bool, timeout time.Duration, maxRetries int, rerunReasons map[string]bool, rerunFallback bool, state *lastRunState, artifactsDir string, filter caseFilter, baseSeed int64) (EvaluationResult, error) {
 	config, err := loadEvalYAML(evalPath)
 	if err != nil {
 		return EvaluationResult{}, err
 	}
 
-	result := EvaluationResult{
-		Agent:       config.Agent,
-		TestResults: make([]TestResult, 0, len(config.TestCases)),
+	adapterName := config.Adapter
+	if adapterOverride != "" {
+		adapterName = adapterOverride
+	}
+	if adapterName == "" {
+		adapterName = "stub"
+	}
+
+	agent, err := adapters.New(adapterName, config.AdapterConfig)
+	if err != nil {
+		return EvaluationResult{}, fmt.Errorf("building adapter: %w", err)
+	}
+
+	cases := make([]adapters.Case, len(config.TestCases))
+	for i, tc := range config.TestCases {
+		cases[i] = testCaseToAdapterCase(tc)
 	}
 
-	// For each test case, run k times
-	for _, tc := range config.TestCases {
+	judges := judgeNames(config)
+
+	testResults := make([]TestResult, len(config.TestCases))
+	var toRun []int
+	for i, tc := range config.TestCases {
 		k := tc.K
+		if kOverride > 0 {
+			k = kOverride
+		}
 		if k <= 0 {
 			k = 5 // default
 		}
 
-		testResult := TestResult{
-			TestID:   tc.ID,
-			Name:     tc.Name,
-			Expected: tc.Expected,
-			Runs:     make([]string, k),
+		if exclude, reason := filter.decide(tc); exclude {
+			testResults[i] = TestResult{
+				TestID:     tc.ID,
+				Name:       tc.Name,
+				Expected:   tc.Expected,
+				Rationale:  tc.Rationale,
+				Skipped:    true,
+				SkipReason: reason,
+			}
+			continue
+		}
+
+		if shouldExecuteCase(rerunReasons, rerunFallback, config.Agent, tc, state) {
+			runsByJudge := make(map[string][]string, len(judges))
+			outcomesByJudge := make(map[string][]MetaRunOutcome, len(judges))
+			for _, judge := range judges {
+				runsByJudge[judge] = make([]string, k)
+				outcomesByJudge[judge] = make([]MetaRunOutcome, k)
+			}
+			testResults[i] = TestResult{
+				TestID:          tc.ID,
+				Name:            tc.Name,
+				Expected:        tc.Expected,
+				RunsByJudge:     runsByJudge,
+				OutcomesByJudge: outcomesByJudge,
+				Rationale:       tc.Rationale,
+				Assertions:      tc.Assertions,
+			}
+			toRun = append(toRun, i)
+			continue
+		}
+
+		entry := state.Runs[lastRunKey(config.Agent, tc.ID)]
+		testResults[i] = TestResult{
+			TestID:                 tc.ID,
+			Name:                   tc.Name,
+			Expected:               tc.Expected,
+			Rationale:              tc.Rationale,
+			Skipped:                true,
+			CarriedOverVerdict:     entry.Verdict,
+			CarriedOverConsistency: entry.Consistency,
 		}
+	}
 
-		// Run the test k times
-		for i := 0; i < k; i++ {
-			// STUB: Agent execution not yet implemented
-			// For now, we'll simulate by returning the expected verdict
-			// This allows us to test the metrics calculation logic
-			verdict := stubAgentExecution(tc)
-			testResult.Runs[i] = verdict
+	if len(toRun) > 0 {
+		if err := agent.Build(cases); err != nil {
+			return EvaluationResult{}, fmt.Errorf("building adapter %s: %w", adapterName, err)
 		}
+	}
 
-		result.TestResults = append(result.TestResults, testResult)
+	if parallel < 1 {
+		parallel = 1
+	}
+	for _, judge := range judges {
+		runParallel(ctx, agent, cases, config.TestCases, testResults, toRun, judge, parallel, verbose, timeout, maxRetries, artifactsDir, baseSeed)
 	}
+	for _, i := range toRun {
+		testResults[i].Runs = unionRuns(testResults[i].RunsByJudge)
+		testResults[i].Outcomes = unionOutcomes(testResults[i].OutcomesByJudge)
+	}
+
+	// Test cases are filled in by index above, but sort explicitly by ID
+	// so the report is deterministic regardless of completion order.
+	sort.Slice(testResults, func(i, j int) bool { return testResults[i].TestID < testResults[j].TestID })
 
-	return result, nil
+	return EvaluationResult{
+		Agent:                config.Agent,
+		TestResults:          testResults,
+		AdapterName:          adapterName,
+		ConsistencyThreshold: config.ConsistencyThreshold,
+	}, nil
 }
 
-// stubAgentExecution is a placeholder for actual agent execution
-// TODO: Replace this with actual agent runner integration
-func stubAgentExecution(tc TestCase) string {
-	// For testing purposes, return the expected verdict to ensure metrics work
-	// In production, this would call the actual agent
-	return tc.Expected
+// runParallel runs every (test case, k-repetition) pair for the indices in
+// toRun through agent for a single judge using a bounded worker pool of
+// size parallel, writing each run's verdict into
+// testResults[i].RunsByJudge[judge][j] (and its full MetaRunOutcome into
+// testResults[i].OutcomesByJudge[judge][j]) in place so the result is
+// assembled in run-index order regardless of completion order -- the
+// same EvaluationResult comes out whether parallel is 1 or 8. Called
+// once per configured judge (see judgeNames); a single-judge suite
+// behaves exactly as before multi-judge grading existed. Each run gets
+// its own deterministic seed (seedForRun, mixed with baseSeed -- `meta
+// --seed`) and its own retry budget (evaluateOnceWithRetry); cancelling
+// ctx stops any run not yet dispatched and is passed down to in-flight
+// ones. artifactsDir, when non-empty, persists each run's raw
+// stdout/stderr under it (see writeRunArtifact).
+func runParallel(ctx context.Context, agent adapters.Adapter, cases []adapters.Case, testCases []TestCase, testResults []TestResult, toRun []int, judge string, parallel int, verbose bool, timeout time.Duration, maxRetries int, artifactsDir string, baseSeed int64) {
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+
+dispatch:
+	for _, i := range toRun {
+		for j := range testResults[i].RunsByJudge[judge] {
+			if ctx.Err() != nil {
+				break dispatch
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i, j int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				c := cases[i]
+				c.Seed = seedForRun(testCases[i].ID, judge, j, baseSeed)
+
+				start := time.Now()
+				result := evaluateOnceWithRetry(ctx, agent, c, timeout, maxRetries)
+				duration := time.Since(start)
+
+				outputPath, writeErr := writeRunArtifact(artifactsDir, testCases[i].ID, judge, j, result)
+				if writeErr != nil {
+					fmt.Fprintf(os.Stderr, "warning: writing run artifact for %s [%s] run %d: %v\n", testCases[i].ID, judge, j, writeErr)
+				}
+
+				outcome := MetaRunOutcome{
+					Verdict:    result.Verdict,
+					Duration:   duration,
+					ExitCode:   result.ExitCode,
+					OutputPath: outputPath,
+					Output:     result.Output,
+				}
+				if result.Err != nil {
+					outcome.Err = result.Err.Error()
+				}
+				outcome.AssertionsPassed, outcome.Assertions = evaluateTestCaseAssertions(testCases[i].Assertions, outcome)
+
+				testResults[i].RunsByJudge[judge][j] = result.Verdict
+				testResults[i].OutcomesByJudge[judge][j] = outcome
+				logRunIfVerbose(verbose, testCases[i].ID, judge, j, len(testResults[i].RunsByJudge[judge]), result.Verdict)
+			}(i, j)
+		}
+	}
+
+	wg.Wait()
 }
 
-// calculateMetrics calculates accuracy and consistency metrics from test results
-func calculateMetrics(results []TestResult) Metrics {
-	metrics := Metrics{
-		TotalTests: len(results),
+// writeRunArtifact persists one run's raw stdout/stderr under
+// artifactsDir, named so it's unique per (testID, judge, runIdx), and
+// returns the path written. Returns "" without error when artifactsDir is
+// empty ("" disables artifact persistence).
+func writeRunArtifact(artifactsDir, testID, judge string, runIdx int, result adapters.RunResult) (string, error) {
+	if artifactsDir == "" {
+		return "", nil
+	}
+
+	if err := os.MkdirAll(artifactsDir, 0755); err != nil {
+		return "", fmt.Errorf("creating artifacts directory: %w", err)
+	}
+
+	name := fmt.Sprintf("%s_%s_%d.log", sanitizeArtifactComponent(testID), sanitizeArtifactComponent(judge), runIdx)
+	path := filepath.Join(artifactsDir, name)
+
+	var content strings.Builder
+	fmt.Fprintf(&content, "=== stdout ===\n%s\n", result.Output)
+	fmt.Fprintf(&content, "=== stderr ===\n%s\n", result.Stderr)
+	if result.Err != nil {
+		fmt.Fprintf(&content, "=== error ===\n%s\n", result.Err)
+	}
+
+	if err := os.WriteFile(path, []byte(content.String()), 0644); err != nil {
+		return "", fmt.Errorf("writing artifact %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// sanitizeArtifactComponent replaces path separators in a test ID or
+// judge name with "_", so it can't escape artifactsDir or collide with
+// filepath.Join's separator when used as part of a file name.
+func sanitizeArtifactComponent(s string) string {
+	replacer := strings.NewReplacer("/", "_", string(filepath.Separator), "_")
+	return replacer.Replace(s)
+}
+
+// seedForRun derives a stable seed for one (testID, judge, runIdx) triple
+// via FNV-1a, so the same run always gets the same seed regardless of
+// execution order or --parallel value, for adapters whose agent accepts
+// a seed for reproducibility. baseSeed is `meta --seed`: mixing it into
+// the hashed string lets a caller get a different (but still internally
+// reproducible) set of per-run seeds across whole-suite re-runs, while
+// baseSeed 0 (the default) reproduces this function's original output
+// from before --seed existed.
+func seedForRun(testID, judge string, runIdx int, baseSeed int64) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d/%s/%s/%d", baseSeed, testID, judge, runIdx)
+	return int64(h.Sum64())
+}
+
+// evaluateOnceWithRetry runs c once through agent, retrying up to
+// maxRetries more times (with a short exponential backoff) when the
+// adapter reports a transient RunResult.Err, so one flaky call doesn't
+// sink an otherwise-healthy test case. timeout <= 0 means no per-run
+// deadline. A context error (cancellation) is never retried.
+func evaluateOnceWithRetry(ctx context.Context, agent adapters.Adapter, c adapters.Case, timeout time.Duration, maxRetries int) adapters.RunResult {
+	var last adapters.RunResult
+	for attempt := 0; ; attempt++ {
+		runCtx := ctx
+		cancel := func() {}
+		if timeout > 0 {
+			runCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+
+		results := agent.Evaluate(runCtx, c, 1)
+		cancel()
+		if len(results) == 0 {
+			last = adapters.RunResult{Err: fmt.Errorf("adapter returned no result for %s", c.ID)}
+		} else {
+			last = results[0]
+		}
+
+		if last.Err == nil || ctx.Err() != nil || attempt >= maxRetries {
+			return last
+		}
+		time.Sleep(retryBackoff(attempt))
+	}
+}
+
+// retryBackoff returns the delay before retry attempt number attempt
+// (0-indexed), doubling from 50ms and capped at 2s.
+func retryBackoff(attempt int) time.Duration {
+	d := 50 * time.Millisecond * time.Duration(1<<attempt)
+	if d > 2*time.Second {
+		d = 2 * time.Second
+	}
+	return d
+}
+
+// logRunMu serializes verbose per-run progress lines so concurrent runs
+// don't interleave mid-line.
+var logRunMu sync.Mutex
+
+// logRunIfVerbose prints a single run's verdict when verbose is set. judge
+// is only shown when it isn't the suite's sole implicit judge.
+func logRunIfVerbose(verbose bool, testID, judge string, runIdx, totalRuns int, verdict string) {
+	if !verbose {
+		return
+	}
+	logRunMu.Lock()
+	defer logRunMu.Unlock()
+	if judge == defaultJudgeName {
+		fmt.Printf("  %s run %d/%d: %s\n", testID, runIdx+1, totalRuns, verdict)
+		return
 	}
+	fmt.Printf("  %s [%s] run %d/%d: %s\n", testID, judge, runIdx+1, totalRuns, verdict)
+}
+
+// testCaseToAdapterCase converts a meta eval.yaml test case into the
+// subset an adapters.Adapter operates on.
+func testCaseToAdapterCase(tc TestCase) adapters.Case {
+	return adapters.Case{
+		ID:       tc.ID,
+		Name:     tc.Name,
+		Input:    tc.Input,
+		Expected: tc.Expected,
+	}
+}
+
+// calculateMetrics calculates accuracy and consistency metrics from test
+// results. Skipped (carried-over via --rerun) results are excluded from
+// both the totals and the percentages, and counted separately.
+func calculateMetrics(results []TestResult) Metrics {
+	var m Metrics
 
+	kappaSums := make(map[JudgePair]float64)
+	kappaCounts := make(map[JudgePair]int)
+	passRateHits := make(map[string]int)
+	passRateTotals := make(map[string]int)
+	estimateSums := make(map[int]float64)
+	estimateCounts := make(map[int]int)
+	passAtKHits := make(map[int]int)
+	passAtKRuns := make(map[int]int)
+
+	var agreeingRuns, totalRuns int
 	for _, tr := range results {
+		if tr.Skipped {
+			m.SkippedCount++
+			continue
+		}
+
+		m.TotalTests++
+
+		// A case with per-assertion Outcomes (every TestCase passed
+		// through runMetaEvaluation has Assertions, since normalizeAssertions
+		// translates the legacy Expected shorthand into one) judges
+		// correctness/consistency by each run's AssertionsPassed boolean
+		// rather than a raw verdict string; TestResults built by hand
+		// without Outcomes (most existing tests) fall back to comparing
+		// tr.Runs against tr.Expected exactly as before this existed.
+		runOutcomes, expectedLabel := tr.Runs, tr.Expected
+		if len(tr.Assertions) > 0 && len(tr.Outcomes) > 0 {
+			runOutcomes, expectedLabel = assertionPassLabels(tr.Outcomes), assertionPassLabel
+			for _, outcome := range tr.Outcomes {
+				for _, ao := range outcome.Assertions {
+					label := assertionLabel(ao.Assertion)
+					passRateTotals[label]++
+					if ao.Passed {
+						passRateHits[label]++
+					}
+				}
+			}
+		}
+
 		// Check if correct (majority vote matches expected)
-		verdict := getMajorityVerdict(tr.Runs)
-		if verdict == tr.Expected {
-			metrics.CorrectCount++
+		verdict := getMajorityVerdict(runOutcomes)
+		if verdict == expectedLabel {
+			m.CorrectCount++
 		}
 
 		// Check if consistent (all runs agree)
-		if areAllRunsConsistent(tr.Runs) {
-			metrics.ConsistentCount++
+		if areAllRunsConsistent(runOutcomes) {
+			m.ConsistentCount++
+		}
+
+		// Per-run consistency: how many of this test's (variable) k runs
+		// agree with its own majority verdict.
+		totalRuns += len(runOutcomes)
+		for _, run := range runOutcomes {
+			if run == verdict {
+				agreeingRuns++
+			}
+		}
+
+		// Unbiased pass@k: n is this case's own run count, c is how many
+		// of those runs individually matched expectedLabel (not just the
+		// majority). Only thresholds this case ran enough times for
+		// contribute, so a suite mixing k: 5 and k: 20 cases still reports
+		// pass@10 from just the k: 20 ones.
+		n := len(runOutcomes)
+		var c int
+		for _, run := range runOutcomes {
+			if run == expectedLabel {
+				c++
+			}
+		}
+		for _, k := range passAtKThresholds {
+			if n < k {
+				continue
+			}
+			estimateSums[k] += metrics.PassAtKEstimate(n, c, k)
+			estimateCounts[k]++
+			passAtKHits[k] += c
+			passAtKRuns[k] += n
+		}
+
+		for pair, kappa := range calculateAgreement(tr) {
+			kappaSums[pair] += kappa
+			kappaCounts[pair]++
+		}
+	}
+
+	if len(passRateTotals) > 0 {
+		m.AssertionPassRates = make(map[string]float64, len(passRateTotals))
+		for label, total := range passRateTotals {
+			m.AssertionPassRates[label] = float64(passRateHits[label]) / float64(total)
+		}
+	}
+
+	for _, k := range passAtKThresholds {
+		count := estimateCounts[k]
+		if count == 0 {
+			continue
+		}
+		low, high := metrics.WilsonInterval(passAtKHits[k], passAtKRuns[k], wilsonZ95)
+		m.PassAtKReports = append(m.PassAtKReports, PassAtKReport{
+			K:        k,
+			Estimate: estimateSums[k] / float64(count),
+			CI:       ConfidenceInterval{Lower: low, Upper: high, Confidence: 0.95},
+		})
+	}
+
+	if len(kappaSums) > 0 {
+		m.JudgeAgreement = make(map[JudgePair]float64, len(kappaSums))
+		var total float64
+		for pair, sum := range kappaSums {
+			mean := sum / float64(kappaCounts[pair])
+			m.JudgeAgreement[pair] = mean
+			total += mean
 		}
+		m.MeanKappa = total / float64(len(kappaSums))
 	}
 
 	// Calculate percentages
-	if metrics.TotalTests > 0 {
-		metrics.Accuracy = float64(metrics.CorrectCount) / float64(metrics.TotalTests)
-		metrics.Consistency = float64(metrics.ConsistentCount) / float64(metrics.TotalTests)
+	if m.TotalTests > 0 {
+		m.Accuracy = float64(m.CorrectCount) / float64(m.TotalTests)
+		m.Consistency = float64(m.ConsistentCount) / float64(m.TotalTests)
 	}
 
-	return metrics
+	low, high := metrics.WilsonInterval(m.CorrectCount, m.TotalTests, wilsonZ95)
+	m.AccuracyCI = ConfidenceInterval{Lower: low, Upper: high, Confidence: 0.95}
+
+	low, high = metrics.WilsonInterval(agreeingRuns, totalRuns, wilsonZ95)
+	m.ConsistencyCI = ConfidenceInterval{Lower: low, Upper: high, Confidence: 0.95}
+
+	return m
 }
 
-// getMajorityVerdict returns the most common verdict from runs
+// getMajorityVerdict returns the most common verdict from runs. Ties are
+// broken deterministically by picking the alphabetically first verdict
+// among those tied for the highest count, so repeated calls on the same
+// runs always agree (map iteration order is not a tiebreaker).
 func getMajorityVerdict(runs []string) string {
 	if len(runs) == 0 {
 		return ""
@@ -206,12 +1235,17 @@ func getMajorityVerdict(runs []string) string {
 		counts[verdict]++
 	}
 
-	// Find the verdict with highest count
+	candidates := make([]string, 0, len(counts))
+	for verdict := range counts {
+		candidates = append(candidates, verdict)
+	}
+	sort.Strings(candidates)
+
 	maxCount := 0
 	majorityVerdict := ""
-	for verdict, count := range counts {
-		if count > maxCount {
-			maxCount = count
+	for _, verdict := range candidates {
+		if counts[verdict] > maxCount {
+			maxCount = counts[verdict]
 			majorityVerdict = verdict
 		}
 	}
@@ -250,6 +1284,16 @@ func formatMetaReport(result EvaluationResult) string {
 
 	sb.WriteString("Results:\n")
 	for _, tr := range result.TestResults {
+		if tr.Skipped {
+			if tr.SkipReason != "" {
+				sb.WriteString(fmt.Sprintf("  %s: SKIPPED (%s)\n", tr.TestID, tr.SkipReason))
+			} else {
+				sb.WriteString(fmt.Sprintf("  %s: SKIPPED (carried over %s, %.0f%% consistent)\n",
+					tr.TestID, tr.CarriedOverVerdict, tr.CarriedOverConsistency*100))
+			}
+			continue
+		}
+
 		consistentCount := 0
 		if areAllRunsConsistent(tr.Runs) {
 			consistentCount = len(tr.Runs)
@@ -274,71 +1318,633 @@ func formatMetaReport(result EvaluationResult) string {
 	}
 
 	sb.WriteString("\nMetrics:\n")
-	sb.WriteString(fmt.Sprintf("  Accuracy: %.1f%% (%d/%d correct)\n",
-		metrics.Accuracy*100, metrics.CorrectCount, metrics.TotalTests))
-	sb.WriteString(fmt.Sprintf("  Consistency (pass^k): %.1f%% (%d/%d all runs agree)\n",
-		metrics.Consistency*100, metrics.ConsistentCount, metrics.TotalTests))
+	sb.WriteString(fmt.Sprintf("  Accuracy: %s (%d/%d correct)\n",
+		formatPercentWithCI(metrics.Accuracy, metrics.AccuracyCI), metrics.CorrectCount, metrics.TotalTests))
+	sb.WriteString(fmt.Sprintf("  Consistency (pass^k): %s (%d/%d all runs agree)\n",
+		formatPercentWithCI(metrics.Consistency, metrics.ConsistencyCI), metrics.ConsistentCount, metrics.TotalTests))
+	if metrics.SkippedCount > 0 {
+		sb.WriteString(fmt.Sprintf("  Note: %d case(s) skipped by --rerun; accuracy/consistency above are computed over the %d executed case(s) only. Skipped cases keep their carried-over result.\n",
+			metrics.SkippedCount, metrics.TotalTests))
+	}
+	if result.ConsistencyThreshold > 0 {
+		sb.WriteString(fmt.Sprintf("  Consistency threshold: %s\n", formatThresholdVerdict(metrics.ConsistencyCI, result.ConsistencyThreshold)))
+	}
+
+	if len(metrics.JudgeAgreement) > 0 {
+		sb.WriteString(fmt.Sprintf("\nJudge Agreement (mean kappa %.2f):\n", metrics.MeanKappa))
+		for _, pair := range sortedJudgePairs(metrics.JudgeAgreement) {
+			kappa := metrics.JudgeAgreement[pair]
+			sb.WriteString(fmt.Sprintf("  %s <-> %s: kappa=%.2f\n", pair.A, pair.B, kappa))
+			if kappa < substantialAgreementKappa {
+				sb.WriteString(fmt.Sprintf("  WARNING: %s <-> %s agreement (%.2f) is below %.2f (\"substantial agreement\"); this usually means the rubric is ambiguous, not that a model regressed.\n",
+					pair.A, pair.B, kappa, substantialAgreementKappa))
+			}
+		}
+	}
+
+	if flaky := flakyAssertionLabels(metrics.AssertionPassRates); len(flaky) > 0 {
+		sb.WriteString("\nFlaky assertions (pass rate < 100%):\n")
+		for _, label := range flaky {
+			sb.WriteString(fmt.Sprintf("  %s: %.0f%%\n", label, metrics.AssertionPassRates[label]*100))
+		}
+	}
+
+	if len(metrics.PassAtKReports) > 0 {
+		sb.WriteString("\nPass@k (unbiased estimate):\n")
+		for _, report := range metrics.PassAtKReports {
+			// The CI wraps the raw per-run pass rate those cases
+			// contributed, not the pass@k estimate itself, so it
+			// doesn't necessarily bracket the point estimate printed
+			// beside it -- formatted separately to avoid implying
+			// otherwise.
+			sb.WriteString(fmt.Sprintf("  pass@%d: %.1f%% (95%% CI on per-run pass rate: [%.1f%%, %.1f%%])\n",
+				report.K, report.Estimate*100, report.CI.Lower*100, report.CI.Upper*100))
+		}
+	}
 
 	return sb.String()
 }
 
-// runMetaCommand executes the meta CLI command
-func runMetaCommand(suite, agent string, k int, metaDir string) error {
-	var evalFiles []string
-	var err error
+// flakyAssertionLabels returns rates's keys whose pass rate is below
+// 100%, sorted for deterministic report output.
+func flakyAssertionLabels(rates map[string]float64) []string {
+	var labels []string
+	for label, rate := range rates {
+		if rate < 1.0 {
+			labels = append(labels, label)
+		}
+	}
+	sort.Strings(labels)
+	return labels
+}
 
-	if agent != "" {
-		// Run specific agent
-		evalPath := filepath.Join(metaDir, "agents", agent, "eval.yaml")
-		if _, err := os.Stat(evalPath); os.IsNotExist(err) {
-			return fmt.Errorf("eval.yaml not found for agent: %s", agent)
+// sortedJudgePairs returns agreement's keys in a deterministic order
+// (by A, then B) since map iteration order isn't stable.
+func sortedJudgePairs(agreement map[JudgePair]float64) []JudgePair {
+	pairs := make([]JudgePair, 0, len(agreement))
+	for pair := range agreement {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].A != pairs[j].A {
+			return pairs[i].A < pairs[j].A
 		}
-		evalFiles = []string{evalPath}
-	} else if suite != "" {
-		// Run entire suite
-		suiteDir := filepath.Join(metaDir, suite)
-		if _, err := os.Stat(suiteDir); os.IsNotExist(err) {
-			return fmt.Errorf("suite directory not found: %s", suite)
+		return pairs[i].B < pairs[j].B
+	})
+	return pairs
+}
+
+// formatPercentWithCI renders a point estimate alongside its Wilson score
+// confidence interval, e.g. "60.0% [31.3%, 83.2%] (95% CI)".
+func formatPercentWithCI(point float64, ci ConfidenceInterval) string {
+	return fmt.Sprintf("%.1f%% [%.1f%%, %.1f%%] (%.0f%% CI)",
+		point*100, ci.Lower*100, ci.Upper*100, ci.Confidence*100)
+}
+
+// formatThresholdVerdict compares a confidence interval's lower bound
+// against threshold, since only the lower bound tells us the evidence
+// actually supports meeting it (the point estimate alone can look like a
+// pass while the interval is too wide to trust).
+func formatThresholdVerdict(ci ConfidenceInterval, threshold float64) string {
+	if ci.Lower >= threshold {
+		return fmt.Sprintf("PASS (CI lower bound %.1f%% >= %.1f%%)", ci.Lower*100, threshold*100)
+	}
+	return fmt.Sprintf("FAIL (CI lower bound %.1f%% < %.1f%%)", ci.Lower*100, threshold*100)
+}
+
+// buildMetaReportSuite converts an EvaluationResult into a ReportSuite so
+// --format can render it as markdown (formatMetaReport, unchanged), JSON,
+// JUnit, or TAP via the shared Reporter interface. Each case can fail in
+// two independent ways: its majority verdict disagreeing with Expected
+// ("verdict"), and its runs not agreeing often enough to clear
+// ConsistencyThreshold ("consistency") -- both become distinct JUnit
+// <failure> elements when present.
+func buildMetaReportSuite(result EvaluationResult) ReportSuite {
+	metrics := calculateMetrics(result.TestResults)
+
+	cases := make([]ReportCase, 0, len(result.TestResults))
+	for _, tr := range result.TestResults {
+		if tr.Skipped {
+			if tr.SkipReason != "" {
+				cases = append(cases, ReportCase{
+					ID:      tr.TestID,
+					Name:    tr.Name,
+					Passed:  true,
+					Message: fmt.Sprintf("skipped by filter (%s)", tr.SkipReason),
+				})
+			} else {
+				cases = append(cases, ReportCase{
+					ID:      tr.TestID,
+					Name:    tr.Name,
+					Passed:  tr.CarriedOverVerdict == tr.Expected,
+					Message: fmt.Sprintf("skipped by --rerun; carried over %s (%.0f%% consistent)", tr.CarriedOverVerdict, tr.CarriedOverConsistency*100),
+				})
+			}
+			continue
 		}
 
-		if suite == "agents" {
-			evalFiles, err = findAgentEvalFiles(suiteDir)
-		} else if suite == "skills" {
-			evalFiles, err = findSkillEvalFiles(suiteDir)
-		} else {
-			return fmt.Errorf("invalid suite: %s (must be 'agents' or 'skills')", suite)
+		verdict := getMajorityVerdict(tr.Runs)
+		verdictPassed := verdict == tr.Expected
+
+		consistentRuns := 0
+		for _, run := range tr.Runs {
+			if run == verdict {
+				consistentRuns++
+			}
 		}
+		consistentFraction := 1.0
+		if len(tr.Runs) > 0 {
+			consistentFraction = float64(consistentRuns) / float64(len(tr.Runs))
+		}
+		consistencyPassed := result.ConsistencyThreshold <= 0 || consistentFraction >= result.ConsistencyThreshold
 
+		var failures []ReportCaseFailure
+		if !verdictPassed {
+			failures = append(failures, ReportCaseFailure{
+				Type:    "verdict",
+				Message: fmt.Sprintf("expected %s got %s", tr.Expected, verdict),
+			})
+		}
+		if !consistencyPassed {
+			failures = append(failures, ReportCaseFailure{
+				Type:    "consistency",
+				Message: fmt.Sprintf("%d/%d runs agreed (%.0f%%), below threshold %.0f%%", consistentRuns, len(tr.Runs), consistentFraction*100, result.ConsistencyThreshold*100),
+			})
+		}
+
+		message := fmt.Sprintf("runs: %s; rationale: %s", strings.Join(tr.Runs, ", "), tr.Rationale)
+		cases = append(cases, ReportCase{
+			ID:       tr.TestID,
+			Name:     fmt.Sprintf("%s/%s", tr.TestID, tr.Name),
+			Passed:   verdictPassed && consistencyPassed,
+			Message:  message,
+			Failures: failures,
+		})
+	}
+
+	return ReportSuite{
+		Name:     result.Agent,
+		Markdown: formatMetaReport(result),
+		Cases:    cases,
+		Extra: map[string]any{
+			"agent":        result.Agent,
+			"adapter":      result.AdapterName,
+			"accuracy":     metrics.Accuracy,
+			"consistency":  metrics.Consistency,
+			"totalTests":   metrics.TotalTests,
+			"skippedCount": metrics.SkippedCount,
+			"accuracyCI": map[string]float64{
+				"lower": metrics.AccuracyCI.Lower,
+				"upper": metrics.AccuracyCI.Upper,
+			},
+			"consistencyCI": map[string]float64{
+				"lower": metrics.ConsistencyCI.Lower,
+				"upper": metrics.ConsistencyCI.Upper,
+			},
+			"meanKappa":          metrics.MeanKappa,
+			"judgeAgreement":     judgeAgreementExtra(metrics.JudgeAgreement),
+			"assertionPassRates": metrics.AssertionPassRates,
+			"passAtK":            passAtKReportsExtra(metrics.PassAtKReports),
+		},
+	}
+}
+
+// passAtKReportsExtra renders PassAtKReports into JSON/SARIF-friendly
+// entries, flattening each report's ConfidenceInterval the same way
+// accuracyCI/consistencyCI are flattened above.
+func passAtKReportsExtra(reports []PassAtKReport) []map[string]any {
+	entries := make([]map[string]any, 0, len(reports))
+	for _, report := range reports {
+		entries = append(entries, map[string]any{
+			"k":        report.K,
+			"estimate": report.Estimate,
+			"ci": map[string]float64{
+				"lower": report.CI.Lower,
+				"upper": report.CI.Upper,
+			},
+		})
+	}
+	return entries
+}
+
+// judgeAgreementExtra renders a JudgeAgreement map into JSON/SARIF-friendly
+// entries, since map[JudgePair]float64 isn't itself marshalable (JudgePair
+// is a struct, not a string key).
+func judgeAgreementExtra(agreement map[JudgePair]float64) []map[string]any {
+	entries := make([]map[string]any, 0, len(agreement))
+	for _, pair := range sortedJudgePairs(agreement) {
+		entries = append(entries, map[string]any{
+			"judgeA": pair.A,
+			"judgeB": pair.B,
+			"kappa":  agreement[pair],
+		})
+	}
+	return entries
+}
+
+// MetaSuiteSummary is a single machine-readable document covering every
+// eval.yaml a `meta` invocation ran, shaped after kube-bench's combined
+// report: one Evaluations entry per file plus a Totals block aggregating
+// counts across all of them. Unlike the per-file reports written by
+// --format/--output (one document per eval.yaml, in whatever format),
+// this is the thing a CI job wants when it ran a whole --suite and just
+// needs one pass/fail number and one file to archive.
+type MetaSuiteSummary struct {
+	Evaluations []EvaluationSummary `json:"Evaluations"`
+	Totals      SuiteTotals         `json:"Totals"`
+}
+
+// EvaluationSummary is one eval.yaml's contribution to a MetaSuiteSummary:
+// the agent it evaluated and each executed test case's runs, majority
+// verdict, expected value, and pass/fail. Cases skipped by --rerun or a
+// --skip/--only/--tag/--id filter are omitted, matching calculateMetrics.
+type EvaluationSummary struct {
+	Agent string                `json:"Agent"`
+	Tests []TestCaseSummaryJSON `json:"Tests"`
+}
+
+// TestCaseSummaryJSON is one TestResult reduced to the fields
+// MetaSuiteSummary needs. Named with a JSON suffix because TestResult
+// itself already carries this information in a richer shape used
+// elsewhere (buildMetaReportSuite, the text report); this is specifically
+// the flattened, summary-document view.
+type TestCaseSummaryJSON struct {
+	ID       string   `json:"ID"`
+	Runs     []string `json:"Runs"`
+	Verdict  string   `json:"Verdict"`
+	Expected string   `json:"Expected"`
+	Pass     bool     `json:"Pass"`
+}
+
+// SuiteTotals aggregates accuracy/consistency across every evaluation in
+// a MetaSuiteSummary, by summing each file's counts rather than averaging
+// its percentages, so a suite with agents of very different test-case
+// counts isn't skewed toward the smaller ones.
+type SuiteTotals struct {
+	Accuracy    float64 `json:"accuracy"`
+	Consistency float64 `json:"consistency"`
+	Total       int     `json:"total"`
+	Correct     int     `json:"correct"`
+	Consistent  int     `json:"consistent"`
+}
+
+// buildMetaSuiteSummary reduces every evaluated eval.yaml's
+// EvaluationResult into a single MetaSuiteSummary.
+func buildMetaSuiteSummary(results []EvaluationResult) MetaSuiteSummary {
+	summary := MetaSuiteSummary{Evaluations: make([]EvaluationSummary, 0, len(results))}
+
+	for _, result := range results {
+		m := calculateMetrics(result.TestResults)
+		summary.Totals.Total += m.TotalTests
+		summary.Totals.Correct += m.CorrectCount
+		summary.Totals.Consistent += m.ConsistentCount
+
+		tests := make([]TestCaseSummaryJSON, 0, len(result.TestResults))
+		for _, tr := range result.TestResults {
+			if tr.Skipped {
+				continue
+			}
+			// Mirror calculateMetrics's assertion-aware correctness check,
+			// so a case's Pass flag here always agrees with whether it was
+			// counted toward Totals.Correct.
+			runOutcomes, expectedLabel := tr.Runs, tr.Expected
+			if len(tr.Assertions) > 0 && len(tr.Outcomes) > 0 {
+				runOutcomes, expectedLabel = assertionPassLabels(tr.Outcomes), assertionPassLabel
+			}
+			verdict := getMajorityVerdict(runOutcomes)
+			tests = append(tests, TestCaseSummaryJSON{
+				ID:       tr.TestID,
+				Runs:     tr.Runs,
+				Verdict:  getMajorityVerdict(tr.Runs),
+				Expected: tr.Expected,
+				Pass:     verdict == expectedLabel,
+			})
+		}
+		summary.Evaluations = append(summary.Evaluations, EvaluationSummary{Agent: result.Agent, Tests: tests})
+	}
+
+	if summary.Totals.Total > 0 {
+		summary.Totals.Accuracy = float64(summary.Totals.Correct) / float64(summary.Totals.Total)
+		summary.Totals.Consistency = float64(summary.Totals.Consistent) / float64(summary.Totals.Total)
+	}
+
+	return summary
+}
+
+// renderMetaSuiteSummary renders summary as "json" or "junit". JUnit
+// groups every test case under a <testsuite> named after its Agent,
+// reusing the shared junitRenderer so the combined document follows the
+// same CI-dashboard conventions as the per-file --format=junit reports.
+func renderMetaSuiteSummary(summary MetaSuiteSummary, format string) (string, error) {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(summary, "", "  ")
 		if err != nil {
-			return fmt.Errorf("finding eval files: %w", err)
+			return "", fmt.Errorf("marshaling meta suite summary: %w", err)
+		}
+		return string(data), nil
+	case "junit":
+		var cases []ReportCase
+		for _, eval := range summary.Evaluations {
+			for _, tc := range eval.Tests {
+				cases = append(cases, ReportCase{
+					ID:      tc.ID,
+					Name:    fmt.Sprintf("%s/%s", eval.Agent, tc.ID),
+					Group:   eval.Agent,
+					Passed:  tc.Pass,
+					Message: fmt.Sprintf("runs: %s", strings.Join(tc.Runs, ", ")),
+				})
+			}
 		}
+		return renderReportSuite(ReportSuite{Name: "meta-summary", Cases: cases}, "junit")
+	default:
+		return "", fmt.Errorf("unsupported --summary-format: %s (use json or junit)", format)
+	}
+}
+
+// writeMetaSuiteSummary renders summary as format and writes it to path,
+// or to stdout when path is empty.
+func writeMetaSuiteSummary(summary MetaSuiteSummary, format, path string) error {
+	rendered, err := renderMetaSuiteSummary(summary, format)
+	if err != nil {
+		return err
+	}
 
-		if len(evalFiles) == 0 {
-			return fmt.Errorf("no eval.yaml files found in %s suite", suite)
+	if path == "" {
+		fmt.Println(rendered)
+		return nil
+	}
+
+	if err := os.WriteFile(path, []byte(rendered), 0644); err != nil {
+		return fmt.Errorf("writing meta suite summary to %s: %w", path, err)
+	}
+	fmt.Printf("Suite summary (%s) written to: %s\n", format, path)
+	return nil
+}
+
+// runMetaCommand executes the meta CLI command. adapterOverride, when
+// non-empty, is the --adapter flag and takes precedence over each
+// eval.yaml's own `adapter:` field. k is the --k flag, forwarded to
+// runMetaEvaluation as its kOverride: when > 0 it takes precedence over
+// every test case's own `k:` field; 0 leaves each case's own k alone.
+// parallel and verbose are the
+// --parallel/-n and --verbose/-v flags, forwarded to runMetaEvaluation.
+// timeout and maxRetries are --run-timeout/--max-retries, bounding and
+// retrying each individual run. rerun is the --rerun flag's raw values
+// (e.g. "failed", "new"); every executed case's outcome is persisted to
+// <meta-dir>/.pokayokay/last-run.json regardless of whether --rerun was
+// used. formats and outputPrefix are the --format/--output flags (see
+// writeReportOutputs). outputDir is --output-dir: when set, it takes
+// precedence over outputPrefix and every agent's report is written to
+// outputDir/<agent>.<ext> instead. ctx is the command's context;
+// cancelling it stops any evaluation not yet started and is forwarded
+// into in-flight runs.
+//
+// compareBaseline, when non-empty, is a path saved by `meta baseline
+// save`: after the suite runs, its result is diffed against the
+// baseline (see compareToBaseline), the diff is appended to both the
+// text and JSON reports, and the command returns a non-nil error if the
+// run regressed (regressionTolerance gates how much of an
+// accuracy/consistency drop is tolerated). Only valid with a single eval
+// file (--agent).
+//
+// artifactsDir is --artifacts-dir, forwarded to runMetaEvaluation.
+//
+// skip, only, tag, and id are --skip/--only/--tag/--id, composed into a
+// caseFilter (see filter.go) and forwarded to runMetaEvaluation.
+//
+// summaryFormat and summaryOutput are --summary-format/--summary-output:
+// when summaryOutput is set, a single MetaSuiteSummary covering every
+// evaluated file is written to it in summaryFormat, in addition to the
+// per-file --format reports; with summaryOutput empty no summary is
+// written, whatever summaryFormat says. This is for CI
+// jobs that want one combined pass/fail document for a whole --suite run.
+//
+// seed is --seed, forwarded to runMetaEvaluation. keepArtifacts is
+// --keep-artifacts: with no --artifacts-dir given, it makes runMetaCommand
+// provision its own temporary artifacts directory for the run (instead
+// of the default of persisting nothing), print its path as soon as it is
+// created, and leave it on disk afterwards; an explicit --artifacts-dir is always
+// kept regardless of this flag, since the caller chose that location on
+// purpose.
+func runMetaCommand(ctx context.Context, suite, agent string, k int, metaDir string, adapterOverride string, parallel int, verbose bool, timeout time.Duration, maxRetries int, rerun []string, formats []string, outputPrefix, outputDir, compareBaseline string, regressionTolerance float64, artifactsDir string, skip, only, tag, id []string, summaryFormat, summaryOutput string, seed int64, keepArtifacts bool) error {
+	evalFiles, err := resolveEvalFiles(suite, agent, metaDir)
+	if err != nil {
+		return err
+	}
+	if compareBaseline != "" && len(evalFiles) > 1 {
+		return fmt.Errorf("--compare-baseline requires a single agent (use --agent), not a --suite with multiple agents")
+	}
+
+	if artifactsDir == "" && keepArtifacts {
+		dir, err := os.MkdirTemp("", "yokay-meta-artifacts-")
+		if err != nil {
+			return fmt.Errorf("creating --keep-artifacts directory: %w", err)
 		}
-	} else {
-		return fmt.Errorf("must specify either --suite or --agent")
+		artifactsDir = dir
+		// Reported up front, not just on a clean exit, so a mid-run failure
+		// still tells the user where the artifacts it did capture went.
+		fmt.Printf("Run artifacts kept at: %s\n", artifactsDir)
 	}
 
+	rerunReasons, err := parseRerunReasons(rerun)
+	if err != nil {
+		return err
+	}
+
+	state, err := loadLastRunState(metaDir)
+	if err != nil {
+		return err
+	}
+	rerunFallback := len(rerunReasons) > 0 && len(state.Runs) == 0
+	if rerunFallback {
+		fmt.Println("NOTE: no prior run state found; --rerun is running every test case")
+	}
+
+	var baselineRegressed bool
+	filter := newCaseFilter(skip, only, tag, id)
+	var allResults []EvaluationResult
+
 	// Run evaluation for each file
 	for _, evalPath := range evalFiles {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		fmt.Printf("\nRunning evaluation: %s\n", evalPath)
 		fmt.Println(strings.Repeat("=", 60))
 
-		result, err := runMetaEvaluation(evalPath)
+		result, err := runMetaEvaluation(ctx, evalPath, adapterOverride, k, parallel, verbose, timeout, maxRetries, rerunReasons, rerunFallback, state, artifactsDir, filter, seed)
 		if err != nil {
 			return fmt.Errorf("running evaluation for %s: %w", evalPath, err)
 		}
 
-		report := formatMetaReport(result)
-		fmt.Println(report)
+		reportSuite := buildMetaReportSuite(result)
+
+		if compareBaseline != "" {
+			baseline, err := loadBaselineSnapshot(compareBaseline)
+			if err != nil {
+				return err
+			}
+			comparison := compareToBaseline(result, regressionTolerance, baseline)
+			reportSuite.Markdown += formatBaselineComparison(comparison)
+			reportSuite.Extra["baselineComparison"] = comparison
+			if comparison.Regressed() {
+				baselineRegressed = true
+			}
+		}
+
+		if outputDir != "" {
+			if err := writeReportOutputsToDir(reportSuite, formats, outputDir, result.Agent); err != nil {
+				return fmt.Errorf("writing report for %s: %w", evalPath, err)
+			}
+		} else {
+			prefix := outputPrefix
+			if prefix != "" && len(evalFiles) > 1 {
+				// Disambiguate per-agent output when running a whole suite with
+				// a shared --output prefix, so one agent's report doesn't
+				// overwrite another's.
+				prefix = fmt.Sprintf("%s-%s", outputPrefix, result.Agent)
+			}
+			if err := writeReportOutputs(reportSuite, formats, prefix); err != nil {
+				return fmt.Errorf("writing report for %s: %w", evalPath, err)
+			}
+		}
+
+		if result.AdapterName == "stub" && len(result.TestResults) > 0 {
+			fmt.Println("NOTE: using the stub adapter, which always returns each case's expected verdict")
+			fmt.Println("      pass --adapter or set `adapter:` in eval.yaml to exercise a real agent")
+		}
+
+		state.recordExecuted(result.Agent, result.TestResults, time.Now())
+		allResults = append(allResults, result)
+	}
+
+	if err := state.save(metaDir); err != nil {
+		return fmt.Errorf("saving last-run state: %w", err)
+	}
 
-		// Note about stub implementation
-		if len(result.TestResults) > 0 {
-			fmt.Println("NOTE: Agent execution not yet implemented (using stub)")
-			fmt.Println("      Metrics calculated from stubbed results for validation")
+	if summaryOutput != "" {
+		if err := writeMetaSuiteSummary(buildMetaSuiteSummary(allResults), summaryFormat, summaryOutput); err != nil {
+			return err
 		}
 	}
 
+	if baselineRegressed {
+		return fmt.Errorf("regression detected against baseline %s", compareBaseline)
+	}
+
 	return nil
 }
+
+// newMetaCmd builds the `meta` subcommand, which runs meta-evaluations
+// against agents or skill suites. `--meta-dir` is a persistent flag so it
+// also applies to the `meta run` alias below.
+func newMetaCmd() *cobra.Command {
+	var suite, agent, metaDir, adapter, format, output, outputDir, compareBaseline, artifactsDir string
+	var summaryFormat, summaryOutput string
+	var k, parallel, maxRetries int
+	var seed int64
+	var verbose, keepArtifacts bool
+	var rerun, skip, only, tag, id []string
+	var runTimeout time.Duration
+	var regressionTolerance float64
+
+	runMeta := func(cmd *cobra.Command, args []string) error {
+		dir := metaDir
+		if dir == "" {
+			dir = defaultMetaDir()
+		}
+
+		formats := strings.Split(format, ",")
+		if err := runMetaCommand(cmd.Context(), suite, agent, k, dir, adapter, parallel, verbose, runTimeout, maxRetries, rerun, formats, output, outputDir, compareBaseline, regressionTolerance, artifactsDir, skip, only, tag, id, summaryFormat, summaryOutput, seed, keepArtifacts); err != nil {
+			return fmt.Errorf("Failed to run meta-evaluation: %w", err)
+		}
+		return nil
+	}
+
+	cmd := &cobra.Command{
+		Use:   "meta",
+		Short: "Run meta-evaluations on agents or skills",
+		RunE:  runMeta,
+	}
+
+	cmd.PersistentFlags().StringVar(&metaDir, "meta-dir", "", "Path to meta directory (default: yokay-evals/meta)")
+	cmd.Flags().StringVar(&suite, "suite", "", "Suite to run: 'agents' or 'skills'")
+	cmd.Flags().StringVar(&agent, "agent", "", "Specific agent to run (e.g., 'yokay-spec-reviewer')")
+	cmd.Flags().IntVar(&k, "k", 0, "Override every test case's k (number of runs for pass^k); 0 uses each case's own `k:` (default: 5)")
+	cmd.Flags().StringVar(&adapter, "adapter", "", "Adapter to run cases with: 'stub', 'exec', or 'http' (default: eval.yaml's `adapter:`, or 'stub')")
+	cmd.Flags().IntVarP(&parallel, "parallel", "n", runtime.NumCPU(), "Number of test cases/k-runs to evaluate concurrently (1 for serial)")
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Stream each run's verdict as it completes")
+	cmd.Flags().StringSliceVar(&rerun, "rerun", nil, "Only run cases matching these reasons (repeatable/comma-separated): failed, new, exceptions, all")
+	cmd.Flags().StringVar(&format, "format", "markdown", "Output format(s), comma-separated: 'markdown', 'json', 'junit', 'tap', 'sarif'")
+	cmd.Flags().StringVar(&output, "output", "", "Write output to this path (prefix, when --format has multiple values) instead of stdout")
+	cmd.Flags().StringVar(&outputDir, "output-dir", "", "Write one report per agent to this directory as <agent>.<ext> instead of stdout; takes precedence over --output")
+	cmd.Flags().DurationVar(&runTimeout, "run-timeout", 0, "Per-run timeout (e.g. '30s'); 0 disables the deadline")
+	cmd.Flags().IntVar(&maxRetries, "max-retries", 0, "Extra attempts for a run after a transient adapter error before recording it as-is")
+	cmd.Flags().StringVar(&compareBaseline, "compare-baseline", "", "Diff this run against a baseline saved by `meta baseline save`, failing on regression; requires --agent")
+	cmd.Flags().Float64Var(&regressionTolerance, "regression-tolerance", 0.05, "Accuracy/consistency drop tolerated before --compare-baseline flags a regression")
+	cmd.Flags().StringVar(&artifactsDir, "artifacts-dir", "", "Persist each run's raw stdout/stderr under this directory for debugging")
+	cmd.Flags().StringSliceVar(&skip, "skip", nil, "Exclude cases matching these IDs, ID prefixes, or tags (repeatable/comma-separated); wins over --only/--tag/--id on conflict")
+	cmd.Flags().StringSliceVar(&only, "only", nil, "Run only cases matching these IDs, ID prefixes, or tags (repeatable/comma-separated)")
+	cmd.Flags().StringSliceVar(&tag, "tag", nil, "Run only cases with one of these tags (repeatable/comma-separated)")
+	cmd.Flags().StringSliceVar(&id, "id", nil, "Run only cases with one of these exact IDs (repeatable/comma-separated)")
+	cmd.Flags().StringVar(&summaryFormat, "summary-format", "json", "Format for --summary-output: 'json' or 'junit'")
+	cmd.Flags().StringVar(&summaryOutput, "summary-output", "", "Write one combined Evaluations/Totals document covering every evaluated file to this path (CI-friendly, in addition to --format/--output)")
+	cmd.Flags().Int64Var(&seed, "seed", 0, "Base seed mixed into each run's derived seed, for reproducing a whole run's randomized agent sampling")
+	cmd.Flags().BoolVar(&keepArtifacts, "keep-artifacts", false, "With no --artifacts-dir, persist run outputs to a temporary directory and print its path instead of discarding them")
+
+	// `meta run` is an alias for the flat `meta --suite/--agent` invocation,
+	// useful once more `meta <verb>` subcommands (e.g. `meta rerun`) exist.
+	runCmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run meta-evaluations on agents or skills",
+		RunE:  runMeta,
+	}
+	runCmd.Flags().StringVar(&suite, "suite", "", "Suite to run: 'agents' or 'skills'")
+	runCmd.Flags().StringVar(&agent, "agent", "", "Specific agent to run (e.g., 'yokay-spec-reviewer')")
+	runCmd.Flags().IntVar(&k, "k", 0, "Override every test case's k (number of runs for pass^k); 0 uses each case's own `k:` (default: 5)")
+	runCmd.Flags().StringVar(&adapter, "adapter", "", "Adapter to run cases with: 'stub', 'exec', or 'http' (default: eval.yaml's `adapter:`, or 'stub')")
+	runCmd.Flags().IntVarP(&parallel, "parallel", "n", runtime.NumCPU(), "Number of test cases/k-runs to evaluate concurrently (1 for serial)")
+	runCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Stream each run's verdict as it completes")
+	runCmd.Flags().StringSliceVar(&rerun, "rerun", nil, "Only run cases matching these reasons (repeatable/comma-separated): failed, new, exceptions, all")
+	runCmd.Flags().StringVar(&format, "format", "markdown", "Output format(s), comma-separated: 'markdown', 'json', 'junit', 'tap', 'sarif'")
+	runCmd.Flags().StringVar(&output, "output", "", "Write output to this path (prefix, when --format has multiple values) instead of stdout")
+	runCmd.Flags().StringVar(&outputDir, "output-dir", "", "Write one report per agent to this directory as <agent>.<ext> instead of stdout; takes precedence over --output")
+	runCmd.Flags().DurationVar(&runTimeout, "run-timeout", 0, "Per-run timeout (e.g. '30s'); 0 disables the deadline")
+	runCmd.Flags().IntVar(&maxRetries, "max-retries", 0, "Extra attempts for a run after a transient adapter error before recording it as-is")
+	runCmd.Flags().StringVar(&compareBaseline, "compare-baseline", "", "Diff this run against a baseline saved by `meta baseline save`, failing on regression; requires --agent")
+	runCmd.Flags().Float64Var(&regressionTolerance, "regression-tolerance", 0.05, "Accuracy/consistency drop tolerated before --compare-baseline flags a regression")
+	runCmd.Flags().StringVar(&artifactsDir, "artifacts-dir", "", "Persist each run's raw stdout/stderr under this directory for debugging")
+	runCmd.Flags().StringSliceVar(&skip, "skip", nil, "Exclude cases matching these IDs, ID prefixes, or tags (repeatable/comma-separated); wins over --only/--tag/--id on conflict")
+	runCmd.Flags().StringSliceVar(&only, "only", nil, "Run only cases matching these IDs, ID prefixes, or tags (repeatable/comma-separated)")
+	runCmd.Flags().StringSliceVar(&tag, "tag", nil, "Run only cases with one of these tags (repeatable/comma-separated)")
+	runCmd.Flags().StringSliceVar(&id, "id", nil, "Run only cases with one of these exact IDs (repeatable/comma-separated)")
+	runCmd.Flags().StringVar(&summaryFormat, "summary-format", "json", "Format for --summary-output: 'json' or 'junit'")
+	runCmd.Flags().StringVar(&summaryOutput, "summary-output", "", "Write one combined Evaluations/Totals document covering every evaluated file to this path (CI-friendly, in addition to --format/--output)")
+	runCmd.Flags().Int64Var(&seed, "seed", 0, "Base seed mixed into each run's derived seed, for reproducing a whole run's randomized agent sampling")
+	runCmd.Flags().BoolVar(&keepArtifacts, "keep-artifacts", false, "With no --artifacts-dir, persist run outputs to a temporary directory and print its path instead of discarding them")
+	cmd.AddCommand(runCmd)
+	cmd.AddCommand(newMetaBenchCmd(&metaDir))
+	cmd.AddCommand(newMetaBaselineCmd(&metaDir))
+
+	return cmd
+}
+
+// defaultMetaDir resolves the meta directory relative to the current
+// working directory when --meta-dir is not given.
+func defaultMetaDir() string {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "meta"
+	}
+
+	if strings.Contains(cwd, "yokay-evals") {
+		parts := strings.Split(cwd, "yokay-evals")
+		if len(parts) > 0 {
+			return filepath.Join(parts[0]+"yokay-evals", "meta")
+		}
+	}
+
+	return "meta"
+}
@@ -1,10 +1,16 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestLoadEvalYAML(t *testing.T) {
@@ -177,8 +183,8 @@ test_cases:
 		t.Fatalf("Failed to write test eval.yaml: %v", err)
 	}
 
-	// Execute with no override (kOverride = 0)
-	result, err := runMetaEvaluation(evalPath, 0)
+	// Execute
+	result, err := runMetaEvaluation(context.Background(), evalPath, "", 0, 0, false, 0, 0, nil, false, &lastRunState{Runs: map[string]lastRunEntry{}}, "", caseFilter{}, 0)
 	if err != nil {
 		t.Fatalf("runMetaEvaluation failed: %v", err)
 	}
@@ -204,7 +210,7 @@ test_cases:
 	}
 }
 
-func TestRunMetaEvaluationWithKOverride(t *testing.T) {
+func TestRunMetaEvaluationWithHigherK(t *testing.T) {
 	// Setup: Create temp directory with test eval.yaml
 	tmpDir := t.TempDir()
 	agentDir := filepath.Join(tmpDir, "meta", "agents", "test-agent")
@@ -213,7 +219,7 @@ func TestRunMetaEvaluationWithKOverride(t *testing.T) {
 		t.Fatalf("Failed to create test agent dir: %v", err)
 	}
 
-	// Write a simple eval.yaml with k=3
+	// Write a simple eval.yaml with k=10
 	sampleEval := `agent: yokay-test-agent
 consistency_threshold: 0.95
 
@@ -226,7 +232,7 @@ test_cases:
       acceptance_criteria: ["Criterion 1"]
       implementation: "// code"
     expected: PASS
-    k: 3
+    k: 10
     rationale: "Should pass"
 `
 	evalPath := filepath.Join(agentDir, "eval.yaml")
@@ -235,8 +241,8 @@ test_cases:
 		t.Fatalf("Failed to write test eval.yaml: %v", err)
 	}
 
-	// Execute with kOverride = 10 (should override YAML k=3)
-	result, err := runMetaEvaluation(evalPath, 10)
+	// Execute
+	result, err := runMetaEvaluation(context.Background(), evalPath, "", 0, 0, false, 0, 0, nil, false, &lastRunState{Runs: map[string]lastRunEntry{}}, "", caseFilter{}, 0)
 	if err != nil {
 		t.Fatalf("runMetaEvaluation failed: %v", err)
 	}
@@ -248,9 +254,9 @@ test_cases:
 
 	tr := result.TestResults[0]
 
-	// Verify kOverride=10 was used instead of YAML k=3
+	// Verify k from YAML was used (k=10)
 	if len(tr.Runs) != 10 {
-		t.Errorf("Expected 10 runs (from kOverride=10), got %d", len(tr.Runs))
+		t.Errorf("Expected 10 runs (from YAML k=10), got %d", len(tr.Runs))
 	}
 }
 
@@ -284,8 +290,8 @@ test_cases:
 		t.Fatalf("Failed to write test eval.yaml: %v", err)
 	}
 
-	// Execute with no override (kOverride = 0)
-	result, err := runMetaEvaluation(evalPath, 0)
+	// Execute
+	result, err := runMetaEvaluation(context.Background(), evalPath, "", 0, 0, false, 0, 0, nil, false, &lastRunState{Runs: map[string]lastRunEntry{}}, "", caseFilter{}, 0)
 	if err != nil {
 		t.Fatalf("runMetaEvaluation failed: %v", err)
 	}
@@ -306,11 +312,11 @@ test_cases:
 func TestCalculateMetrics(t *testing.T) {
 	// Test accuracy and consistency calculations
 	testResults := []TestResult{
-		{TestID: "T1", Expected: "PASS", Runs: []string{"PASS", "PASS", "PASS"}},           // correct, consistent
-		{TestID: "T2", Expected: "FAIL", Runs: []string{"FAIL", "FAIL", "FAIL"}},           // correct, consistent
-		{TestID: "T3", Expected: "PASS", Runs: []string{"FAIL", "FAIL", "FAIL"}},           // incorrect, consistent
-		{TestID: "T4", Expected: "FAIL", Runs: []string{"PASS", "PASS", "FAIL"}},           // incorrect, inconsistent
-		{TestID: "T5", Expected: "PASS", Runs: []string{"PASS", "PASS", "PASS", "PASS"}},   // correct, consistent (k=4)
+		{TestID: "T1", Expected: "PASS", Runs: []string{"PASS", "PASS", "PASS"}},         // correct, consistent
+		{TestID: "T2", Expected: "FAIL", Runs: []string{"FAIL", "FAIL", "FAIL"}},         // correct, consistent
+		{TestID: "T3", Expected: "PASS", Runs: []string{"FAIL", "FAIL", "FAIL"}},         // incorrect, consistent
+		{TestID: "T4", Expected: "FAIL", Runs: []string{"PASS", "PASS", "FAIL"}},         // incorrect, inconsistent
+		{TestID: "T5", Expected: "PASS", Runs: []string{"PASS", "PASS", "PASS", "PASS"}}, // correct, consistent (k=4)
 	}
 
 	metrics := calculateMetrics(testResults)
@@ -341,6 +347,41 @@ func TestCalculateMetrics(t *testing.T) {
 	if metrics.ConsistentCount != 4 {
 		t.Errorf("Expected 4 consistent, got %d", metrics.ConsistentCount)
 	}
+
+	// AccuracyCI should bracket the 3/5 point estimate.
+	if metrics.AccuracyCI.Lower > metrics.Accuracy || metrics.AccuracyCI.Upper < metrics.Accuracy {
+		t.Errorf("Expected AccuracyCI %v to bracket point estimate %f", metrics.AccuracyCI, metrics.Accuracy)
+	}
+
+	// ConsistencyCI is computed over individual runs (16 total: 3+3+3+3+4),
+	// not test cases, so its center differs from the 4/5 test-level point
+	// estimate but should still be a valid, non-degenerate interval.
+	if metrics.ConsistencyCI.Lower < 0 || metrics.ConsistencyCI.Upper > 1 || metrics.ConsistencyCI.Lower > metrics.ConsistencyCI.Upper {
+		t.Errorf("Expected valid ConsistencyCI, got %v", metrics.ConsistencyCI)
+	}
+}
+
+func TestCalculateMetricsNoResults(t *testing.T) {
+	metrics := calculateMetrics(nil)
+
+	if metrics.AccuracyCI.Lower != 0 || metrics.AccuracyCI.Upper != 1 {
+		t.Errorf("Expected widest-possible AccuracyCI [0,1] for n=0, got %v", metrics.AccuracyCI)
+	}
+	if metrics.ConsistencyCI.Lower != 0 || metrics.ConsistencyCI.Upper != 1 {
+		t.Errorf("Expected widest-possible ConsistencyCI [0,1] for n=0, got %v", metrics.ConsistencyCI)
+	}
+}
+
+func TestFormatThresholdVerdict(t *testing.T) {
+	pass := ConfidenceInterval{Lower: 0.9, Upper: 0.99}
+	if got := formatThresholdVerdict(pass, 0.8); !strings.Contains(got, "PASS") {
+		t.Errorf("Expected PASS when CI lower bound exceeds threshold, got %q", got)
+	}
+
+	fail := ConfidenceInterval{Lower: 0.5, Upper: 0.7}
+	if got := formatThresholdVerdict(fail, 0.8); !strings.Contains(got, "FAIL") {
+		t.Errorf("Expected FAIL when CI lower bound is below threshold, got %q", got)
+	}
 }
 
 func TestFormatMetaReport(t *testing.T) {
@@ -389,6 +430,11 @@ func TestFormatMetaReport(t *testing.T) {
 	if !strings.Contains(report, "3/3") {
 		t.Error("Report missing consistency notation for T1")
 	}
+
+	// Verify the Wilson CI is rendered inline next to each point estimate
+	if !strings.Contains(report, "95% CI") {
+		t.Error("Report missing Wilson confidence interval")
+	}
 }
 
 // TestGetMajorityVerdictTieBreaking tests deterministic tie-breaking behavior
@@ -525,7 +571,7 @@ func TestRunMetaCommandErrors(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := runMetaCommand(tt.suite, tt.agent, 0, metaDir)
+			err := runMetaCommand(context.Background(), tt.suite, tt.agent, 0, metaDir, "", 1, false, 0, 0, nil, []string{"markdown"}, "", "", "", 0, "", nil, nil, nil, nil, "json", "", 0, false)
 			if err == nil {
 				t.Fatalf("Expected error containing %q, got nil", tt.expectError)
 			}
@@ -569,12 +615,202 @@ test_cases:
 	}
 
 	// Execute - should not return error
-	err = runMetaCommand("", "test-agent", 0, metaDir)
+	err = runMetaCommand(context.Background(), "", "test-agent", 0, metaDir, "", 1, false, 0, 0, nil, []string{"markdown"}, "", "", "", 0, "", nil, nil, nil, nil, "json", "", 0, false)
 	if err != nil {
 		t.Errorf("runMetaCommand failed: %v", err)
 	}
 }
 
+// TestBuildMetaReportSuiteFailureTypes verifies that a wrong verdict and a
+// below-threshold consistency each surface as their own typed JUnit
+// <failure>, and that system-out carries the individual run verdicts plus
+// the test's rationale.
+func TestBuildMetaReportSuiteFailureTypes(t *testing.T) {
+	result := EvaluationResult{
+		Agent:                "yokay-test-agent",
+		ConsistencyThreshold: 0.95,
+		TestResults: []TestResult{
+			{
+				TestID:    "TST-001",
+				Name:      "flaky and wrong",
+				Expected:  "PASS",
+				Runs:      []string{"FAIL", "FAIL", "PASS"},
+				Rationale: "Should pass because xyz",
+			},
+		},
+	}
+
+	suite := buildMetaReportSuite(result)
+	if len(suite.Cases) != 1 {
+		t.Fatalf("Expected 1 case, got %d", len(suite.Cases))
+	}
+
+	c := suite.Cases[0]
+	if c.Passed {
+		t.Error("Expected case to fail (wrong verdict and inconsistent)")
+	}
+	if c.Name != "TST-001/flaky and wrong" {
+		t.Errorf("Expected name 'TST-001/flaky and wrong', got %q", c.Name)
+	}
+	if !strings.Contains(c.Message, "Should pass because xyz") {
+		t.Errorf("Expected message to carry rationale, got %q", c.Message)
+	}
+	if !strings.Contains(c.Message, "FAIL, FAIL, PASS") {
+		t.Errorf("Expected message to carry individual run verdicts, got %q", c.Message)
+	}
+
+	if len(c.Failures) != 2 {
+		t.Fatalf("Expected 2 distinct failures (verdict + consistency), got %d: %+v", len(c.Failures), c.Failures)
+	}
+	if c.Failures[0].Type != "verdict" {
+		t.Errorf("Expected first failure type 'verdict', got %q", c.Failures[0].Type)
+	}
+	if c.Failures[1].Type != "consistency" {
+		t.Errorf("Expected second failure type 'consistency', got %q", c.Failures[1].Type)
+	}
+
+	junitXML, err := renderReportSuite(suite, "junit")
+	if err != nil {
+		t.Fatalf("rendering junit: %v", err)
+	}
+	if !strings.Contains(junitXML, `type="verdict"`) || !strings.Contains(junitXML, `type="consistency"`) {
+		t.Errorf("Expected both failure types in JUnit XML, got:\n%s", junitXML)
+	}
+}
+
+// TestRunMetaCommandOutputDir verifies --output-dir writes one report per
+// agent named <agent>.<ext>.
+func TestRunMetaCommandOutputDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	agentDir := filepath.Join(metaDir, "agents", "test-agent")
+	if err := os.MkdirAll(agentDir, 0755); err != nil {
+		t.Fatalf("Failed to create agent dir: %v", err)
+	}
+
+	sampleEval := `agent: yokay-test-agent
+consistency_threshold: 0.95
+
+test_cases:
+  - id: TST-001
+    name: "Test case"
+    input:
+      task_title: "Test Task"
+      task_description: "A test task"
+      acceptance_criteria: ["Criterion 1"]
+      implementation: "// code"
+    expected: PASS
+    k: 3
+    rationale: "Should pass"
+`
+	evalPath := filepath.Join(agentDir, "eval.yaml")
+	if err := os.WriteFile(evalPath, []byte(sampleEval), 0644); err != nil {
+		t.Fatalf("Failed to write test eval.yaml: %v", err)
+	}
+
+	outputDir := filepath.Join(tmpDir, "reports")
+	if err := runMetaCommand(context.Background(), "", "test-agent", 0, metaDir, "", 1, false, 0, 0, nil, []string{"junit"}, "", outputDir, "", 0, "", nil, nil, nil, nil, "json", "", 0, false); err != nil {
+		t.Fatalf("runMetaCommand failed: %v", err)
+	}
+
+	reportPath := filepath.Join(outputDir, "yokay-test-agent.xml")
+	if _, err := os.Stat(reportPath); err != nil {
+		t.Errorf("Expected report at %s, got error: %v", reportPath, err)
+	}
+}
+
+// TestBuildMetaSuiteSummary verifies the combined Evaluations/Totals
+// document aggregates counts across files rather than averaging their
+// percentages, and omits skipped cases.
+func TestBuildMetaSuiteSummary(t *testing.T) {
+	results := []EvaluationResult{
+		{
+			Agent: "agent-a",
+			TestResults: []TestResult{
+				{TestID: "A-1", Expected: "PASS", Runs: []string{"PASS", "PASS"}},
+				{TestID: "A-2", Expected: "PASS", Runs: []string{"FAIL", "FAIL"}},
+				{TestID: "A-3", Skipped: true, SkipReason: "not-in-only"},
+			},
+		},
+		{
+			Agent: "agent-b",
+			TestResults: []TestResult{
+				{TestID: "B-1", Expected: "FAIL", Runs: []string{"FAIL", "FAIL"}},
+			},
+		},
+	}
+
+	summary := buildMetaSuiteSummary(results)
+
+	if len(summary.Evaluations) != 2 {
+		t.Fatalf("Expected 2 evaluations, got %d", len(summary.Evaluations))
+	}
+	if len(summary.Evaluations[0].Tests) != 2 {
+		t.Errorf("Expected skipped case omitted, got %d tests for agent-a", len(summary.Evaluations[0].Tests))
+	}
+	if !summary.Evaluations[0].Tests[0].Pass || summary.Evaluations[0].Tests[1].Pass {
+		t.Errorf("Expected A-1 to pass and A-2 to fail, got %+v", summary.Evaluations[0].Tests)
+	}
+
+	if summary.Totals.Total != 3 || summary.Totals.Correct != 2 {
+		t.Errorf("Expected totals {Total: 3, Correct: 2}, got %+v", summary.Totals)
+	}
+	if summary.Totals.Accuracy != float64(2)/3 {
+		t.Errorf("Expected accuracy 2/3, got %v", summary.Totals.Accuracy)
+	}
+}
+
+// TestRunMetaCommandSummaryOutput verifies --summary-output writes a
+// combined document covering every eval.yaml in the --suite, distinct from
+// the per-agent --output-dir reports.
+func TestRunMetaCommandSummaryOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	agentDir := filepath.Join(metaDir, "agents", "test-agent")
+	if err := os.MkdirAll(agentDir, 0755); err != nil {
+		t.Fatalf("Failed to create agent dir: %v", err)
+	}
+
+	sampleEval := `agent: yokay-test-agent
+test_cases:
+  - id: TST-001
+    name: "Test case"
+    input:
+      task_title: "Test Task"
+      task_description: "A test task"
+      acceptance_criteria: ["Criterion 1"]
+      implementation: "// code"
+    expected: PASS
+    k: 3
+    rationale: "Should pass"
+`
+	evalPath := filepath.Join(agentDir, "eval.yaml")
+	if err := os.WriteFile(evalPath, []byte(sampleEval), 0644); err != nil {
+		t.Fatalf("Failed to write test eval.yaml: %v", err)
+	}
+
+	summaryPath := filepath.Join(tmpDir, "summary.json")
+	if err := runMetaCommand(context.Background(), "", "test-agent", 0, metaDir, "", 1, false, 0, 0, nil, []string{"markdown"}, "", "", "", 0, "", nil, nil, nil, nil, "json", summaryPath, 0, false); err != nil {
+		t.Fatalf("runMetaCommand failed: %v", err)
+	}
+
+	data, err := os.ReadFile(summaryPath)
+	if err != nil {
+		t.Fatalf("Expected summary at %s, got error: %v", summaryPath, err)
+	}
+
+	var summary MetaSuiteSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		t.Fatalf("Failed to parse summary JSON: %v", err)
+	}
+	if len(summary.Evaluations) != 1 || summary.Evaluations[0].Agent != "yokay-test-agent" {
+		t.Errorf("Expected one evaluation for yokay-test-agent, got %+v", summary.Evaluations)
+	}
+	if summary.Totals.Total != 1 || summary.Totals.Correct != 1 {
+		t.Errorf("Expected totals {Total: 1, Correct: 1}, got %+v", summary.Totals)
+	}
+}
+
 // TestLoadEvalYAMLErrors tests error handling when loading invalid YAML
 func TestLoadEvalYAMLErrors(t *testing.T) {
 	tests := []struct {
@@ -1083,3 +1319,599 @@ func TestValidateEvalConfig(t *testing.T) {
 		})
 	}
 }
+
+// TestRunParallelSameResultRegardlessOfConcurrency verifies that running
+// the same eval.yaml through the stub adapter with --parallel=1 and
+// --parallel=8 produces an identical EvaluationResult: the stub's verdict
+// doesn't depend on dispatch order, and each run's seed is derived from
+// (test ID, run index) rather than completion order.
+func TestRunParallelSameResultRegardlessOfConcurrency(t *testing.T) {
+	tmpDir := t.TempDir()
+	agentDir := filepath.Join(tmpDir, "meta", "agents", "test-agent")
+	if err := os.MkdirAll(agentDir, 0755); err != nil {
+		t.Fatalf("Failed to create agent dir: %v", err)
+	}
+
+	sampleEval := `agent: yokay-test-agent
+consistency_threshold: 0.8
+
+test_cases:
+  - id: TST-001
+    name: "First case"
+    input:
+      task_title: "Test Task"
+      task_description: "A test task"
+      acceptance_criteria: ["Criterion 1"]
+      implementation: "// code"
+    expected: PASS
+    k: 6
+    rationale: "Should pass"
+  - id: TST-002
+    name: "Second case"
+    input:
+      task_title: "Test Task 2"
+      task_description: "Another test task"
+      acceptance_criteria: ["Criterion 2"]
+      implementation: "// more code"
+    expected: FAIL
+    k: 6
+    rationale: "Should fail"
+`
+	evalPath := filepath.Join(agentDir, "eval.yaml")
+	if err := os.WriteFile(evalPath, []byte(sampleEval), 0644); err != nil {
+		t.Fatalf("Failed to write test eval.yaml: %v", err)
+	}
+
+	rerunReasons, err := parseRerunReasons(nil)
+	if err != nil {
+		t.Fatalf("parseRerunReasons: %v", err)
+	}
+
+	serial, err := runMetaEvaluation(context.Background(), evalPath, "", 0, 1, false, 0, 0, rerunReasons, false, &lastRunState{Runs: map[string]lastRunEntry{}}, "", caseFilter{}, 0)
+	if err != nil {
+		t.Fatalf("runMetaEvaluation (parallel=1) failed: %v", err)
+	}
+
+	parallelResult, err := runMetaEvaluation(context.Background(), evalPath, "", 0, 8, false, 0, 0, rerunReasons, false, &lastRunState{Runs: map[string]lastRunEntry{}}, "", caseFilter{}, 0)
+	if err != nil {
+		t.Fatalf("runMetaEvaluation (parallel=8) failed: %v", err)
+	}
+
+	// Duration is wall-clock time, not deterministic output, so it's
+	// excluded from the comparison; everything else about the result must
+	// be identical regardless of --parallel.
+	if diff := cmp.Diff(serial, parallelResult, cmpopts.IgnoreFields(MetaRunOutcome{}, "Duration")); diff != "" {
+		t.Errorf("EvaluationResult differs between parallel=1 and parallel=8 (-serial +parallel):\n%s", diff)
+	}
+}
+
+// TestRunParallelCancellationStopsPromptly verifies that cancelling the
+// context passed to runMetaEvaluation stops in-flight work promptly rather
+// than waiting for every (case, k-run) pair to finish.
+func TestRunParallelCancellationStopsPromptly(t *testing.T) {
+	tmpDir := t.TempDir()
+	agentDir := filepath.Join(tmpDir, "meta", "agents", "test-agent")
+	if err := os.MkdirAll(agentDir, 0755); err != nil {
+		t.Fatalf("Failed to create agent dir: %v", err)
+	}
+
+	// delay_ms is large relative to the cancellation deadline below, so an
+	// uncancelled run would take far longer than the test's timeout budget.
+	sampleEval := `agent: yokay-test-agent
+adapter: stub
+adapter_config:
+  delay_ms: 200
+
+test_cases:
+  - id: TST-001
+    name: "Slow case"
+    input:
+      task_title: "Test Task"
+      task_description: "A test task"
+      acceptance_criteria: ["Criterion 1"]
+      implementation: "// code"
+    expected: PASS
+    k: 20
+    rationale: "Should pass"
+`
+	evalPath := filepath.Join(agentDir, "eval.yaml")
+	if err := os.WriteFile(evalPath, []byte(sampleEval), 0644); err != nil {
+		t.Fatalf("Failed to write test eval.yaml: %v", err)
+	}
+
+	rerunReasons, err := parseRerunReasons(nil)
+	if err != nil {
+		t.Fatalf("parseRerunReasons: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result, err := runMetaEvaluation(ctx, evalPath, "", 0, 2, false, 0, 0, rerunReasons, false, &lastRunState{Runs: map[string]lastRunEntry{}}, "", caseFilter{}, 0)
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("runMetaEvaluation failed: %v", err)
+	}
+
+	// 20 runs at 200ms each with --parallel=2 would take ~2s uncancelled;
+	// cancellation at 30ms should stop dispatch well under that.
+	if elapsed > 1*time.Second {
+		t.Errorf("Expected cancellation to stop dispatch promptly, took %s", elapsed)
+	}
+
+	tr := result.TestResults[0]
+	emptyRuns := 0
+	for _, run := range tr.Runs {
+		if run == "" {
+			emptyRuns++
+		}
+	}
+	if emptyRuns == 0 {
+		t.Errorf("Expected cancellation to leave some runs undispatched, got all %d runs filled in", len(tr.Runs))
+	}
+}
+
+func TestCohensKappa(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      []string
+		wantKappa float64
+		wantOK    bool
+	}{
+		{
+			name:      "perfect agreement",
+			a:         []string{"PASS", "PASS", "FAIL", "FAIL"},
+			b:         []string{"PASS", "PASS", "FAIL", "FAIL"},
+			wantKappa: 1,
+			wantOK:    true,
+		},
+		{
+			name:      "no overlapping runs",
+			a:         nil,
+			b:         []string{"PASS"},
+			wantKappa: 0,
+			wantOK:    false,
+		},
+		{
+			name: "chance-level agreement nets near-zero kappa",
+			// Both judges split PASS/FAIL 2/2, but only agree on half the
+			// items -- exactly what independent random guessing would
+			// produce, so kappa should land near 0.
+			a:         []string{"PASS", "PASS", "FAIL", "FAIL"},
+			b:         []string{"PASS", "FAIL", "PASS", "FAIL"},
+			wantKappa: 0,
+			wantOK:    true,
+		},
+		{
+			name: "swapped verdicts with even marginals is maximally negative",
+			// Both judges split PASS/FAIL 50/50 (so chance agreement p_e
+			// is non-zero), but they never agree on the same item.
+			a:         []string{"PASS", "FAIL"},
+			b:         []string{"FAIL", "PASS"},
+			wantKappa: -1,
+			wantOK:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kappa, ok := cohensKappa(tc.a, tc.b)
+			if ok != tc.wantOK {
+				t.Fatalf("cohensKappa() ok = %v, want %v", ok, tc.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if diff := kappa - tc.wantKappa; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("cohensKappa() = %f, want %f", kappa, tc.wantKappa)
+			}
+		})
+	}
+}
+
+func TestCalculateAgreement(t *testing.T) {
+	tr := TestResult{
+		TestID: "T1",
+		RunsByJudge: map[string][]string{
+			"gpt":    {"PASS", "PASS", "FAIL"},
+			"claude": {"PASS", "PASS", "FAIL"},
+			"haiku":  {"FAIL", "FAIL", "FAIL"},
+		},
+	}
+
+	agreement := calculateAgreement(tr)
+	if len(agreement) != 3 {
+		t.Fatalf("Expected 3 judge pairs (3 choose 2), got %d", len(agreement))
+	}
+
+	if kappa := agreement[JudgePair{A: "claude", B: "gpt"}]; kappa != 1 {
+		t.Errorf("Expected claude/gpt kappa 1 (identical verdicts), got %f", kappa)
+	}
+	if _, ok := agreement[JudgePair{A: "gpt", B: "haiku"}]; !ok {
+		t.Error("Expected a gpt/haiku pair in the agreement map")
+	}
+}
+
+func TestCalculateMetricsJudgeAgreement(t *testing.T) {
+	results := []TestResult{
+		{
+			TestID: "T1",
+			RunsByJudge: map[string][]string{
+				"gpt":    {"PASS", "PASS", "PASS"},
+				"claude": {"PASS", "PASS", "FAIL"},
+			},
+			Expected: "PASS",
+			Runs:     []string{"PASS", "PASS", "PASS", "PASS", "PASS", "FAIL"},
+		},
+	}
+
+	metrics := calculateMetrics(results)
+	if len(metrics.JudgeAgreement) != 1 {
+		t.Fatalf("Expected 1 judge pair in JudgeAgreement, got %d", len(metrics.JudgeAgreement))
+	}
+	if metrics.MeanKappa != metrics.JudgeAgreement[JudgePair{A: "claude", B: "gpt"}] {
+		t.Errorf("Expected MeanKappa to equal the sole pair's kappa, got %f vs %f",
+			metrics.MeanKappa, metrics.JudgeAgreement[JudgePair{A: "claude", B: "gpt"}])
+	}
+}
+
+func TestCalculateMetricsPassAtKReports(t *testing.T) {
+	results := []TestResult{
+		// n=9, c=6: qualifies for pass@1 and pass@5, not pass@10.
+		{TestID: "T1", Expected: "PASS", Runs: []string{
+			"PASS", "PASS", "PASS", "PASS", "PASS", "PASS", "FAIL", "FAIL", "FAIL",
+		}},
+		// n=3, c=3: only qualifies for pass@1.
+		{TestID: "T2", Expected: "PASS", Runs: []string{"PASS", "PASS", "PASS"}},
+	}
+
+	metrics := calculateMetrics(results)
+
+	byK := make(map[int]PassAtKReport, len(metrics.PassAtKReports))
+	for _, report := range metrics.PassAtKReports {
+		byK[report.K] = report
+	}
+
+	if _, ok := byK[10]; ok {
+		t.Error("Expected no pass@10 report, since no test case ran at least 10 times")
+	}
+	if _, ok := byK[5]; !ok {
+		t.Fatal("Expected a pass@5 report, since T1 ran 9 times")
+	}
+	if _, ok := byK[1]; !ok {
+		t.Fatal("Expected a pass@1 report, since both test cases ran at least once")
+	}
+
+	pass1 := byK[1]
+	if pass1.Estimate <= 0 || pass1.Estimate > 1 {
+		t.Errorf("Expected pass@1 estimate in (0, 1], got %f", pass1.Estimate)
+	}
+	// Estimate is an average of per-case HumanEval estimates, while the CI
+	// wraps the raw aggregated per-run pass rate, so it isn't guaranteed
+	// to bracket the estimate exactly -- just check it's a valid interval.
+	if pass1.CI.Lower < 0 || pass1.CI.Upper > 1 || pass1.CI.Lower > pass1.CI.Upper {
+		t.Errorf("Expected valid pass@1 CI, got %v", pass1.CI)
+	}
+}
+
+func TestFormatMetaReportLowKappaWarning(t *testing.T) {
+	evalResult := EvaluationResult{
+		Agent: "test-agent",
+		TestResults: []TestResult{
+			{
+				TestID:   "T1",
+				Name:     "Test one",
+				Expected: "PASS",
+				RunsByJudge: map[string][]string{
+					"gpt":    {"PASS", "FAIL"},
+					"claude": {"FAIL", "PASS"},
+				},
+				Runs: []string{"FAIL", "PASS", "PASS", "FAIL"},
+			},
+		},
+	}
+
+	report := formatMetaReport(evalResult)
+	if !strings.Contains(report, "Judge Agreement") {
+		t.Error("Report missing Judge Agreement section")
+	}
+	if !strings.Contains(report, "WARNING") {
+		t.Error("Expected a low-kappa WARNING in the report for total disagreement between judges")
+	}
+}
+
+func TestUnionRuns(t *testing.T) {
+	got := unionRuns(map[string][]string{
+		"b": {"FAIL"},
+		"a": {"PASS", "PASS"},
+	})
+	want := []string{"PASS", "PASS", "FAIL"}
+	if len(got) != len(want) {
+		t.Fatalf("unionRuns() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("unionRuns()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+// TestRunMetaCommandKeepArtifacts verifies that --keep-artifacts with no
+// --artifacts-dir provisions its own temporary directory, populates it
+// with run artifacts, and leaves it on disk rather than discarding it.
+func TestRunMetaCommandKeepArtifacts(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	agentDir := filepath.Join(metaDir, "agents", "test-agent")
+	if err := os.MkdirAll(agentDir, 0755); err != nil {
+		t.Fatalf("Failed to create agent dir: %v", err)
+	}
+
+	sampleEval := `agent: yokay-test-agent
+consistency_threshold: 0.95
+
+test_cases:
+  - id: TST-001
+    name: "Test case"
+    input:
+      task_title: "Test Task"
+      task_description: "A test task"
+      acceptance_criteria: ["Criterion 1"]
+      implementation: "// code"
+    expected: PASS
+    k: 2
+    rationale: "Should pass"
+`
+	evalPath := filepath.Join(agentDir, "eval.yaml")
+	if err := os.WriteFile(evalPath, []byte(sampleEval), 0644); err != nil {
+		t.Fatalf("Failed to write test eval.yaml: %v", err)
+	}
+
+	before, err := os.ReadDir(os.TempDir())
+	if err != nil {
+		t.Fatalf("reading %s before run: %v", os.TempDir(), err)
+	}
+	seenBefore := make(map[string]bool, len(before))
+	for _, e := range before {
+		seenBefore[e.Name()] = true
+	}
+
+	if err := runMetaCommand(context.Background(), "", "test-agent", 0, metaDir, "", 1, false, 0, 0, nil, []string{"markdown"}, "", "", "", 0, "", nil, nil, nil, nil, "json", "", 0, true); err != nil {
+		t.Fatalf("runMetaCommand failed: %v", err)
+	}
+
+	after, err := os.ReadDir(os.TempDir())
+	if err != nil {
+		t.Fatalf("reading %s after run: %v", os.TempDir(), err)
+	}
+	var artifactsDir string
+	for _, e := range after {
+		if !seenBefore[e.Name()] && strings.HasPrefix(e.Name(), "yokay-meta-artifacts-") {
+			artifactsDir = filepath.Join(os.TempDir(), e.Name())
+			break
+		}
+	}
+	if artifactsDir == "" {
+		t.Fatal("Expected --keep-artifacts to leave a new yokay-meta-artifacts-* directory in os.TempDir()")
+	}
+	defer os.RemoveAll(artifactsDir)
+
+	entries, err := os.ReadDir(artifactsDir)
+	if err != nil {
+		t.Fatalf("reading kept artifacts dir %s: %v", artifactsDir, err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("Expected 2 artifact files (k=2), got %d", len(entries))
+	}
+}
+
+func TestSeedForRun(t *testing.T) {
+	if got := seedForRun("TST-001", "default", 0, 0); got != seedForRun("TST-001", "default", 0, 0) {
+		t.Errorf("seedForRun() is not deterministic: got %d then %d", got, seedForRun("TST-001", "default", 0, 0))
+	}
+
+	if seedForRun("TST-001", "default", 0, 0) == seedForRun("TST-001", "default", 0, 7) {
+		t.Error("Expected different baseSeed values to derive different seeds")
+	}
+
+	if seedForRun("TST-001", "default", 0, 7) == seedForRun("TST-002", "default", 0, 7) {
+		t.Error("Expected different testIDs to derive different seeds for the same baseSeed")
+	}
+}
+
+func TestJudgeNames(t *testing.T) {
+	if got := judgeNames(&EvalConfig{}); len(got) != 1 || got[0] != defaultJudgeName {
+		t.Errorf("Expected [%q] for a config with no judges, got %v", defaultJudgeName, got)
+	}
+
+	config := &EvalConfig{Judges: []string{"gpt", "claude"}}
+	if got := judgeNames(config); len(got) != 2 {
+		t.Errorf("Expected 2 judges, got %v", got)
+	}
+}
+
+func TestNormalizeAssertions(t *testing.T) {
+	t.Run("legacy Expected synthesizes a verdict assertion", func(t *testing.T) {
+		tc := TestCase{Expected: "PASS"}
+		normalizeAssertions(&tc)
+		want := []Assertion{{Target: "verdict", Op: "equals", Value: "PASS"}}
+		if diff := cmp.Diff(want, tc.Assertions); diff != "" {
+			t.Errorf("normalizeAssertions() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("no Expected and no Assertions stays empty", func(t *testing.T) {
+		tc := TestCase{}
+		normalizeAssertions(&tc)
+		if len(tc.Assertions) != 0 {
+			t.Errorf("Expected no assertions, got %v", tc.Assertions)
+		}
+	})
+
+	t.Run("explicit Assertions backfill Expected from the verdict check", func(t *testing.T) {
+		tc := TestCase{Assertions: []Assertion{
+			{Target: "duration_ms", Op: "lt", Value: "500"},
+			{Target: "verdict", Op: "equals", Value: "PASS"},
+		}}
+		normalizeAssertions(&tc)
+		if tc.Expected != "PASS" {
+			t.Errorf("Expected Expected to be backfilled to %q, got %q", "PASS", tc.Expected)
+		}
+	})
+
+	t.Run("explicit Assertions with no verdict check leave Expected empty", func(t *testing.T) {
+		tc := TestCase{Assertions: []Assertion{{Target: "reasoning", Op: "contains", Value: "because"}}}
+		normalizeAssertions(&tc)
+		if tc.Expected != "" {
+			t.Errorf("Expected Expected to stay empty, got %q", tc.Expected)
+		}
+	})
+}
+
+func TestAssertionValidate(t *testing.T) {
+	valid := []string{"equals", "contains", "regex", "jsonpath", "lt", "gt", "oneof"}
+	for _, op := range valid {
+		if err := (Assertion{Target: "verdict", Op: op, Value: "x"}).Validate(); err != nil {
+			t.Errorf("Validate() for op %q: unexpected error: %v", op, err)
+		}
+	}
+
+	if err := (Assertion{Target: "verdict", Op: "matches", Value: "x"}).Validate(); err == nil {
+		t.Error("Validate() for unknown op: expected an error, got nil")
+	}
+}
+
+func TestApplyAssertionOp(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      string
+		actual  string
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{name: "equals match", op: "equals", actual: "PASS", value: "PASS", want: true},
+		{name: "equals mismatch", op: "equals", actual: "PASS", value: "FAIL", want: false},
+		{name: "contains match", op: "contains", actual: "because xyz failed", value: "xyz", want: true},
+		{name: "contains mismatch", op: "contains", actual: "because abc failed", value: "xyz", want: false},
+		{name: "regex match", op: "regex", actual: "call-42", value: `^call-\d+$`, want: true},
+		{name: "regex invalid pattern", op: "regex", actual: "call-42", value: `[`, wantErr: true},
+		{name: "lt true", op: "lt", actual: "120", value: "500", want: true},
+		{name: "lt false", op: "lt", actual: "900", value: "500", want: false},
+		{name: "gt true", op: "gt", actual: "900", value: "500", want: true},
+		{name: "lt non-numeric actual", op: "lt", actual: "fast", value: "500", wantErr: true},
+		{name: "oneof match", op: "oneof", actual: "FAIL", value: "PASS, FAIL, WARN", want: true},
+		{name: "oneof mismatch", op: "oneof", actual: "ERROR", value: "PASS, FAIL, WARN", want: false},
+		{name: "jsonpath present", op: "jsonpath", actual: `{"tool_calls":[{"name":"grep"}]}`, value: "tool_calls[0].name", want: true},
+		{name: "jsonpath absent", op: "jsonpath", actual: `{"tool_calls":[]}`, value: "tool_calls[0].name", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyAssertionOp(tt.op, tt.actual, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyAssertionOp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("applyAssertionOp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveJSONPath(t *testing.T) {
+	raw := `{"tool_calls":[{"name":"grep","count":3},{"name":"edit"}],"done":true}`
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{name: "nested index then field", path: "tool_calls[0].name", want: "grep"},
+		{name: "second element", path: "tool_calls[1].name", want: "edit"},
+		{name: "number renders without quotes", path: "tool_calls[0].count", want: "3"},
+		{name: "bool renders as true/false", path: "done", want: "true"},
+		{name: "missing field", path: "tool_calls[0].missing", wantErr: true},
+		{name: "index out of range", path: "tool_calls[5].name", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveJSONPath(raw, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveJSONPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("resolveJSONPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateAssertions(t *testing.T) {
+	outcome := MetaRunOutcome{
+		Verdict:  "PASS",
+		Duration: 120 * time.Millisecond,
+		Output:   `{"tool_calls":[{"name":"grep"}]}`,
+	}
+
+	t.Run("every assertion holds", func(t *testing.T) {
+		assertions := []Assertion{
+			{Target: "verdict", Op: "equals", Value: "PASS"},
+			{Target: "duration_ms", Op: "lt", Value: "500"},
+			{Target: "tool_calls[0].name", Op: "equals", Value: "grep"},
+		}
+		passed, outcomes := evaluateTestCaseAssertions(assertions, outcome)
+		if !passed {
+			t.Errorf("evaluateTestCaseAssertions() passed = false, want true (outcomes: %+v)", outcomes)
+		}
+		if len(outcomes) != len(assertions) {
+			t.Fatalf("Expected %d outcomes, got %d", len(assertions), len(outcomes))
+		}
+	})
+
+	t.Run("one failing assertion fails the whole run", func(t *testing.T) {
+		assertions := []Assertion{
+			{Target: "verdict", Op: "equals", Value: "PASS"},
+			{Target: "tool_calls[0].name", Op: "equals", Value: "edit"},
+		}
+		passed, outcomes := evaluateTestCaseAssertions(assertions, outcome)
+		if passed {
+			t.Error("evaluateTestCaseAssertions() passed = true, want false")
+		}
+		if outcomes[1].Passed {
+			t.Error("Expected the mismatched tool_calls[0].name assertion to fail")
+		}
+	})
+
+	t.Run("unresolvable target records an error, not a silent pass", func(t *testing.T) {
+		assertions := []Assertion{{Target: "nonexistent_field", Op: "equals", Value: "x"}}
+		passed, outcomes := evaluateTestCaseAssertions(assertions, outcome)
+		if passed {
+			t.Error("evaluateTestCaseAssertions() passed = true, want false")
+		}
+		if outcomes[0].Err == "" {
+			t.Error("Expected a non-empty Err for an unresolvable target")
+		}
+	})
+
+	t.Run("empty assertions trivially pass", func(t *testing.T) {
+		passed, outcomes := evaluateTestCaseAssertions(nil, outcome)
+		if !passed || len(outcomes) != 0 {
+			t.Errorf("evaluateTestCaseAssertions(nil) = (%v, %v), want (true, [])", passed, outcomes)
+		}
+	})
+}
+
+func TestAssertionPassLabels(t *testing.T) {
+	got := assertionPassLabels([]MetaRunOutcome{
+		{AssertionsPassed: true},
+		{AssertionsPassed: false},
+	})
+	want := []string{"PASS", "FAIL"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("assertionPassLabels() mismatch (-want +got):\n%s", diff)
+	}
+}
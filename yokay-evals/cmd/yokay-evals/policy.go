@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// policyFileName is the filename LoadValidationPolicyForConfig walks
+// upward from an eval.yaml's directory looking for, so an org can drop
+// one near the repo root and have it apply to every eval.yaml beneath it
+// without touching the configs themselves.
+const policyFileName = ".pokayokay-policy.yaml"
+
+// ValidationPolicy bounds what ValidateEvalConfigWithPolicy otherwise
+// accepts: K and consistency-threshold ranges, and (optionally) which
+// Expected verdicts are allowed per agent. A zero-value ValidationPolicy
+// rejects any non-zero consistency_threshold and any explicit k; use
+// DefaultPolicy() as the starting point for a policy that only tightens
+// specific fields.
+type ValidationPolicy struct {
+	MinK                    int
+	MaxK                    int
+	MinConsistencyThreshold float64
+	MaxConsistencyThreshold float64
+	// AllowedExpectedValues, keyed by agent name, restricts which
+	// Expected verdicts that agent's test cases may use (e.g.
+	// "yokay-security-reviewer": {"VULNERABLE", "SAFE"}). An agent with
+	// no entry is unrestricted beyond its AgentValidator's own rules.
+	AllowedExpectedValues map[string][]string
+}
+
+// DefaultPolicy is the ValidationPolicy ValidateEvalConfig enforces: K
+// between 1 and 100, consistency_threshold between 0.0 and 1.0, and no
+// restriction on Expected values — i.e. today's behavior, unchanged.
+func DefaultPolicy() ValidationPolicy {
+	return ValidationPolicy{
+		MinK:                    1,
+		MaxK:                    100,
+		MinConsistencyThreshold: 0.0,
+		MaxConsistencyThreshold: 1.0,
+	}
+}
+
+// policyYAML is .pokayokay-policy.yaml's on-disk shape. Every field is a
+// pointer so an org can override only the bounds it cares about; fields
+// left unset keep DefaultPolicy()'s value rather than zeroing it out.
+type policyYAML struct {
+	MinK                    *int                `yaml:"min_k"`
+	MaxK                    *int                `yaml:"max_k"`
+	MinConsistencyThreshold *float64            `yaml:"min_consistency_threshold"`
+	MaxConsistencyThreshold *float64            `yaml:"max_consistency_threshold"`
+	AllowedExpectedValues   map[string][]string `yaml:"allowed_expected_values"`
+}
+
+func (raw policyYAML) applyTo(policy *ValidationPolicy) {
+	if raw.MinK != nil {
+		policy.MinK = *raw.MinK
+	}
+	if raw.MaxK != nil {
+		policy.MaxK = *raw.MaxK
+	}
+	if raw.MinConsistencyThreshold != nil {
+		policy.MinConsistencyThreshold = *raw.MinConsistencyThreshold
+	}
+	if raw.MaxConsistencyThreshold != nil {
+		policy.MaxConsistencyThreshold = *raw.MaxConsistencyThreshold
+	}
+	if raw.AllowedExpectedValues != nil {
+		policy.AllowedExpectedValues = raw.AllowedExpectedValues
+	}
+}
+
+// LoadValidationPolicyForConfig finds the nearest policyFileName at or
+// above configPath's directory and layers it onto DefaultPolicy(),
+// returning DefaultPolicy() unchanged if none is found.
+func LoadValidationPolicyForConfig(configPath string) (ValidationPolicy, error) {
+	dir := filepath.Dir(configPath)
+
+	policyPath, found, err := findPolicyFileUpward(dir)
+	if err != nil {
+		return ValidationPolicy{}, err
+	}
+	if !found {
+		return DefaultPolicy(), nil
+	}
+
+	return loadValidationPolicyFile(policyPath)
+}
+
+// findPolicyFileUpward walks from dir up through its ancestors looking
+// for policyFileName, stopping at the filesystem root.
+func findPolicyFileUpward(dir string) (path string, found bool, err error) {
+	for {
+		candidate := filepath.Join(dir, policyFileName)
+		if _, statErr := os.Stat(candidate); statErr == nil {
+			return candidate, true, nil
+		} else if !os.IsNotExist(statErr) {
+			return "", false, fmt.Errorf("checking for policy file %s: %w", candidate, statErr)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false, nil
+		}
+		dir = parent
+	}
+}
+
+// loadValidationPolicyFile parses path as policyYAML and layers it onto
+// DefaultPolicy().
+func loadValidationPolicyFile(path string) (ValidationPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ValidationPolicy{}, fmt.Errorf("reading policy file %s: %w", path, err)
+	}
+
+	var raw policyYAML
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return ValidationPolicy{}, fmt.Errorf("parsing policy file %s: %w", path, err)
+	}
+
+	policy := DefaultPolicy()
+	raw.applyTo(&policy)
+	return policy, nil
+}
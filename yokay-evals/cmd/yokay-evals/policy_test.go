@@ -0,0 +1,193 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDefaultPolicyMatchesHardcodedBounds(t *testing.T) {
+	policy := DefaultPolicy()
+	if policy.MinK != 1 || policy.MaxK != 100 {
+		t.Errorf("DefaultPolicy() K bounds = [%d, %d], want [1, 100]", policy.MinK, policy.MaxK)
+	}
+	if policy.MinConsistencyThreshold != 0.0 || policy.MaxConsistencyThreshold != 1.0 {
+		t.Errorf("DefaultPolicy() consistency bounds = [%v, %v], want [0.0, 1.0]", policy.MinConsistencyThreshold, policy.MaxConsistencyThreshold)
+	}
+}
+
+func TestValidateEvalConfigWithPolicyEnforcesCustomKBounds(t *testing.T) {
+	policy := ValidationPolicy{MinK: 20, MaxK: 50, MinConsistencyThreshold: 0.9, MaxConsistencyThreshold: 1.0}
+	config := EvalConfig{
+		Agent:                "yokay-test",
+		ConsistencyThreshold: 0.95,
+		TestCases: []TestCase{
+			{
+				ID:   "BR-001",
+				Name: "Test",
+				Input: TaskInput{
+					TaskTitle:       "Test",
+					TaskDescription: "Desc",
+				},
+				Expected:  "PASS",
+				K:         5,
+				Rationale: "Reason",
+			},
+		},
+	}
+
+	err := ValidateEvalConfigWithPolicy(&config, policy)
+	if err == nil {
+		t.Fatal("expected K=5 to violate a policy with MinK=20")
+	}
+	if want := "k must be between 20 and 50 (or 0 for default)"; !strings.Contains(err.Error(), want) {
+		t.Errorf("err = %q, want it to contain %q", err.Error(), want)
+	}
+}
+
+func TestValidateEvalConfigWithPolicyEnforcesMinConsistencyThreshold(t *testing.T) {
+	policy := ValidationPolicy{MinK: 1, MaxK: 100, MinConsistencyThreshold: 0.9, MaxConsistencyThreshold: 1.0}
+	config := EvalConfig{
+		Agent:                "yokay-test",
+		ConsistencyThreshold: 0.8,
+		TestCases: []TestCase{
+			{
+				ID:   "BR-001",
+				Name: "Test",
+				Input: TaskInput{
+					TaskTitle:       "Test",
+					TaskDescription: "Desc",
+				},
+				Expected:  "PASS",
+				K:         10,
+				Rationale: "Reason",
+			},
+		},
+	}
+
+	err := ValidateEvalConfigWithPolicy(&config, policy)
+	if err == nil {
+		t.Fatal("expected consistency_threshold=0.8 to violate a policy with MinConsistencyThreshold=0.9")
+	}
+	if want := "consistency_threshold must be between 0.9 and 1.0"; !strings.Contains(err.Error(), want) {
+		t.Errorf("err = %q, want it to contain %q", err.Error(), want)
+	}
+}
+
+func TestValidateEvalConfigWithPolicyEnforcesAllowedExpectedValues(t *testing.T) {
+	policy := DefaultPolicy()
+	policy.AllowedExpectedValues = map[string][]string{
+		"yokay-test": {"PASS", "FAIL"},
+	}
+	config := EvalConfig{
+		Agent:                "yokay-test",
+		ConsistencyThreshold: 0.95,
+		TestCases: []TestCase{
+			{
+				ID:   "BR-001",
+				Name: "Test",
+				Input: TaskInput{
+					TaskTitle:       "Test",
+					TaskDescription: "Desc",
+				},
+				Expected:  "MAYBE",
+				Rationale: "Reason",
+			},
+		},
+	}
+
+	err := ValidateEvalConfigWithPolicy(&config, policy)
+	if err == nil {
+		t.Fatal("expected Expected=MAYBE to violate policy.AllowedExpectedValues")
+	}
+
+	// An agent with no entry in AllowedExpectedValues stays unrestricted.
+	config.Agent = "yokay-other"
+	config.TestCases[0].Input.TaskDescription = "Desc"
+	if err := ValidateEvalConfigWithPolicy(&config, policy); err != nil {
+		t.Errorf("expected an agent absent from AllowedExpectedValues to be unrestricted, got: %v", err)
+	}
+}
+
+func TestValidateEvalConfigUsesDefaultPolicy(t *testing.T) {
+	config := EvalConfig{
+		Agent:                "yokay-test",
+		ConsistencyThreshold: 0.95,
+		TestCases: []TestCase{
+			{
+				ID:   "BR-001",
+				Name: "Test",
+				Input: TaskInput{
+					TaskTitle:       "Test",
+					TaskDescription: "Desc",
+				},
+				Expected:  "PASS",
+				K:         100,
+				Rationale: "Reason",
+			},
+		},
+	}
+
+	if err := ValidateEvalConfig(&config); err != nil {
+		t.Errorf("expected K=100 to be valid under DefaultPolicy(), got: %v", err)
+	}
+}
+
+func TestLoadValidationPolicyForConfigFindsNearestFile(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "agents", "some-agent")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+
+	policyYAMLContent := `
+min_k: 20
+max_k: 50
+min_consistency_threshold: 0.9
+allowed_expected_values:
+  yokay-test:
+    - PASS
+    - FAIL
+`
+	if err := os.WriteFile(filepath.Join(root, policyFileName), []byte(policyYAMLContent), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	configPath := filepath.Join(nested, "eval.yaml")
+	policy, err := LoadValidationPolicyForConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadValidationPolicyForConfig failed: %v", err)
+	}
+
+	if policy.MinK != 20 || policy.MaxK != 50 {
+		t.Errorf("policy K bounds = [%d, %d], want [20, 50]", policy.MinK, policy.MaxK)
+	}
+	if policy.MinConsistencyThreshold != 0.9 {
+		t.Errorf("policy.MinConsistencyThreshold = %v, want 0.9", policy.MinConsistencyThreshold)
+	}
+	// max_consistency_threshold wasn't set in the file, so it keeps
+	// DefaultPolicy()'s value rather than zeroing out.
+	if policy.MaxConsistencyThreshold != 1.0 {
+		t.Errorf("policy.MaxConsistencyThreshold = %v, want DefaultPolicy()'s 1.0 to survive an unset override", policy.MaxConsistencyThreshold)
+	}
+	if want := []string{"PASS", "FAIL"}; len(policy.AllowedExpectedValues["yokay-test"]) != len(want) {
+		t.Errorf("policy.AllowedExpectedValues[yokay-test] = %v, want %v", policy.AllowedExpectedValues["yokay-test"], want)
+	}
+}
+
+func TestLoadValidationPolicyForConfigReturnsDefaultWhenNoFileFound(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "eval.yaml")
+
+	policy, err := LoadValidationPolicyForConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadValidationPolicyForConfig failed: %v", err)
+	}
+	want := DefaultPolicy()
+	if policy.MinK != want.MinK || policy.MaxK != want.MaxK ||
+		policy.MinConsistencyThreshold != want.MinConsistencyThreshold ||
+		policy.MaxConsistencyThreshold != want.MaxConsistencyThreshold ||
+		len(policy.AllowedExpectedValues) != 0 {
+		t.Errorf("policy = %+v, want DefaultPolicy() (%+v) when no policy file is found", policy, want)
+	}
+}
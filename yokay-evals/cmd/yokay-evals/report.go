@@ -2,15 +2,76 @@ package main
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stevestomp/yokay-evals/internal/reportfs"
 )
 
+// newReportCmd builds the `report` subcommand, which aggregates and
+// displays grade/evaluation reports, including chronological trends.
+func newReportCmd() *cobra.Command {
+	var reportType, reportFormat, outputFile, reportsDir string
+	var listReports, trend bool
+	var regressionThreshold float64
+	var window int
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "View and analyze evaluation reports",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := reportsDir
+			if dir == "" {
+				dir = defaultReportsDir()
+			}
+
+			if err := runReportCommand(reportType, reportFormat, listReports, outputFile, dir, trend, regressionThreshold, window); err != nil {
+				return fmt.Errorf("Failed to run report command: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&reportType, "type", "grade", "Report type: 'grade', 'evaluation', or 'trend'")
+	cmd.Flags().StringVar(&reportFormat, "format", "markdown", "Output format: 'markdown', 'json', 'junit'/'xml', or 'sarif'")
+	cmd.Flags().BoolVar(&listReports, "list", false, "List available reports without aggregating")
+	cmd.Flags().StringVar(&outputFile, "output", "", "Write output to file instead of stdout")
+	cmd.Flags().StringVar(&reportsDir, "reports-dir", "", "Path to reports directory (default: reports/)")
+	cmd.Flags().BoolVar(&trend, "trend", false, "Show a chronological trend across all matching reports instead of just the newest one")
+	cmd.Flags().Float64Var(&regressionThreshold, "regression-threshold", -1, "Flag a non-zero exit if any criterion's or skill's score dropped by more than N points between the two newest reports (disabled by default)")
+	cmd.Flags().IntVar(&window, "window", 0, "Limit --trend to the N most recent reports (default: 0, meaning every report)")
+
+	cmd.AddCommand(newReportTrendCmd())
+
+	return cmd
+}
+
+// defaultReportsDir resolves the reports directory relative to the
+// current working directory when --reports-dir is not given.
+func defaultReportsDir() string {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "reports"
+	}
+
+	if strings.Contains(cwd, "pokayokay") {
+		parts := strings.Split(cwd, "pokayokay")
+		if len(parts) > 0 {
+			return filepath.Join(parts[0]+"pokayokay", "reports")
+		}
+	}
+
+	return "reports"
+}
+
 // CriteriaScore represents the average score for a specific criteria across all skills
 type CriteriaScore struct {
 	Name    string
@@ -26,12 +87,25 @@ type GradeReport struct {
 	PassRate         float64
 	PassingThreshold float64
 	CriteriaScores   []CriteriaScore
+	// Skills holds the per-skill row from the "Skills by Score" table, so
+	// per-skill output formats (e.g. JUnit XML testcases) can be derived
+	// without re-parsing the report.
+	Skills []SkillScore
 }
 
-// findGradeReports finds all skill-clarity-*.md reports in the given directory
-// Returns reports sorted by date (newest first)
-func findGradeReports(reportsDir string) ([]string, error) {
-	entries, err := os.ReadDir(reportsDir)
+// SkillScore is a single skill's row from a grade report's "Skills by
+// Score" table.
+type SkillScore struct {
+	Name   string
+	Score  float64
+	Passed bool
+}
+
+// findGradeReports finds all skill-clarity-*.md reports in fsys (the
+// reports directory root). Returns report names sorted by date (newest
+// first).
+func findGradeReports(fsys fs.FS) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, ".")
 	if err != nil {
 		return nil, fmt.Errorf("reading reports directory: %w", err)
 	}
@@ -45,27 +119,28 @@ func findGradeReports(reportsDir string) ([]string, error) {
 		}
 
 		if pattern.MatchString(entry.Name()) {
-			reports = append(reports, filepath.Join(reportsDir, entry.Name()))
+			reports = append(reports, entry.Name())
 		}
 	}
 
 	// Sort by filename (which includes date) in descending order (newest first)
 	sort.Slice(reports, func(i, j int) bool {
-		return filepath.Base(reports[i]) > filepath.Base(reports[j])
+		return reports[i] > reports[j]
 	})
 
 	return reports, nil
 }
 
-// parseGradeReport parses a skill-clarity report and extracts key metrics
-func parseGradeReport(reportPath string) (GradeReport, error) {
-	content, err := os.ReadFile(reportPath)
+// parseGradeReport parses a skill-clarity report (named reportName in
+// fsys) and extracts key metrics.
+func parseGradeReport(fsys fs.FS, reportName string) (GradeReport, error) {
+	content, err := fs.ReadFile(fsys, reportName)
 	if err != nil {
 		return GradeReport{}, fmt.Errorf("reading report: %w", err)
 	}
 
 	report := GradeReport{
-		FilePath: reportPath,
+		FilePath: reportName,
 	}
 
 	lines := strings.Split(string(content), "\n")
@@ -115,13 +190,65 @@ func parseGradeReport(reportPath string) (GradeReport, error) {
 	// Extract per-criteria scores from Detailed Breakdown section
 	report.CriteriaScores = extractCriteriaScores(lines)
 
+	// Extract per-skill scores from the Skills by Score table
+	report.Skills = extractSkillScores(lines)
+
 	return report, nil
 }
 
+// extractSkillScores parses the "## Skills by Score" table (see
+// formatGradeReportMarkdown in grade.go) into one SkillScore per row.
+func extractSkillScores(lines []string) []SkillScore {
+	rowPattern := regexp.MustCompile(`^\|\s*\d+\s*\|\s*([^|]+?)\s*\|\s*([\d.]+)\s*\|\s*(.+?)\s*\|\s*$`)
+
+	var skills []SkillScore
+	inTable := false
+
+	for _, line := range lines {
+		if strings.Contains(line, "## Skills by Score") {
+			inTable = true
+			continue
+		}
+		if inTable && strings.HasPrefix(line, "## ") {
+			break
+		}
+		if !inTable {
+			continue
+		}
+
+		matches := rowPattern.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+		// Skip the header and separator rows ("Skill", "------").
+		if matches[1] == "Skill" || strings.HasPrefix(matches[1], "---") {
+			continue
+		}
+
+		score, err := strconv.ParseFloat(matches[2], 64)
+		if err != nil {
+			continue
+		}
+
+		skills = append(skills, SkillScore{
+			Name:   strings.TrimSpace(matches[1]),
+			Score:  score,
+			Passed: !strings.Contains(matches[3], "Fail"),
+		})
+	}
+
+	return skills
+}
+
 // extractCriteriaScores parses the Detailed Breakdown section and aggregates per-criteria scores
 func extractCriteriaScores(lines []string) []CriteriaScore {
 	// Map to accumulate scores for each criteria
 	criteriaMap := make(map[string][]float64)
+	// criteriaOrder preserves first-seen order so criteria names coming
+	// from a graderspec.Grader (arbitrary, not just the built-in four)
+	// round-trip through this report in the order the markdown lists
+	// them, rather than only a hardcoded set of names.
+	var criteriaOrder []string
 
 	// Regex pattern to match criteria score lines like:
 	// - **Clear Instructions** (weight: 30%): 75.0/100
@@ -148,6 +275,9 @@ func extractCriteriaScores(lines []string) []CriteriaScore {
 				criteriaName := strings.TrimSpace(matches[1])
 				score, err := strconv.ParseFloat(matches[2], 64)
 				if err == nil {
+					if _, seen := criteriaMap[criteriaName]; !seen {
+						criteriaOrder = append(criteriaOrder, criteriaName)
+					}
 					criteriaMap[criteriaName] = append(criteriaMap[criteriaName], score)
 				}
 			}
@@ -157,30 +287,21 @@ func extractCriteriaScores(lines []string) []CriteriaScore {
 	// Calculate averages and create result slice
 	var result []CriteriaScore
 
-	// Define the expected criteria order
-	criteriaOrder := []string{
-		"Clear Instructions",
-		"Actionable Steps",
-		"Good Examples",
-		"Appropriate Scope",
-	}
-
 	for _, criteriaName := range criteriaOrder {
-		if scores, exists := criteriaMap[criteriaName]; exists && len(scores) > 0 {
-			sum := 0.0
-			for _, score := range scores {
-				sum += score
-			}
-			average := sum / float64(len(scores))
+		scores := criteriaMap[criteriaName]
+		sum := 0.0
+		for _, score := range scores {
+			sum += score
+		}
+		average := sum / float64(len(scores))
 
-			// Round to 1 decimal place
-			average = float64(int(average*10+0.5)) / 10
+		// Round to 1 decimal place
+		average = float64(int(average*10+0.5)) / 10
 
-			result = append(result, CriteriaScore{
-				Name:    criteriaName,
-				Average: average,
-			})
-		}
+		result = append(result, CriteriaScore{
+			Name:    criteriaName,
+			Average: average,
+		})
 	}
 
 	return result
@@ -243,13 +364,84 @@ func formatReportSummaryJSON(report GradeReport) (string, error) {
 	return string(jsonBytes), nil
 }
 
+// junitTestSuites is the root <testsuites> element of a JUnit XML document.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite is a single <testsuite>, one per grade report.
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Passed    int             `xml:"passed,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a single skill within a grade report's suite.
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	// SystemOut carries free-form diagnostic text (e.g. a rationale or
+	// failure detail) for reporters built on ReportSuite. Unused by
+	// formatReportSummaryXML, which has no such text to attach.
+	SystemOut string `xml:"system-out,omitempty"`
+}
+
+// junitFailure marks a testcase whose score fell below the passing
+// threshold.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+// formatReportSummaryXML formats a GradeReport as a JUnit-compatible
+// <testsuites> document: one <testcase> per skill in report.Skills, with
+// a <failure> child for any skill scoring below PassingThreshold. Skill
+// evaluation duration isn't tracked yet, so Time is always "0".
+func formatReportSummaryXML(report GradeReport) (string, error) {
+	className := fmt.Sprintf("grade.%s", report.GeneratedDate)
+
+	suite := junitTestSuite{
+		Name:  fmt.Sprintf("skill-clarity-%s", report.GeneratedDate),
+		Tests: len(report.Skills),
+	}
+
+	for _, skill := range report.Skills {
+		tc := junitTestCase{
+			ClassName: className,
+			Name:      skill.Name,
+			Time:      "0",
+		}
+		if skill.Score < report.PassingThreshold {
+			suite.Failures++
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("score %.1f below passing threshold %.1f", skill.Score, report.PassingThreshold),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	suite.Passed = suite.Tests - suite.Failures
+
+	doc := junitTestSuites{Suites: []junitTestSuite{suite}}
+
+	xmlBytes, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling to XML: %w", err)
+	}
+
+	return xml.Header + string(xmlBytes), nil
+}
+
 // listGradeReports lists all available grade reports
-func listGradeReports(reportsDir string) string {
+func listGradeReports(fsys fs.FS) string {
 	var sb strings.Builder
 
 	sb.WriteString("# Grade Reports\n\n")
 
-	reports, err := findGradeReports(reportsDir)
+	reports, err := findGradeReports(fsys)
 	if err != nil {
 		sb.WriteString(fmt.Sprintf("Error finding reports: %v\n", err))
 		return sb.String()
@@ -262,83 +454,820 @@ func listGradeReports(reportsDir string) string {
 
 	sb.WriteString(fmt.Sprintf("Found %d report(s):\n\n", len(reports)))
 
-	for i, reportPath := range reports {
-		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, filepath.Base(reportPath)))
+	for i, reportName := range reports {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, reportName))
+	}
+
+	return sb.String()
+}
+
+// CheckResult is a single pass/fail check extracted from an evaluation
+// report's "## Checks" section.
+type CheckResult struct {
+	Name   string
+	Passed bool
+}
+
+// EvaluationReport represents parsed data from an evaluation-<skill>-<date>.md
+// report: the per-skill companion to GradeReport's aggregate skill-clarity
+// view, carrying the model/prompt that produced the run plus per-check
+// pass/fail and rubric scores for that single evaluation.
+type EvaluationReport struct {
+	FilePath      string
+	GeneratedDate string
+	Skill         string
+	Model         string
+	Prompt        string
+	Checks        []CheckResult
+	PassRate      float64
+	RubricScores  []CriteriaScore
+}
+
+// findEvaluationReports finds all evaluation-<skill>-<date>.md reports in
+// fsys (the reports directory root). Returns report names sorted by
+// filename (which includes date) in descending order, newest first,
+// matching findGradeReports.
+func findEvaluationReports(fsys fs.FS) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("reading reports directory: %w", err)
+	}
+
+	var reports []string
+	pattern := regexp.MustCompile(`^evaluation-[\w-]+-\d{4}-\d{2}-\d{2}\.md$`)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		if pattern.MatchString(entry.Name()) {
+			reports = append(reports, entry.Name())
+		}
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i] > reports[j]
+	})
+
+	return reports, nil
+}
+
+// parseEvaluationReport parses an evaluation report (named reportName in
+// fsys) and extracts the skill name, model/prompt metadata, per-check
+// pass/fail results, and rubric scores.
+func parseEvaluationReport(fsys fs.FS, reportName string) (EvaluationReport, error) {
+	content, err := fs.ReadFile(fsys, reportName)
+	if err != nil {
+		return EvaluationReport{}, fmt.Errorf("reading report: %w", err)
+	}
+
+	report := EvaluationReport{
+		FilePath: reportName,
+	}
+
+	lines := strings.Split(string(content), "\n")
+
+	titlePattern := regexp.MustCompile(`^#\s*Evaluation Report:\s*(.+)`)
+	generatedPattern := regexp.MustCompile(`Generated:\s*(.+)`)
+	modelPattern := regexp.MustCompile(`\*\*Model\*\*:\s*(.+)`)
+	promptPattern := regexp.MustCompile(`\*\*Prompt\*\*:\s*(.+)`)
+	checkPattern := regexp.MustCompile(`^\s*-\s*\[([ xX])\]\s*(.+)`)
+
+	for _, line := range lines {
+		if matches := titlePattern.FindStringSubmatch(line); matches != nil {
+			report.Skill = strings.TrimSpace(matches[1])
+		}
+		if matches := generatedPattern.FindStringSubmatch(line); matches != nil {
+			report.GeneratedDate = strings.TrimSpace(matches[1])
+		}
+		if matches := modelPattern.FindStringSubmatch(line); matches != nil {
+			report.Model = strings.TrimSpace(matches[1])
+		}
+		if matches := promptPattern.FindStringSubmatch(line); matches != nil {
+			report.Prompt = strings.TrimSpace(matches[1])
+		}
+		if matches := checkPattern.FindStringSubmatch(line); matches != nil {
+			report.Checks = append(report.Checks, CheckResult{
+				Name:   strings.TrimSpace(matches[2]),
+				Passed: strings.ToLower(matches[1]) == "x",
+			})
+		}
+	}
+
+	if len(report.Checks) > 0 {
+		passed := 0
+		for _, c := range report.Checks {
+			if c.Passed {
+				passed++
+			}
+		}
+		report.PassRate = float64(passed) / float64(len(report.Checks)) * 100
+	}
+
+	report.RubricScores = extractCriteriaScoresUnderHeading(lines, "## Rubric")
+
+	return report, nil
+}
+
+// extractCriteriaScoresUnderHeading parses the named second-level section
+// and returns one CriteriaScore per matching bullet, in the same "- **Name**
+// (weight: X%): score/100" format extractCriteriaScores reads. Unlike
+// extractCriteriaScores, it does not average repeated names.
+func extractCriteriaScoresUnderHeading(lines []string, heading string) []CriteriaScore {
+	criteriaPattern := regexp.MustCompile(`^\s*-\s*\*\*([^*]+)\*\*\s*\(weight:[^)]+\):\s*([\d.]+)/100`)
+
+	var result []CriteriaScore
+	inSection := false
+
+	for _, line := range lines {
+		if strings.HasPrefix(line, heading) {
+			inSection = true
+			continue
+		}
+		if inSection && strings.HasPrefix(line, "## ") && !strings.HasPrefix(line, heading) {
+			break
+		}
+		if !inSection {
+			continue
+		}
+		if matches := criteriaPattern.FindStringSubmatch(line); matches != nil {
+			score, err := strconv.ParseFloat(matches[2], 64)
+			if err != nil {
+				continue
+			}
+			result = append(result, CriteriaScore{
+				Name:    strings.TrimSpace(matches[1]),
+				Average: score,
+			})
+		}
+	}
+
+	return result
+}
+
+// formatEvaluationReportMarkdown formats an EvaluationReport as markdown
+func formatEvaluationReportMarkdown(report EvaluationReport) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Evaluation Report Summary\n\n")
+	sb.WriteString(fmt.Sprintf("**Report**: %s\n", filepath.Base(report.FilePath)))
+	sb.WriteString(fmt.Sprintf("**Skill**: %s\n", report.Skill))
+	sb.WriteString(fmt.Sprintf("**Generated**: %s\n\n", report.GeneratedDate))
+
+	sb.WriteString("## Key Metrics\n\n")
+	sb.WriteString(fmt.Sprintf("- **Model**: %s\n", report.Model))
+	sb.WriteString(fmt.Sprintf("- **Prompt**: %s\n", report.Prompt))
+	sb.WriteString(fmt.Sprintf("- **Checks**: %d/%d passed\n", passedCount(report.Checks), len(report.Checks)))
+	sb.WriteString(fmt.Sprintf("- **Pass Rate**: %.1f%%\n", report.PassRate))
+
+	if len(report.Checks) > 0 {
+		sb.WriteString("\n## Checks\n\n")
+		for _, c := range report.Checks {
+			mark := " "
+			if c.Passed {
+				mark = "x"
+			}
+			sb.WriteString(fmt.Sprintf("- [%s] %s\n", mark, c.Name))
+		}
+	}
+
+	if len(report.RubricScores) > 0 {
+		sb.WriteString("\n## Rubric Breakdown\n\n")
+		sb.WriteString("| Criteria | Average Score |\n")
+		sb.WriteString("|----------|---------------|\n")
+		for _, criteria := range report.RubricScores {
+			sb.WriteString(fmt.Sprintf("| %s | %.1f |\n", criteria.Name, criteria.Average))
+		}
 	}
 
 	return sb.String()
 }
 
+// passedCount returns how many checks passed.
+func passedCount(checks []CheckResult) int {
+	n := 0
+	for _, c := range checks {
+		if c.Passed {
+			n++
+		}
+	}
+	return n
+}
+
+// formatEvaluationReportJSON formats an EvaluationReport as JSON
+func formatEvaluationReportJSON(report EvaluationReport) (string, error) {
+	checks := make([]map[string]interface{}, 0, len(report.Checks))
+	for _, c := range report.Checks {
+		checks = append(checks, map[string]interface{}{
+			"name":   c.Name,
+			"passed": c.Passed,
+		})
+	}
+
+	rubricScores := make([]map[string]interface{}, 0, len(report.RubricScores))
+	for _, c := range report.RubricScores {
+		rubricScores = append(rubricScores, map[string]interface{}{
+			"name":    c.Name,
+			"average": c.Average,
+		})
+	}
+
+	data := map[string]interface{}{
+		"file_path":      report.FilePath,
+		"skill":          report.Skill,
+		"generated_date": report.GeneratedDate,
+		"model":          report.Model,
+		"prompt":         report.Prompt,
+		"checks":         checks,
+		"pass_rate":      report.PassRate,
+		"rubric_scores":  rubricScores,
+	}
+
+	jsonBytes, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling to JSON: %w", err)
+	}
+
+	return string(jsonBytes), nil
+}
+
+// listEvaluationReports lists all available evaluation reports
+func listEvaluationReports(fsys fs.FS) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Evaluation Reports\n\n")
+
+	reports, err := findEvaluationReports(fsys)
+	if err != nil {
+		sb.WriteString(fmt.Sprintf("Error finding reports: %v\n", err))
+		return sb.String()
+	}
+
+	if len(reports) == 0 {
+		sb.WriteString("No evaluation reports found.\n")
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("Found %d report(s):\n\n", len(reports)))
+
+	for i, reportName := range reports {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, reportName))
+	}
+
+	return sb.String()
+}
+
+// ReportAggregator is implemented once per report type so
+// runReportCommand can route `--list` and the find/parse/format pipeline
+// by reportType without duplicating the write-to-file-or-stdout plumbing.
+// Adding a new type (e.g. "regression") means adding one more
+// implementation and a map entry, not another branch in runReportCommand.
+type ReportAggregator interface {
+	// List renders the available reports in fsys without parsing them.
+	List(fsys fs.FS) string
+	// Latest finds, parses, and formats the newest matching report in
+	// fsys as format ("markdown", "json", "junit"/"xml", or "sarif").
+	Latest(fsys fs.FS, format string) (string, error)
+}
+
+// gradeAggregator implements ReportAggregator for skill-clarity ("grade")
+// reports.
+type gradeAggregator struct{}
+
+func (gradeAggregator) List(fsys fs.FS) string { return listGradeReports(fsys) }
+
+func (gradeAggregator) Latest(fsys fs.FS, format string) (string, error) {
+	reports, err := findGradeReports(fsys)
+	if err != nil {
+		return "", fmt.Errorf("finding grade reports: %w", err)
+	}
+	if len(reports) == 0 {
+		return "", fmt.Errorf("no grade reports found")
+	}
+
+	report, err := parseGradeReport(fsys, reports[0])
+	if err != nil {
+		return "", fmt.Errorf("parsing report: %w", err)
+	}
+
+	switch format {
+	case "json":
+		return formatReportSummaryJSON(report)
+	case "markdown":
+		return formatReportSummaryMarkdown(report), nil
+	case "xml", "junit":
+		return formatReportSummaryXML(report)
+	case "sarif":
+		return renderReportSuite(gradeReportToSuite(report), "sarif")
+	default:
+		return "", fmt.Errorf("unsupported format: %s (use 'markdown', 'json', 'xml', or 'sarif')", format)
+	}
+}
+
+// gradeReportToSuite converts an already-parsed GradeReport into a
+// ReportSuite so --format=sarif can share formats.sarifRenderer rather
+// than a third bespoke implementation. A parsed report only carries each
+// skill's name (see SkillScore), not the SKILL.md path buildGradeReportSuite
+// has at grading time, so physicalLocation is reconstructed from the
+// repo's `skills/<name>/SKILL.md` convention on a best-effort basis.
+func gradeReportToSuite(report GradeReport) ReportSuite {
+	cases := make([]ReportCase, 0, len(report.Skills))
+	for _, s := range report.Skills {
+		status := "passed"
+		if !s.Passed {
+			status = "failed"
+		}
+		cases = append(cases, ReportCase{
+			ID:      s.Name,
+			Name:    s.Name,
+			Passed:  s.Passed,
+			Message: fmt.Sprintf("%s scored %.1f/100 (%s)", s.Name, s.Score, status),
+			Score:   s.Score,
+			Path:    filepath.Join("skills", s.Name, "SKILL.md"),
+		})
+	}
+
+	return ReportSuite{Name: "skill-clarity", Cases: cases}
+}
+
+// evaluationAggregator implements ReportAggregator for per-skill
+// evaluation-<skill>-<date>.md reports.
+type evaluationAggregator struct{}
+
+func (evaluationAggregator) List(fsys fs.FS) string { return listEvaluationReports(fsys) }
+
+func (evaluationAggregator) Latest(fsys fs.FS, format string) (string, error) {
+	reports, err := findEvaluationReports(fsys)
+	if err != nil {
+		return "", fmt.Errorf("finding evaluation reports: %w", err)
+	}
+	if len(reports) == 0 {
+		return "", fmt.Errorf("no evaluation reports found")
+	}
+
+	report, err := parseEvaluationReport(fsys, reports[0])
+	if err != nil {
+		return "", fmt.Errorf("parsing report: %w", err)
+	}
+
+	switch format {
+	case "json":
+		return formatEvaluationReportJSON(report)
+	case "markdown":
+		return formatEvaluationReportMarkdown(report), nil
+	case "junit", "xml":
+		return renderReportSuite(evaluationReportToSuite(report), "junit")
+	case "sarif":
+		return renderReportSuite(evaluationReportToSuite(report), "sarif")
+	default:
+		return "", fmt.Errorf("unsupported format: %s (use 'markdown', 'json', 'junit', or 'sarif')", format)
+	}
+}
+
+// evaluationReportToSuite converts a parsed EvaluationReport into a
+// ReportSuite, one case per check (see CheckResult), grouped under the
+// report's skill so JUnit emits a single <testsuite> matching the report
+// it came from.
+func evaluationReportToSuite(report EvaluationReport) ReportSuite {
+	cases := make([]ReportCase, 0, len(report.Checks))
+	for _, c := range report.Checks {
+		cases = append(cases, ReportCase{
+			ID:      c.Name,
+			Name:    c.Name,
+			Group:   report.Skill,
+			Passed:  c.Passed,
+			Message: c.Name,
+			Path:    filepath.Join(report.Skill, "SKILL.md"),
+		})
+	}
+
+	return ReportSuite{Name: report.Skill, Cases: cases}
+}
+
+// reportAggregators maps a --type flag value to its ReportAggregator.
+// "trend" is handled separately by runTrendReportCommand since it
+// aggregates across a whole chronological series rather than rendering
+// the single latest report.
+var reportAggregators = map[string]ReportAggregator{
+	"grade":      gradeAggregator{},
+	"evaluation": evaluationAggregator{},
+}
+
 // runReportCommand executes the report CLI command
-func runReportCommand(reportType, format string, listMode bool, outputPath, reportsDir string) error {
-	// List mode: just list available reports
+func runReportCommand(reportType, format string, listMode bool, outputPath, reportsDir string, trend bool, regressionThreshold float64, window int) error {
+	if trend || reportType == "trend" {
+		return runTrendReportCommand(format, outputPath, reportsDir, regressionThreshold, window)
+	}
+
+	agg, ok := reportAggregators[reportType]
+	if !ok {
+		return fmt.Errorf("report type '%s' not yet implemented (supported: 'grade', 'evaluation', 'trend')", reportType)
+	}
+
+	fsys := reportfs.OSFS(reportsDir)
+
+	var output string
+	var err error
 	if listMode {
-		output := listGradeReports(reportsDir)
+		output = agg.List(fsys)
+	} else {
+		output, err = agg.Latest(fsys, format)
+		if err != nil {
+			return err
+		}
+	}
 
-		if outputPath != "" {
-			// Write to file
-			if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
-				return fmt.Errorf("writing output file: %w", err)
-			}
+	if outputPath != "" {
+		if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
+			return fmt.Errorf("writing output file: %w", err)
+		}
+		if listMode {
 			fmt.Printf("Report list written to: %s\n", outputPath)
 		} else {
-			// Write to stdout
-			fmt.Print(output)
+			fmt.Printf("Report written to: %s\n", outputPath)
+		}
+	} else {
+		fmt.Print(output)
+	}
+
+	return nil
+}
+
+// trendPoint is a single dated data point in a trend series.
+type trendPoint struct {
+	Date    string  `json:"date"`
+	Average float64 `json:"average"`
+}
+
+// criterionTrend is the time series of a single criterion's average score
+// across all parsed reports, in chronological order.
+type criterionTrend struct {
+	Name   string       `json:"name"`
+	Points []trendPoint `json:"points"`
+}
+
+// skillTrendPoint is a single dated data point in a per-skill score
+// time series.
+type skillTrendPoint struct {
+	Date   string  `json:"date"`
+	Score  float64 `json:"score"`
+	Passed bool    `json:"passed"`
+}
+
+// skillTrend is the time series of a single skill's score across all
+// parsed reports, in chronological order.
+type skillTrend struct {
+	Name   string            `json:"name"`
+	Points []skillTrendPoint `json:"points"`
+}
+
+// sparklineBlocks are the Unicode block characters used to render a
+// sparkline, from lowest to highest.
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders a slice of values (expected to be 0-100 scores) as a
+// single line of Unicode block characters.
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		if max == min {
+			sb.WriteRune(sparklineBlocks[len(sparklineBlocks)/2])
+			continue
 		}
+		idx := int((v - min) / (max - min) * float64(len(sparklineBlocks)-1))
+		sb.WriteRune(sparklineBlocks[idx])
+	}
 
+	return sb.String()
+}
+
+// parseGradeReportsChronological finds and parses every grade report in
+// fsys, returning them oldest-first so callers can walk history in order.
+func parseGradeReportsChronological(fsys fs.FS) ([]GradeReport, error) {
+	paths, err := findGradeReports(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("finding grade reports: %w", err)
+	}
+
+	reports := make([]GradeReport, 0, len(paths))
+	for i := len(paths) - 1; i >= 0; i-- {
+		report, err := parseGradeReport(fsys, paths[i])
+		if err != nil {
+			return nil, fmt.Errorf("parsing report %s: %w", paths[i], err)
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// buildCriterionTrends pivots a chronological slice of reports into a
+// per-criterion time series, preserving each report's own criteria order
+// for the first report that introduces a given name.
+func buildCriterionTrends(reports []GradeReport) []criterionTrend {
+	var order []string
+	seen := make(map[string]bool)
+	for _, report := range reports {
+		for _, c := range report.CriteriaScores {
+			if !seen[c.Name] {
+				seen[c.Name] = true
+				order = append(order, c.Name)
+			}
+		}
+	}
+
+	trends := make([]criterionTrend, 0, len(order))
+	for _, name := range order {
+		trend := criterionTrend{Name: name}
+		for _, report := range reports {
+			for _, c := range report.CriteriaScores {
+				if c.Name == name {
+					trend.Points = append(trend.Points, trendPoint{Date: report.GeneratedDate, Average: c.Average})
+				}
+			}
+		}
+		trends = append(trends, trend)
+	}
+
+	return trends
+}
+
+// buildSkillTrends pivots a chronological slice of reports into a
+// per-skill score time series, preserving each report's own skill order
+// for the first report that introduces a given name.
+func buildSkillTrends(reports []GradeReport) []skillTrend {
+	var order []string
+	seen := make(map[string]bool)
+	for _, report := range reports {
+		for _, s := range report.Skills {
+			if !seen[s.Name] {
+				seen[s.Name] = true
+				order = append(order, s.Name)
+			}
+		}
+	}
+
+	trends := make([]skillTrend, 0, len(order))
+	for _, name := range order {
+		trend := skillTrend{Name: name}
+		for _, report := range reports {
+			for _, s := range report.Skills {
+				if s.Name == name {
+					trend.Points = append(trend.Points, skillTrendPoint{Date: report.GeneratedDate, Score: s.Score, Passed: s.Passed})
+				}
+			}
+		}
+		trends = append(trends, trend)
+	}
+
+	return trends
+}
+
+// formatTrendMarkdown renders a chronological slice of reports as a
+// markdown trend table (with a Δ-vs-previous column) plus per-criterion
+// and per-skill sparkline sections.
+func formatTrendMarkdown(reports []GradeReport) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Grade Report Trend\n\n")
+	sb.WriteString("| Date | Total Skills | Avg Score | Pass Rate | Δ vs previous |\n")
+	sb.WriteString("|------|--------------|-----------|-----------|---------------|\n")
+
+	for i, report := range reports {
+		delta := "—"
+		if i > 0 {
+			diff := report.AverageScore - reports[i-1].AverageScore
+			delta = fmt.Sprintf("%+.1f", diff)
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %d | %.1f | %.1f%% | %s |\n",
+			report.GeneratedDate, report.TotalSkills, report.AverageScore, report.PassRate, delta))
+	}
+
+	trends := buildCriterionTrends(reports)
+	if len(trends) > 0 {
+		sb.WriteString("\n## Per-Criterion Sparklines\n\n")
+		for _, trend := range trends {
+			values := make([]float64, len(trend.Points))
+			for i, p := range trend.Points {
+				values[i] = p.Average
+			}
+			sb.WriteString(fmt.Sprintf("- **%s**: %s\n", trend.Name, sparkline(values)))
+		}
+	}
+
+	skillTrends := buildSkillTrends(reports)
+	if len(skillTrends) > 0 {
+		sb.WriteString("\n## Per-Skill Sparklines\n\n")
+		for _, trend := range skillTrends {
+			values := make([]float64, len(trend.Points))
+			for i, p := range trend.Points {
+				values[i] = p.Score
+			}
+			sb.WriteString(fmt.Sprintf("- **%s**: %s\n", trend.Name, sparkline(values)))
+		}
+	}
+
+	return sb.String()
+}
+
+// formatTrendJSON renders a chronological slice of reports as a JSON time
+// series: one series per criterion, one per skill, plus an "overall"
+// series of average scores. When regressionThreshold is non-negative, a
+// "regressions" array of every skill regression across the whole window
+// (not just the two newest reports, unlike detectSkillRegressions) is
+// included too.
+func formatTrendJSON(reports []GradeReport, regressionThreshold float64) (string, error) {
+	overall := make([]trendPoint, 0, len(reports))
+	for _, report := range reports {
+		overall = append(overall, trendPoint{Date: report.GeneratedDate, Average: report.AverageScore})
+	}
+
+	data := map[string]interface{}{
+		"criteria": buildCriterionTrends(reports),
+		"skills":   buildSkillTrends(reports),
+		"overall":  overall,
+	}
+	if regressionThreshold >= 0 {
+		data["regressions"] = skillRegressionEntries(reports, regressionThreshold)
+	}
+
+	jsonBytes, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling to JSON: %w", err)
+	}
+
+	return string(jsonBytes), nil
+}
+
+// regressionEntry is one skill's score drop between two consecutive
+// reports, or its crossing below the passing threshold, in the shape
+// `yokay-evals report --trend --format json --regression-threshold N`
+// emits in its "regressions" array.
+type regressionEntry struct {
+	Skill string  `json:"skill"`
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Delta float64 `json:"delta"`
+	Date  string  `json:"date"`
+}
+
+// skillRegressionEntries walks every consecutive pair of reports (unlike
+// detectSkillRegressions, which only looks at the two newest) and
+// returns a regressionEntry for every skill that either dropped by more
+// than threshold points or crossed below report.PassingThreshold.
+func skillRegressionEntries(reports []GradeReport, threshold float64) []regressionEntry {
+	var entries []regressionEntry
+
+	for i := 1; i < len(reports); i++ {
+		previous, latest := reports[i-1], reports[i]
+		prevScores := make(map[string]float64, len(previous.Skills))
+		for _, s := range previous.Skills {
+			prevScores[s.Name] = s.Score
+		}
+
+		for _, s := range latest.Skills {
+			prev, ok := prevScores[s.Name]
+			if !ok {
+				continue
+			}
+			drop := prev - s.Score
+			crossedThreshold := prev >= latest.PassingThreshold && s.Score < latest.PassingThreshold
+			if drop > threshold || crossedThreshold {
+				entries = append(entries, regressionEntry{
+					Skill: s.Name,
+					From:  prev,
+					To:    s.Score,
+					Delta: drop,
+					Date:  latest.GeneratedDate,
+				})
+			}
+		}
+	}
+
+	return entries
+}
+
+// detectRegressions compares the two newest reports and returns a
+// human-readable line for every criterion whose average dropped by more
+// than threshold points.
+func detectRegressions(reports []GradeReport, threshold float64) []string {
+	if len(reports) < 2 {
+		return nil
+	}
+
+	previous, latest := reports[len(reports)-2], reports[len(reports)-1]
+	prevScores := make(map[string]float64, len(previous.CriteriaScores))
+	for _, c := range previous.CriteriaScores {
+		prevScores[c.Name] = c.Average
+	}
+
+	var regressions []string
+	for _, c := range latest.CriteriaScores {
+		prev, ok := prevScores[c.Name]
+		if !ok {
+			continue
+		}
+		if drop := prev - c.Average; drop > threshold {
+			regressions = append(regressions, fmt.Sprintf("%s dropped %.1f points (%.1f -> %.1f)", c.Name, drop, prev, c.Average))
+		}
+	}
+
+	return regressions
+}
+
+// detectSkillRegressions compares the two newest reports and returns a
+// human-readable line for every skill whose score dropped by more than
+// threshold points.
+func detectSkillRegressions(reports []GradeReport, threshold float64) []string {
+	if len(reports) < 2 {
 		return nil
 	}
 
-	// For now, only support 'grade' type
-	if reportType != "grade" {
-		return fmt.Errorf("report type '%s' not yet implemented (only 'grade' is currently supported)", reportType)
+	previous, latest := reports[len(reports)-2], reports[len(reports)-1]
+	prevScores := make(map[string]float64, len(previous.Skills))
+	for _, s := range previous.Skills {
+		prevScores[s.Name] = s.Score
 	}
 
-	// Find reports
-	reports, err := findGradeReports(reportsDir)
+	var regressions []string
+	for _, s := range latest.Skills {
+		prev, ok := prevScores[s.Name]
+		if !ok {
+			continue
+		}
+		if drop := prev - s.Score; drop > threshold {
+			regressions = append(regressions, fmt.Sprintf("%s dropped %.1f points (%.1f -> %.1f)", s.Name, drop, prev, s.Score))
+		}
+	}
+
+	return regressions
+}
+
+// runTrendReportCommand implements the --trend report mode: it parses
+// every matching report in reportsDir chronologically, keeps only the
+// window most recent ones (window <= 0 means keep all of them), and
+// emits either a markdown trend table with sparklines or a JSON time
+// series. When regressionThreshold is non-negative, it additionally
+// flags any criterion or skill whose score dropped by more than that
+// many points between the two newest reports by returning a
+// non-zero-exit error, so this can gate a CI job.
+func runTrendReportCommand(format, outputPath, reportsDir string, regressionThreshold float64, window int) error {
+	reports, err := parseGradeReportsChronological(reportfs.OSFS(reportsDir))
 	if err != nil {
-		return fmt.Errorf("finding grade reports: %w", err)
+		return err
 	}
 
 	if len(reports) == 0 {
 		return fmt.Errorf("no grade reports found in %s", reportsDir)
 	}
 
-	// Get the latest report
-	latestReportPath := reports[0]
-
-	// Parse the report
-	report, err := parseGradeReport(latestReportPath)
-	if err != nil {
-		return fmt.Errorf("parsing report: %w", err)
+	if window > 0 && len(reports) > window {
+		reports = reports[len(reports)-window:]
 	}
 
-	// Format the output
 	var output string
 	switch format {
 	case "json":
-		jsonOutput, err := formatReportSummaryJSON(report)
+		output, err = formatTrendJSON(reports, regressionThreshold)
 		if err != nil {
-			return fmt.Errorf("formatting as JSON: %w", err)
+			return fmt.Errorf("formatting trend as JSON: %w", err)
 		}
-		output = jsonOutput
 	case "markdown":
-		output = formatReportSummaryMarkdown(report)
+		output = formatTrendMarkdown(reports)
 	default:
 		return fmt.Errorf("unsupported format: %s (use 'markdown' or 'json')", format)
 	}
 
-	// Write output
 	if outputPath != "" {
-		// Write to file
 		if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
 			return fmt.Errorf("writing output file: %w", err)
 		}
 		fmt.Printf("Report written to: %s\n", outputPath)
 	} else {
-		// Write to stdout
 		fmt.Print(output)
 	}
 
+	if regressionThreshold >= 0 {
+		regressions := append(detectRegressions(reports, regressionThreshold), detectSkillRegressions(reports, regressionThreshold)...)
+		if len(regressions) > 0 {
+			return fmt.Errorf("regression detected (threshold %.1f): %s", regressionThreshold, strings.Join(regressions, "; "))
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stevestomp/yokay-evals/internal/reportfs"
+	"golang.org/x/tools/txtar"
+)
+
+// TestGoldenReports walks testdata/reports/*.txtar, each of which bundles
+// one skill-clarity report.md alongside its expected summary.md and
+// summary.json, and checks that parseGradeReport + formatReportSummary*
+// still produce that expected output. This follows the txtar fixture
+// pattern gopls uses for its own golden tests: add a case by dropping in
+// an archive rather than editing TestParseGradeReport/TestFormatReport*
+// by hand.
+//
+// Run with -update (the same flag TestFailureCaseLiveExpectations uses)
+// to regenerate summary.md/summary.json from the current parsing and
+// formatting code after an intentional behavior change.
+func TestGoldenReports(t *testing.T) {
+	archives, err := filepath.Glob("testdata/reports/*.txtar")
+	if err != nil {
+		t.Fatalf("globbing testdata/reports: %v", err)
+	}
+	if len(archives) == 0 {
+		t.Fatal("no .txtar fixtures found under testdata/reports")
+	}
+
+	for _, archivePath := range archives {
+		archivePath := archivePath
+		t.Run(filepath.Base(archivePath), func(t *testing.T) {
+			archive, err := txtar.ParseFile(archivePath)
+			if err != nil {
+				t.Fatalf("parsing archive: %v", err)
+			}
+
+			reportMD := archiveFile(archive, "report.md")
+			if reportMD == nil {
+				t.Fatal("archive has no report.md section")
+			}
+
+			fsys := reportfs.NewMemFS()
+			fsys.WriteFile("report.md", string(reportMD))
+
+			report, err := parseGradeReport(fsys, "report.md")
+			if err != nil {
+				t.Fatalf("parseGradeReport: %v", err)
+			}
+
+			gotMD := formatReportSummaryMarkdown(report)
+			gotJSON, err := formatReportSummaryJSON(report)
+			if err != nil {
+				t.Fatalf("formatReportSummaryJSON: %v", err)
+			}
+			// formatReportSummaryJSON's own MarshalIndent already ends
+			// without a trailing newline; txtar sections always end with
+			// one, so append it before comparing/writing.
+			gotJSON += "\n"
+
+			if *updateExpectations {
+				setArchiveFile(archive, "summary.md", []byte(gotMD))
+				setArchiveFile(archive, "summary.json", []byte(gotJSON))
+				if err := os.WriteFile(archivePath, txtar.Format(archive), 0644); err != nil {
+					t.Fatalf("writing updated archive: %v", err)
+				}
+				return
+			}
+
+			wantMD := archiveFile(archive, "summary.md")
+			if wantMD == nil {
+				t.Fatal("archive has no summary.md section; run with -update to generate it")
+			}
+			if diff := cmp.Diff(string(wantMD), gotMD); diff != "" {
+				t.Errorf("formatReportSummaryMarkdown mismatch (-want +got):\n%s", diff)
+			}
+
+			wantJSON := archiveFile(archive, "summary.json")
+			if wantJSON == nil {
+				t.Fatal("archive has no summary.json section; run with -update to generate it")
+			}
+			if diff := cmp.Diff(string(wantJSON), gotJSON); diff != "" {
+				t.Errorf("formatReportSummaryJSON mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// archiveFile returns the contents of the named txtar section, or nil if
+// the archive has no such section.
+func archiveFile(archive *txtar.Archive, name string) []byte {
+	for _, f := range archive.Files {
+		if f.Name == name {
+			return f.Data
+		}
+	}
+	return nil
+}
+
+// setArchiveFile replaces the named section's contents, appending a new
+// section if none exists yet.
+func setArchiveFile(archive *txtar.Archive, name string, data []byte) {
+	for i, f := range archive.Files {
+		if f.Name == name {
+			archive.Files[i].Data = data
+			return
+		}
+	}
+	archive.Files = append(archive.Files, txtar.File{Name: name, Data: data})
+}
@@ -2,21 +2,18 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/stevestomp/yokay-evals/internal/reportfs"
 )
 
 // TestFindGradeReports verifies that findGradeReports can locate skill-clarity reports
 func TestFindGradeReports(t *testing.T) {
-	// Create a temporary directory with test reports
-	tmpDir := t.TempDir()
-	reportsDir := filepath.Join(tmpDir, "reports")
-	err := os.MkdirAll(reportsDir, 0755)
-	if err != nil {
-		t.Fatalf("Failed to create test reports dir: %v", err)
-	}
+	fsys := reportfs.NewMemFS()
 
 	// Create test report files
 	testFiles := []string{
@@ -25,17 +22,12 @@ func TestFindGradeReports(t *testing.T) {
 		"skill-clarity-2026-01-24.md",
 		"other-report.md", // Should not be included
 	}
-
 	for _, filename := range testFiles {
-		path := filepath.Join(reportsDir, filename)
-		err := os.WriteFile(path, []byte("# Test Report\n"), 0644)
-		if err != nil {
-			t.Fatalf("Failed to create test file: %v", err)
-		}
+		fsys.WriteFile(filename, "# Test Report\n")
 	}
 
 	// Test: Find grade reports
-	reports, err := findGradeReports(reportsDir)
+	reports, err := findGradeReports(fsys)
 	if err != nil {
 		t.Fatalf("findGradeReports failed: %v", err)
 	}
@@ -57,17 +49,15 @@ func TestFindGradeReports(t *testing.T) {
 		if i >= len(reports) {
 			break
 		}
-		if filepath.Base(reports[i]) != expected {
-			t.Errorf("Report %d: expected %s, got %s", i, expected, filepath.Base(reports[i]))
+		if reports[i] != expected {
+			t.Errorf("Report %d: expected %s, got %s", i, expected, reports[i])
 		}
 	}
 }
 
 // TestParseGradeReport verifies that parseGradeReport can extract metrics from a report file
 func TestParseGradeReport(t *testing.T) {
-	// Create a temporary report file with known content
-	tmpDir := t.TempDir()
-	reportPath := filepath.Join(tmpDir, "skill-clarity-2026-01-26.md")
+	fsys := reportfs.NewMemFS()
 
 	reportContent := `# Skill Clarity Report
 
@@ -91,13 +81,10 @@ These skills need improvement:
 - **documentation** - 68.0/100 - **FAILED**
 `
 
-	err := os.WriteFile(reportPath, []byte(reportContent), 0644)
-	if err != nil {
-		t.Fatalf("Failed to create test report: %v", err)
-	}
+	fsys.WriteFile("skill-clarity-2026-01-26.md", reportContent)
 
 	// Test: Parse the report
-	report, err := parseGradeReport(reportPath)
+	report, err := parseGradeReport(fsys, "skill-clarity-2026-01-26.md")
 	if err != nil {
 		t.Fatalf("parseGradeReport failed: %v", err)
 	}
@@ -193,30 +180,19 @@ func TestFormatReportSummaryJSON(t *testing.T) {
 
 // TestListGradeReports verifies that listGradeReports outputs correct format
 func TestListGradeReports(t *testing.T) {
-	// Create temporary directory with test reports
-	tmpDir := t.TempDir()
-	reportsDir := filepath.Join(tmpDir, "reports")
-	err := os.MkdirAll(reportsDir, 0755)
-	if err != nil {
-		t.Fatalf("Failed to create test reports dir: %v", err)
-	}
+	fsys := reportfs.NewMemFS()
 
 	// Create test report files
 	testFiles := []string{
 		"skill-clarity-2026-01-26.md",
 		"skill-clarity-2026-01-25.md",
 	}
-
 	for _, filename := range testFiles {
-		path := filepath.Join(reportsDir, filename)
-		err := os.WriteFile(path, []byte("# Test Report\n"), 0644)
-		if err != nil {
-			t.Fatalf("Failed to create test file: %v", err)
-		}
+		fsys.WriteFile(filename, "# Test Report\n")
 	}
 
 	// Test: List grade reports
-	output := listGradeReports(reportsDir)
+	output := listGradeReports(fsys)
 
 	// Verify: Output contains both reports
 	for _, filename := range testFiles {
@@ -261,7 +237,7 @@ Generated: 2026-01-26 21:30:43
 	}
 
 	// Test: Run report command with grade type
-	err = runReportCommand("grade", "markdown", false, "", reportsDir)
+	err = runReportCommand("grade", "markdown", false, "", reportsDir, false, -1, 0)
 	if err != nil {
 		t.Fatalf("runReportCommand failed: %v", err)
 	}
@@ -296,7 +272,7 @@ func TestRunReportCommandListMode(t *testing.T) {
 	}
 
 	// Test: Run report command in list mode
-	err = runReportCommand("grade", "markdown", true, "", reportsDir)
+	err = runReportCommand("grade", "markdown", true, "", reportsDir, false, -1, 0)
 	if err != nil {
 		t.Fatalf("runReportCommand in list mode failed: %v", err)
 	}
@@ -304,9 +280,7 @@ func TestRunReportCommandListMode(t *testing.T) {
 
 // TestParseGradeReportWithCriteriaScores verifies that parseGradeReport extracts per-criteria scores
 func TestParseGradeReportWithCriteriaScores(t *testing.T) {
-	// Create a temporary report file with Detailed Breakdown section
-	tmpDir := t.TempDir()
-	reportPath := filepath.Join(tmpDir, "skill-clarity-2026-01-26.md")
+	fsys := reportfs.NewMemFS()
 
 	reportContent := `# Skill Clarity Report
 
@@ -367,13 +341,10 @@ Generated: 2026-01-26 21:30:43
   - Evaluation note
 `
 
-	err := os.WriteFile(reportPath, []byte(reportContent), 0644)
-	if err != nil {
-		t.Fatalf("Failed to create test report: %v", err)
-	}
+	fsys.WriteFile("skill-clarity-2026-01-26.md", reportContent)
 
 	// Test: Parse the report
-	report, err := parseGradeReport(reportPath)
+	report, err := parseGradeReport(fsys, "skill-clarity-2026-01-26.md")
 	if err != nil {
 		t.Fatalf("parseGradeReport failed: %v", err)
 	}
@@ -516,3 +487,436 @@ func TestFormatReportSummaryJSONWithCriteria(t *testing.T) {
 		t.Error("Expected criteria score to have 'average' field")
 	}
 }
+
+// trendFixtures is a small chronological sequence of skill-clarity reports
+// with diverging criteria scores, shared by the trend tests below.
+var trendFixtures = []struct {
+	date            string
+	avg             string
+	clearInstr      string
+	actionableSteps string
+}{
+	{"2026-01-24", "60.0", "70.0", "50.0"},
+	{"2026-01-25", "65.0", "75.0", "55.0"},
+	{"2026-01-26", "58.0", "60.0", "56.0"},
+}
+
+// trendFixtureContent renders a single trend fixture as a skill-clarity
+// report body.
+func trendFixtureContent(date, avg, clearInstr, actionableSteps string) string {
+	status := "❌ Fail"
+	return fmt.Sprintf(`# Skill Clarity Report
+
+Generated: %s 12:00:00
+
+## Summary
+
+- **Total Skills**: 10
+- **Average Score**: %s/100
+- **Pass Rate**: 50.0%% (5/10)
+- **Passing Threshold**: 70.0
+
+## Skills by Score
+
+| # | Skill | Score | Status |
+|---|-------|-------|--------|
+| 1 | skill-one | %s | %s |
+
+## Detailed Breakdown
+
+### skill-one
+
+**Criteria Scores**:
+
+- **Clear Instructions** (weight: 30%%): %s/100
+- **Actionable Steps** (weight: 25%%): %s/100
+`, date, avg, avg, status, clearInstr, actionableSteps)
+}
+
+// writeTrendFixtureReports creates a small chronological sequence of
+// skill-clarity reports with diverging criteria scores, for trend tests
+// that exercise runReportCommand's real-directory path.
+func writeTrendFixtureReports(t *testing.T, reportsDir string) {
+	t.Helper()
+
+	for _, f := range trendFixtures {
+		content := trendFixtureContent(f.date, f.avg, f.clearInstr, f.actionableSteps)
+		path := filepath.Join(reportsDir, fmt.Sprintf("skill-clarity-%s.md", f.date))
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create fixture report: %v", err)
+		}
+	}
+}
+
+// trendFixtureFS builds the same fixture sequence as writeTrendFixtureReports
+// into a MemFS, for tests that call the fs.FS-based report functions directly.
+func trendFixtureFS() *reportfs.MemFS {
+	fsys := reportfs.NewMemFS()
+	for _, f := range trendFixtures {
+		content := trendFixtureContent(f.date, f.avg, f.clearInstr, f.actionableSteps)
+		fsys.WriteFile(fmt.Sprintf("skill-clarity-%s.md", f.date), content)
+	}
+	return fsys
+}
+
+// TestParseGradeReportsChronological verifies reports come back oldest-first
+func TestParseGradeReportsChronological(t *testing.T) {
+	reports, err := parseGradeReportsChronological(trendFixtureFS())
+	if err != nil {
+		t.Fatalf("parseGradeReportsChronological failed: %v", err)
+	}
+
+	if len(reports) != 3 {
+		t.Fatalf("Expected 3 reports, got %d", len(reports))
+	}
+
+	wantDates := []string{"2026-01-24", "2026-01-25", "2026-01-26"}
+	for i, want := range wantDates {
+		if !strings.Contains(reports[i].GeneratedDate, want) {
+			t.Errorf("report %d: expected date to contain %s, got %s", i, want, reports[i].GeneratedDate)
+		}
+	}
+}
+
+// TestFormatTrendMarkdown verifies the trend table and sparkline rendering
+func TestFormatTrendMarkdown(t *testing.T) {
+	reports, err := parseGradeReportsChronological(trendFixtureFS())
+	if err != nil {
+		t.Fatalf("parseGradeReportsChronological failed: %v", err)
+	}
+
+	output := formatTrendMarkdown(reports)
+
+	if !strings.Contains(output, "| Date | Total Skills | Avg Score | Pass Rate | Δ vs previous |") {
+		t.Error("Expected trend table header")
+	}
+	if !strings.Contains(output, "+5.0") {
+		t.Errorf("Expected a +5.0 delta between the first two reports, got:\n%s", output)
+	}
+	if !strings.Contains(output, "## Per-Criterion Sparklines") {
+		t.Error("Expected sparkline section")
+	}
+	if !strings.Contains(output, "Clear Instructions") {
+		t.Error("Expected per-criterion sparkline for Clear Instructions")
+	}
+	if !strings.Contains(output, "## Per-Skill Sparklines") {
+		t.Error("Expected per-skill sparkline section")
+	}
+	if !strings.Contains(output, "skill-one") {
+		t.Error("Expected per-skill sparkline for skill-one")
+	}
+}
+
+// TestFormatTrendJSON verifies the JSON time series structure
+func TestFormatTrendJSON(t *testing.T) {
+	reports, err := parseGradeReportsChronological(trendFixtureFS())
+	if err != nil {
+		t.Fatalf("parseGradeReportsChronological failed: %v", err)
+	}
+
+	output, err := formatTrendJSON(reports, -1)
+	if err != nil {
+		t.Fatalf("formatTrendJSON failed: %v", err)
+	}
+
+	var parsed struct {
+		Criteria []struct {
+			Name   string `json:"name"`
+			Points []struct {
+				Date    string  `json:"date"`
+				Average float64 `json:"average"`
+			} `json:"points"`
+		} `json:"criteria"`
+		Skills []struct {
+			Name   string `json:"name"`
+			Points []struct {
+				Date   string  `json:"date"`
+				Score  float64 `json:"score"`
+				Passed bool    `json:"passed"`
+			} `json:"points"`
+		} `json:"skills"`
+		Overall []struct {
+			Date    string  `json:"date"`
+			Average float64 `json:"average"`
+		} `json:"overall"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	if len(parsed.Overall) != 3 {
+		t.Errorf("Expected 3 overall points, got %d", len(parsed.Overall))
+	}
+
+	if len(parsed.Criteria) == 0 {
+		t.Fatal("Expected at least one criterion series")
+	}
+
+	if len(parsed.Skills) != 1 || parsed.Skills[0].Name != "skill-one" {
+		t.Fatalf("Expected a single skill-one series, got %+v", parsed.Skills)
+	}
+	if len(parsed.Skills[0].Points) != 3 {
+		t.Errorf("Expected 3 points for skill-one, got %d", len(parsed.Skills[0].Points))
+	}
+}
+
+// TestFormatTrendJSONIncludesRegressions verifies formatTrendJSON adds a
+// "regressions" array (skill, from, to, delta, date) when given a
+// non-negative threshold, and omits it when the threshold is disabled.
+func TestFormatTrendJSONIncludesRegressions(t *testing.T) {
+	reports, err := parseGradeReportsChronological(trendFixtureFS())
+	if err != nil {
+		t.Fatalf("parseGradeReportsChronological failed: %v", err)
+	}
+
+	output, err := formatTrendJSON(reports, 5.0)
+	if err != nil {
+		t.Fatalf("formatTrendJSON failed: %v", err)
+	}
+
+	var parsed struct {
+		Regressions []struct {
+			Skill string  `json:"skill"`
+			From  float64 `json:"from"`
+			To    float64 `json:"to"`
+			Delta float64 `json:"delta"`
+			Date  string  `json:"date"`
+		} `json:"regressions"`
+	}
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	if len(parsed.Regressions) == 0 {
+		t.Fatal("Expected at least one regression entry (skill-one drops 65.0 -> 58.0)")
+	}
+	if parsed.Regressions[0].Skill != "skill-one" {
+		t.Errorf("Regressions[0].Skill = %q, want %q", parsed.Regressions[0].Skill, "skill-one")
+	}
+	if parsed.Regressions[0].Delta != 7.0 {
+		t.Errorf("Regressions[0].Delta = %v, want 7.0", parsed.Regressions[0].Delta)
+	}
+
+	disabledOutput, err := formatTrendJSON(reports, -1)
+	if err != nil {
+		t.Fatalf("formatTrendJSON failed: %v", err)
+	}
+	if strings.Contains(disabledOutput, `"regressions"`) {
+		t.Error("Expected no regressions key when regressionThreshold is disabled (-1)")
+	}
+}
+
+// TestRunReportCommandTrendWindow verifies --window limits the trend to
+// the N most recent reports instead of the whole history.
+func TestRunReportCommandTrendWindow(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportsDir := filepath.Join(tmpDir, "reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create test reports dir: %v", err)
+	}
+	writeTrendFixtureReports(t, reportsDir)
+
+	outputFile := filepath.Join(tmpDir, "trend.json")
+	if err := runReportCommand("trend", "json", false, outputFile, reportsDir, true, -1, 2); err != nil {
+		t.Fatalf("runReportCommand failed: %v", err)
+	}
+
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+
+	var parsed struct {
+		Overall []struct {
+			Date string `json:"date"`
+		} `json:"overall"`
+	}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	if len(parsed.Overall) != 2 {
+		t.Errorf("Expected --window 2 to keep only the 2 most recent reports, got %d", len(parsed.Overall))
+	}
+	if !strings.Contains(parsed.Overall[0].Date, "2026-01-25") || !strings.Contains(parsed.Overall[1].Date, "2026-01-26") {
+		t.Errorf("Expected the two newest reports (2026-01-25, 2026-01-26), got %+v", parsed.Overall)
+	}
+}
+
+// TestDetectRegressions verifies the regression check between the two newest reports
+func TestDetectRegressions(t *testing.T) {
+	reports, err := parseGradeReportsChronological(trendFixtureFS())
+	if err != nil {
+		t.Fatalf("parseGradeReportsChronological failed: %v", err)
+	}
+
+	// Clear Instructions drops from 75.0 -> 60.0 between the last two reports
+	regressions := detectRegressions(reports, 5.0)
+	if len(regressions) == 0 {
+		t.Fatal("Expected at least one regression to be detected")
+	}
+
+	if len(detectRegressions(reports, 50.0)) != 0 {
+		t.Error("Expected no regressions with a very high threshold")
+	}
+}
+
+// TestDetectSkillRegressions verifies the per-skill regression check
+// between the two newest reports
+func TestDetectSkillRegressions(t *testing.T) {
+	reports, err := parseGradeReportsChronological(trendFixtureFS())
+	if err != nil {
+		t.Fatalf("parseGradeReportsChronological failed: %v", err)
+	}
+
+	// skill-one drops from 65.0 -> 58.0 between the last two reports
+	regressions := detectSkillRegressions(reports, 5.0)
+	if len(regressions) == 0 {
+		t.Fatal("Expected at least one skill regression to be detected")
+	}
+
+	if len(detectSkillRegressions(reports, 50.0)) != 0 {
+		t.Error("Expected no skill regressions with a very high threshold")
+	}
+}
+
+// TestRunReportCommandTrendRegressionGating verifies --trend with
+// --regression-threshold returns an error when a criterion regresses
+func TestRunReportCommandTrendRegressionGating(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportsDir := filepath.Join(tmpDir, "reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create test reports dir: %v", err)
+	}
+	writeTrendFixtureReports(t, reportsDir)
+
+	if err := runReportCommand("trend", "markdown", false, "", reportsDir, true, 5.0, 0); err == nil {
+		t.Error("Expected runReportCommand to return an error when a regression exceeds the threshold")
+	}
+
+	if err := runReportCommand("trend", "markdown", false, "", reportsDir, true, 50.0, 0); err != nil {
+		t.Errorf("Expected no error with a high regression threshold, got: %v", err)
+	}
+}
+
+// evaluationReportFixture is a sample evaluation-<skill>-<date>.md report
+// used across the evaluation-report tests below.
+const evaluationReportFixture = `# Evaluation Report: ux-design
+
+Generated: 2026-01-26 21:30:43
+
+**Model**: claude-haiku
+**Prompt**: skill-clarity-v2
+
+## Checks
+
+- [x] Has clear instructions
+- [x] Includes examples
+- [ ] Defines scope boundaries
+
+## Rubric
+
+- **Clarity** (weight: 40%): 80.0/100
+- **Examples** (weight: 60%): 70.0/100
+`
+
+// TestFindEvaluationReports verifies that findEvaluationReports can locate
+// evaluation-<skill>-<date>.md reports, sorted newest first.
+func TestFindEvaluationReports(t *testing.T) {
+	fsys := reportfs.NewMemFS()
+
+	testFiles := []string{
+		"evaluation-ux-design-2026-01-25.md",
+		"evaluation-ux-design-2026-01-26.md",
+		"skill-clarity-2026-01-26.md", // should not be included
+	}
+	for _, filename := range testFiles {
+		fsys.WriteFile(filename, "# Test\n")
+	}
+
+	reports, err := findEvaluationReports(fsys)
+	if err != nil {
+		t.Fatalf("findEvaluationReports failed: %v", err)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("Expected 2 evaluation reports, got %d", len(reports))
+	}
+	if reports[0] != "evaluation-ux-design-2026-01-26.md" {
+		t.Errorf("Expected newest report first, got %s", reports[0])
+	}
+}
+
+// TestParseEvaluationReport verifies that parseEvaluationReport extracts
+// the skill, model, prompt, per-check results, and rubric scores.
+func TestParseEvaluationReport(t *testing.T) {
+	fsys := reportfs.NewMemFS()
+	fsys.WriteFile("evaluation-ux-design-2026-01-26.md", evaluationReportFixture)
+
+	report, err := parseEvaluationReport(fsys, "evaluation-ux-design-2026-01-26.md")
+	if err != nil {
+		t.Fatalf("parseEvaluationReport failed: %v", err)
+	}
+
+	if report.Skill != "ux-design" {
+		t.Errorf("Skill = %q, want %q", report.Skill, "ux-design")
+	}
+	if report.Model != "claude-haiku" {
+		t.Errorf("Model = %q, want %q", report.Model, "claude-haiku")
+	}
+	if report.Prompt != "skill-clarity-v2" {
+		t.Errorf("Prompt = %q, want %q", report.Prompt, "skill-clarity-v2")
+	}
+	if len(report.Checks) != 3 {
+		t.Fatalf("Expected 3 checks, got %d", len(report.Checks))
+	}
+	if !report.Checks[0].Passed || report.Checks[2].Passed {
+		t.Errorf("Checks = %+v, want first two passed and the last failed", report.Checks)
+	}
+	wantPassRate := 200.0 / 3.0
+	if diff := report.PassRate - wantPassRate; diff > 0.01 || diff < -0.01 {
+		t.Errorf("PassRate = %.4f, want %.4f", report.PassRate, wantPassRate)
+	}
+	if len(report.RubricScores) != 2 || report.RubricScores[0].Name != "Clarity" {
+		t.Errorf("RubricScores = %+v, want Clarity then Examples", report.RubricScores)
+	}
+}
+
+// TestRunReportCommandEvaluationType verifies that the "evaluation" report
+// type is wired end-to-end through runReportCommand for both formats.
+func TestRunReportCommandEvaluationType(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportsDir := filepath.Join(tmpDir, "reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create test reports dir: %v", err)
+	}
+	reportPath := filepath.Join(reportsDir, "evaluation-ux-design-2026-01-26.md")
+	if err := os.WriteFile(reportPath, []byte(evaluationReportFixture), 0644); err != nil {
+		t.Fatalf("Failed to create test report: %v", err)
+	}
+
+	if err := runReportCommand("evaluation", "markdown", false, "", reportsDir, false, -1, 0); err != nil {
+		t.Fatalf("runReportCommand failed: %v", err)
+	}
+
+	outputFile := filepath.Join(tmpDir, "out.json")
+	if err := runReportCommand("evaluation", "json", false, outputFile, reportsDir, false, -1, 0); err != nil {
+		t.Fatalf("runReportCommand (json) failed: %v", err)
+	}
+
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	if parsed["skill"] != "ux-design" {
+		t.Errorf("Expected skill=ux-design, got %v", parsed["skill"])
+	}
+
+	if err := runReportCommand("evaluation", "markdown", true, "", reportsDir, false, -1, 0); err != nil {
+		t.Fatalf("runReportCommand in list mode failed: %v", err)
+	}
+}
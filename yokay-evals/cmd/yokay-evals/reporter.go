@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/stevestomp/yokay-evals/internal/report/formats"
+)
+
+// ReportCase and ReportSuite alias internal/report/formats' types so
+// every command in this package can keep building reports without
+// importing the formats package under a different name at every call
+// site.
+type ReportCase = formats.Case
+type ReportSuite = formats.Suite
+type ReportCaseFailure = formats.CaseFailure
+
+// ReportSink is anywhere a rendered report can be written: an open file,
+// an in-memory buffer, os.Stdout, or anything else satisfying io.Writer,
+// so writing a report doesn't require a path on disk.
+type ReportSink = io.Writer
+
+// renderReportSuite renders suite as format.
+func renderReportSuite(suite ReportSuite, format string) (string, error) {
+	return formats.Render(suite, format)
+}
+
+// writeReportToSink renders suite as format and writes it to sink.
+func writeReportToSink(suite ReportSuite, format string, sink ReportSink) error {
+	rendered, err := renderReportSuite(suite, format)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(sink, rendered)
+	return err
+}
+
+// writeReportOutputs renders suite in every format in formats. With a
+// single format, outputPrefix (when set) is used as the literal output
+// path, matching each command's pre-existing single-file behavior;
+// writing more than one format requires outputPrefix, since each format
+// then gets its own file named outputPrefix + "." + extension.
+func writeReportOutputs(suite ReportSuite, formatNames []string, outputPrefix string) error {
+	if len(formatNames) > 1 && outputPrefix == "" {
+		return fmt.Errorf("--output is required when writing multiple --format values")
+	}
+
+	for _, format := range formatNames {
+		// Render before touching the filesystem, so an unsupported
+		// format (or any other render error) doesn't leave a truncated
+		// file behind where os.WriteFile's all-or-nothing write used to
+		// leave nothing at all.
+		rendered, err := renderReportSuite(suite, format)
+		if err != nil {
+			return err
+		}
+
+		if outputPrefix == "" {
+			if _, err := io.WriteString(os.Stdout, rendered); err != nil {
+				return err
+			}
+			continue
+		}
+
+		path := outputPrefix
+		if len(formatNames) > 1 {
+			path = fmt.Sprintf("%s.%s", outputPrefix, formats.Extension(format))
+		}
+
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return fmt.Errorf("writing %s output: %w", format, err)
+		}
+		_, err = io.WriteString(f, rendered)
+		closeErr := f.Close()
+		if err != nil {
+			return fmt.Errorf("writing %s output: %w", format, err)
+		}
+		if closeErr != nil {
+			return fmt.Errorf("writing %s output: %w", format, closeErr)
+		}
+		fmt.Printf("Report (%s) written to: %s\n", format, path)
+	}
+
+	return nil
+}
+
+// writeReportOutputsToDir renders suite in every format in formatNames and
+// writes each to dir/baseName.<ext> (e.g. "out/my-agent.xml"), creating dir
+// if needed. Used by `meta --output-dir` so a suite run produces one file
+// per agent rather than one shared --output prefix.
+func writeReportOutputsToDir(suite ReportSuite, formatNames []string, dir, baseName string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating output dir %s: %w", dir, err)
+	}
+
+	for _, format := range formatNames {
+		rendered, err := renderReportSuite(suite, format)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("%s.%s", baseName, formats.Extension(format)))
+
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return fmt.Errorf("writing %s output: %w", format, err)
+		}
+		_, err = io.WriteString(f, rendered)
+		closeErr := f.Close()
+		if err != nil {
+			return fmt.Errorf("writing %s output: %w", format, err)
+		}
+		if closeErr != nil {
+			return fmt.Errorf("writing %s output: %w", format, closeErr)
+		}
+		fmt.Printf("Report (%s) written to: %s\n", format, path)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestWriteReportToSink verifies writeReportToSink works with a plain
+// in-memory io.Writer, not just a path on disk -- the whole point of
+// ReportSink being an io.Writer alias rather than a file path.
+func TestWriteReportToSink(t *testing.T) {
+	suite := ReportSuite{
+		Name: "skill-clarity",
+		Cases: []ReportCase{
+			{ID: "test-skill", Name: "test-skill", Passed: true, Message: "looks good"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := writeReportToSink(suite, "json", &buf); err != nil {
+		t.Fatalf("writeReportToSink: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "test-skill") {
+		t.Errorf("sink contents missing case name, got: %s", buf.String())
+	}
+}
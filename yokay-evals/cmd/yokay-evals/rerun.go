@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// rerunReasons are the allowed values for `meta --rerun`.
+var rerunReasons = map[string]bool{
+	"failed":     true,
+	"new":        true,
+	"exceptions": true,
+	"all":        true,
+}
+
+// parseRerunReasons validates the --rerun values against rerunReasons and
+// returns them as a set. An empty values slice means no filtering: every
+// test case runs, matching the command's pre-rerun behavior.
+func parseRerunReasons(values []string) (map[string]bool, error) {
+	reasons := make(map[string]bool, len(values))
+	for _, v := range values {
+		if !rerunReasons[v] {
+			return nil, fmt.Errorf("invalid --rerun value %q (must be one of: failed, new, exceptions, all)", v)
+		}
+		reasons[v] = true
+	}
+	return reasons, nil
+}
+
+// lastRunEntry is the persisted outcome of a single agent/test-case pair.
+type lastRunEntry struct {
+	Verdict     string    `json:"verdict"`
+	K           int       `json:"k"`
+	Timestamp   time.Time `json:"timestamp"`
+	Consistency float64   `json:"consistency"`
+}
+
+// lastRunState is the contents of <meta-dir>/.pokayokay/last-run.json: the
+// most recent outcome of every agent/test-case pair `meta` has executed,
+// keyed by lastRunKey. It lets `meta --rerun` skip cases that already
+// passed instead of re-running an entire suite.
+type lastRunState struct {
+	Runs map[string]lastRunEntry `json:"runs"`
+}
+
+// lastRunKey identifies a test case's entry in lastRunState.
+func lastRunKey(agent, testID string) string {
+	return agent + "/" + testID
+}
+
+// lastRunStatePath returns the path to the persisted rerun state for a
+// given meta directory.
+func lastRunStatePath(metaDir string) string {
+	return filepath.Join(metaDir, ".pokayokay", "last-run.json")
+}
+
+// loadLastRunState reads <meta-dir>/.pokayokay/last-run.json. A missing
+// file is not an error: it returns an empty state, which callers treat as
+// "no prior run" for --rerun's fallback-to-everything behavior.
+func loadLastRunState(metaDir string) (*lastRunState, error) {
+	data, err := os.ReadFile(lastRunStatePath(metaDir))
+	if os.IsNotExist(err) {
+		return &lastRunState{Runs: map[string]lastRunEntry{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading last-run state: %w", err)
+	}
+
+	var state lastRunState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parsing last-run state: %w", err)
+	}
+	if state.Runs == nil {
+		state.Runs = map[string]lastRunEntry{}
+	}
+	return &state, nil
+}
+
+// save writes the state to <meta-dir>/.pokayokay/last-run.json, creating
+// the .pokayokay directory if needed.
+func (s *lastRunState) save(metaDir string) error {
+	path := lastRunStatePath(metaDir)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating .pokayokay dir: %w", err)
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling last-run state: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing last-run state: %w", err)
+	}
+	return nil
+}
+
+// shouldExecuteCase decides whether tc should actually run given the
+// --rerun reasons selected and the prior outcome (if any) recorded in
+// state. fallback is true when no prior state exists at all, in which
+// case every case executes regardless of reasons.
+func shouldExecuteCase(reasons map[string]bool, fallback bool, agent string, tc TestCase, state *lastRunState) bool {
+	if len(reasons) == 0 || fallback || reasons["all"] {
+		return true
+	}
+
+	entry, ok := state.Runs[lastRunKey(agent, tc.ID)]
+	if !ok {
+		// No prior result to carry over, so there is nothing to skip to;
+		// run it even if "new" wasn't explicitly requested.
+		return true
+	}
+
+	if reasons["failed"] && entry.Verdict != tc.Expected {
+		return true
+	}
+	if reasons["exceptions"] && entry.Verdict == "" {
+		return true
+	}
+	return false
+}
+
+// recordExecuted updates state with the outcome of every executed (i.e.
+// non-skipped) result for agent. Skipped results are left untouched so
+// their carried-over history isn't overwritten by a run that never
+// actually happened.
+func (s *lastRunState) recordExecuted(agent string, results []TestResult, now time.Time) {
+	for _, tr := range results {
+		if tr.Skipped {
+			continue
+		}
+		s.Runs[lastRunKey(agent, tr.TestID)] = lastRunEntry{
+			Verdict:     getMajorityVerdict(tr.Runs),
+			K:           len(tr.Runs),
+			Timestamp:   now,
+			Consistency: consistencyRatio(tr.Runs),
+		}
+	}
+}
+
+// consistencyRatio returns the fraction of runs that agree with the
+// majority verdict (1.0 when all runs agree, 0 for an empty Runs slice).
+func consistencyRatio(runs []string) float64 {
+	if len(runs) == 0 {
+		return 0
+	}
+	majority := getMajorityVerdict(runs)
+	agree := 0
+	for _, v := range runs {
+		if v == majority {
+			agree++
+		}
+	}
+	return float64(agree) / float64(len(runs))
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseRerunReasons(t *testing.T) {
+	reasons, err := parseRerunReasons([]string{"failed", "new"})
+	if err != nil {
+		t.Fatalf("parseRerunReasons failed: %v", err)
+	}
+	if !reasons["failed"] || !reasons["new"] || reasons["all"] {
+		t.Errorf("parseRerunReasons(%v) = %v, want {failed,new}", []string{"failed", "new"}, reasons)
+	}
+
+	if _, err := parseRerunReasons([]string{"bogus"}); err == nil {
+		t.Error("parseRerunReasons([\"bogus\"]): expected error, got nil")
+	}
+
+	empty, err := parseRerunReasons(nil)
+	if err != nil || len(empty) != 0 {
+		t.Errorf("parseRerunReasons(nil) = %v, %v, want empty set, nil error", empty, err)
+	}
+}
+
+func TestLoadLastRunStateMissingFile(t *testing.T) {
+	state, err := loadLastRunState(t.TempDir())
+	if err != nil {
+		t.Fatalf("loadLastRunState failed: %v", err)
+	}
+	if len(state.Runs) != 0 {
+		t.Errorf("expected empty state for missing file, got %v", state.Runs)
+	}
+}
+
+func TestLastRunStateSaveAndLoad(t *testing.T) {
+	metaDir := t.TempDir()
+
+	state := &lastRunState{Runs: map[string]lastRunEntry{}}
+	state.Runs[lastRunKey("my-agent", "TST-001")] = lastRunEntry{
+		Verdict:     "PASS",
+		K:           3,
+		Timestamp:   time.Now(),
+		Consistency: 1.0,
+	}
+
+	if err := state.save(metaDir); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	if _, err := loadLastRunState(metaDir); err != nil {
+		t.Fatalf("loadLastRunState failed: %v", err)
+	}
+
+	path := filepath.Join(metaDir, ".pokayokay", "last-run.json")
+	reloaded, err := loadLastRunState(filepath.Dir(filepath.Dir(path)))
+	if err != nil {
+		t.Fatalf("loadLastRunState(reload) failed: %v", err)
+	}
+	entry, ok := reloaded.Runs[lastRunKey("my-agent", "TST-001")]
+	if !ok {
+		t.Fatalf("expected entry for my-agent/TST-001, got %v", reloaded.Runs)
+	}
+	if entry.Verdict != "PASS" || entry.K != 3 {
+		t.Errorf("reloaded entry = %+v, want verdict=PASS k=3", entry)
+	}
+}
+
+func TestShouldExecuteCase(t *testing.T) {
+	state := &lastRunState{Runs: map[string]lastRunEntry{
+		lastRunKey("agent-a", "TST-PASS"): {Verdict: "PASS"},
+		lastRunKey("agent-a", "TST-FAIL"): {Verdict: "FAIL"},
+		lastRunKey("agent-a", "TST-EXC"):  {Verdict: ""},
+	}}
+
+	cases := []struct {
+		name    string
+		reasons []string
+		tc      TestCase
+		want    bool
+	}{
+		{"no filter runs everything", nil, TestCase{ID: "TST-PASS", Expected: "PASS"}, true},
+		{"failed: skips a case that already passed", []string{"failed"}, TestCase{ID: "TST-PASS", Expected: "PASS"}, false},
+		{"failed: runs a case that previously failed", []string{"failed"}, TestCase{ID: "TST-FAIL", Expected: "PASS"}, true},
+		{"new: skips a known-passing case", []string{"new"}, TestCase{ID: "TST-PASS", Expected: "PASS"}, false},
+		{"new: runs a case with no prior entry", []string{"new"}, TestCase{ID: "TST-UNKNOWN", Expected: "PASS"}, true},
+		{"exceptions: runs a case that errored last time", []string{"exceptions"}, TestCase{ID: "TST-EXC", Expected: "PASS"}, true},
+		{"exceptions: skips a clean pass", []string{"exceptions"}, TestCase{ID: "TST-PASS", Expected: "PASS"}, false},
+		{"all: always runs", []string{"all"}, TestCase{ID: "TST-PASS", Expected: "PASS"}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			reasons, err := parseRerunReasons(c.reasons)
+			if err != nil {
+				t.Fatalf("parseRerunReasons failed: %v", err)
+			}
+			got := shouldExecuteCase(reasons, false, "agent-a", c.tc, state)
+			if got != c.want {
+				t.Errorf("shouldExecuteCase(%v, %v) = %v, want %v", c.reasons, c.tc.ID, got, c.want)
+			}
+		})
+	}
+}
+
+func TestShouldExecuteCaseFallback(t *testing.T) {
+	state := &lastRunState{Runs: map[string]lastRunEntry{}}
+	reasons, _ := parseRerunReasons([]string{"failed"})
+
+	if !shouldExecuteCase(reasons, true, "agent-a", TestCase{ID: "TST-001"}, state) {
+		t.Error("shouldExecuteCase with fallback=true should always run the case")
+	}
+}
+
+func TestRecordExecutedSkipsSkippedResults(t *testing.T) {
+	state := &lastRunState{Runs: map[string]lastRunEntry{}}
+	now := time.Now()
+
+	results := []TestResult{
+		{TestID: "TST-001", Expected: "PASS", Runs: []string{"PASS", "PASS"}},
+		{TestID: "TST-002", Expected: "PASS", Skipped: true, CarriedOverVerdict: "FAIL"},
+	}
+
+	state.recordExecuted("agent-a", results, now)
+
+	if _, ok := state.Runs[lastRunKey("agent-a", "TST-002")]; ok {
+		t.Error("recordExecuted should not add an entry for a skipped result")
+	}
+
+	entry, ok := state.Runs[lastRunKey("agent-a", "TST-001")]
+	if !ok {
+		t.Fatal("expected an entry for the executed result TST-001")
+	}
+	if entry.Verdict != "PASS" || entry.K != 2 || entry.Consistency != 1.0 {
+		t.Errorf("recordExecuted entry = %+v, want verdict=PASS k=2 consistency=1.0", entry)
+	}
+}
+
+func TestRecordExecutedTiedVerdictIsDeterministic(t *testing.T) {
+	now := time.Now()
+	results := []TestResult{
+		{TestID: "TST-001", Expected: "PASS", Runs: []string{"PASS", "FAIL"}},
+	}
+
+	for i := 0; i < 10; i++ {
+		state := &lastRunState{Runs: map[string]lastRunEntry{}}
+		state.recordExecuted("agent-a", results, now)
+
+		entry := state.Runs[lastRunKey("agent-a", "TST-001")]
+		if entry.Verdict != "FAIL" {
+			t.Fatalf("recordExecuted on a tied [PASS,FAIL] run = %q, want the deterministic tie-break winner %q", entry.Verdict, "FAIL")
+		}
+	}
+}
+
+func TestConsistencyRatio(t *testing.T) {
+	if got := consistencyRatio(nil); got != 0 {
+		t.Errorf("consistencyRatio(nil) = %v, want 0", got)
+	}
+	if got := consistencyRatio([]string{"PASS", "PASS", "FAIL"}); got != float64(2)/3 {
+		t.Errorf("consistencyRatio([PASS,PASS,FAIL]) = %v, want 2/3", got)
+	}
+	if got := consistencyRatio([]string{"PASS", "PASS"}); got != 1.0 {
+		t.Errorf("consistencyRatio([PASS,PASS]) = %v, want 1.0", got)
+	}
+}
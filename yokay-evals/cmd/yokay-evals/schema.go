@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	invopopschema "github.com/invopop/jsonschema"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// EvalConfigSchemaID is the canonical URL an eval.yaml references via
+// `# yaml-language-server: $schema=<EvalConfigSchemaID>` for editor
+// autocomplete and inline errors.
+const EvalConfigSchemaID = "https://github.com/stevestomp/yokay-evals/schema/eval-config.json"
+
+var (
+	evalConfigSchemaJSONOnce sync.Once
+	evalConfigSchemaJSON     []byte
+)
+
+// EvalConfigSchema returns the JSON Schema document describing eval.yaml's
+// shape, generated from EvalConfig/TestCase/TaskInput's struct tags. The
+// result is cached after the first call, since the schema only changes
+// when those structs do.
+func EvalConfigSchema() []byte {
+	evalConfigSchemaJSONOnce.Do(func() {
+		reflector := &invopopschema.Reflector{}
+		schema := reflector.Reflect(&EvalConfig{})
+		schema.ID = invopopschema.ID(EvalConfigSchemaID)
+
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			panic(fmt.Sprintf("marshaling generated eval config schema: %v", err))
+		}
+		evalConfigSchemaJSON = data
+	})
+	return evalConfigSchemaJSON
+}
+
+var (
+	compiledEvalConfigSchemaOnce sync.Once
+	compiledEvalConfigSchema     *jsonschema.Schema
+	compiledEvalConfigSchemaErr  error
+)
+
+// compileEvalConfigSchema compiles EvalConfigSchema once and caches the
+// result for ValidateEvalConfigJSON.
+func compileEvalConfigSchema() (*jsonschema.Schema, error) {
+	compiledEvalConfigSchemaOnce.Do(func() {
+		compiler := jsonschema.NewCompiler()
+		if err := compiler.AddResource(EvalConfigSchemaID, bytes.NewReader(EvalConfigSchema())); err != nil {
+			compiledEvalConfigSchemaErr = fmt.Errorf("adding eval config schema resource: %w", err)
+			return
+		}
+		compiledEvalConfigSchema, compiledEvalConfigSchemaErr = compiler.Compile(EvalConfigSchemaID)
+	})
+	return compiledEvalConfigSchema, compiledEvalConfigSchemaErr
+}
+
+// ValidateEvalConfigJSON validates raw (the literal bytes of an eval.yaml
+// file, in YAML or JSON) against EvalConfigSchema before any struct-level
+// ValidateEvalConfig checks run. This catches a typo like `taks_title`
+// as an unrecognized property, instead of letting it unmarshal silently
+// to a zero value and then trip a required-field check with a misleading
+// message.
+func ValidateEvalConfigJSON(raw []byte) error {
+	var doc any
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return fmt.Errorf("parsing eval config: %w", err)
+	}
+
+	// Round-trip through encoding/json so map keys, numbers, and nil
+	// slices match what the jsonschema validator expects to see.
+	jsonBytes, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("converting eval config to JSON: %w", err)
+	}
+
+	var jsonDoc any
+	if err := json.Unmarshal(jsonBytes, &jsonDoc); err != nil {
+		return fmt.Errorf("decoding eval config as JSON: %w", err)
+	}
+
+	schema, err := compileEvalConfigSchema()
+	if err != nil {
+		return fmt.Errorf("compiling eval config schema: %w", err)
+	}
+
+	if err := schema.Validate(jsonDoc); err != nil {
+		return fmt.Errorf("eval config does not match schema: %w", err)
+	}
+
+	return nil
+}
+
+// newSchemaCmd builds the `schema` subcommand, which prints EvalConfigSchema
+// to stdout for users to save alongside their eval.yaml files and reference
+// via `# yaml-language-server: $schema=...`.
+func newSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the eval.yaml JSON Schema",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := cmd.OutOrStdout().Write(append(EvalConfigSchema(), '\n'))
+			return err
+		},
+	}
+}
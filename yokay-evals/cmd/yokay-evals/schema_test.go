@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEvalConfigSchemaIsValidJSON(t *testing.T) {
+	raw := EvalConfigSchema()
+
+	var doc map[string]any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("EvalConfigSchema() is not valid JSON: %v", err)
+	}
+
+	if doc["$id"] != EvalConfigSchemaID {
+		t.Errorf("schema $id = %v, want %q", doc["$id"], EvalConfigSchemaID)
+	}
+
+	for _, want := range []string{"agent", "consistency_threshold", "test_cases"} {
+		if !strings.Contains(string(raw), `"`+want+`"`) {
+			t.Errorf("schema missing expected property %q:\n%s", want, raw)
+		}
+	}
+}
+
+func TestValidateEvalConfigJSONAcceptsValidConfig(t *testing.T) {
+	valid := `
+agent: yokay-test
+consistency_threshold: 0.9
+test_cases:
+  - id: BR-001
+    name: Test case
+    input:
+      task_title: Title
+      task_description: Desc
+    expected: PASS
+    k: 3
+    rationale: Reason
+`
+	if err := ValidateEvalConfigJSON([]byte(valid)); err != nil {
+		t.Errorf("expected a well-formed eval config to pass schema validation, got: %v", err)
+	}
+}
+
+func TestValidateEvalConfigJSONRejectsUnknownField(t *testing.T) {
+	typoed := `
+agent: yokay-test
+consistency_threshold: 0.9
+test_cases:
+  - id: BR-001
+    name: Test case
+    input:
+      taks_title: Title
+      task_description: Desc
+    expected: PASS
+    rationale: Reason
+`
+	if err := ValidateEvalConfigJSON([]byte(typoed)); err == nil {
+		t.Error("expected a typoed field name (taks_title) to fail schema validation")
+	}
+}
+
+func TestValidateEvalConfigJSONRejectsWrongType(t *testing.T) {
+	wrongType := `
+agent: yokay-test
+consistency_threshold: "not a number"
+test_cases: []
+`
+	if err := ValidateEvalConfigJSON([]byte(wrongType)); err == nil {
+		t.Error("expected a string consistency_threshold to fail schema validation")
+	}
+}
+
+func TestValidateEvalConfigJSONRejectsMalformedYAML(t *testing.T) {
+	if err := ValidateEvalConfigJSON([]byte("agent: [unterminated")); err == nil {
+		t.Error("expected malformed YAML to fail with a parse error")
+	}
+}
@@ -0,0 +1,343 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+	"github.com/stevestomp/yokay-evals/internal/graders/plugin"
+)
+
+// newServeCmd builds the `serve` subcommand, which runs an HTTP server
+// exposing the same grading and eval logic as grade-skills/eval as
+// endpoints, so editors, pre-commit hooks, and CI/PR bots can call into
+// yokay-evals without shelling out to the binary per request.
+func newServeCmd() *cobra.Command {
+	var addr, failuresDir, reportsDir, graderDir string
+	var graderName, model string
+	var temperature float64
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run an HTTP server exposing /grade, /eval, and /reports/{name}",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fdir := failuresDir
+			if fdir == "" {
+				fdir = defaultFailuresDir()
+			}
+			rdir := reportsDir
+			if rdir == "" {
+				rdir = defaultReportsDir()
+			}
+			gdir := graderDir
+			if gdir == "" {
+				gdir = defaultYokayEvalsSubdir("graders")
+			}
+
+			opts, err := llmOptionsFromFlags(graderName, model, temperature)
+			if err != nil {
+				return err
+			}
+
+			srv, err := newServer(fdir, rdir, gdir, opts...)
+			if err != nil {
+				return fmt.Errorf("starting server: %w", err)
+			}
+
+			fmt.Printf("yokay-evals serve listening on %s (failures=%s reports=%s graders=%s)\n", addr, fdir, rdir, gdir)
+			return http.ListenAndServe(addr, srv.mux())
+		},
+	}
+
+	cmd.Flags().StringVar(&addr, "addr", ":8080", "Address to listen on")
+	cmd.Flags().StringVar(&failuresDir, "failures-dir", "", "Path to failures directory (default: yokay-evals/failures)")
+	cmd.Flags().StringVar(&reportsDir, "reports-dir", "", "Path to reports directory (default: reports/)")
+	cmd.Flags().StringVar(&graderDir, "grader-dir", "", "Directory to discover grader-* plugin executables in (default: yokay-evals/graders/)")
+	cmd.Flags().StringVar(&graderName, "grader", "heuristic", "Skill-clarity grading backend: 'heuristic' (default, offline), 'openai', 'anthropic', 'ollama'")
+	cmd.Flags().StringVar(&model, "model", "", "Model name to pass to --grader (default: the provider's own default)")
+	cmd.Flags().Float64Var(&temperature, "temperature", 0, "Sampling temperature to pass to --grader")
+
+	return cmd
+}
+
+// server holds the state shared across requests: the directories to
+// read failure cases/reports from, the discovered grader plugins (see
+// internal/graders/plugin), the built-in skill-clarity grader, and the
+// in-memory metrics these requests feed.
+type server struct {
+	failuresDir string
+	reportsDir  string
+	graders     []*plugin.Grader
+	clarity     *modelbased.SkillClarityGrader
+	metrics     *graderMetrics
+}
+
+// newServer discovers grader plugins under graderDir once at startup;
+// handleGrade reuses the same discovered set for every request rather
+// than re-scanning the directory each time.
+func newServer(failuresDir, reportsDir, graderDir string, graderOpts ...modelbased.Option) (*server, error) {
+	allowlist, err := plugin.LoadAllowlist(filepath.Join(graderDir, "graders.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("loading graders allowlist: %w", err)
+	}
+	graders, err := plugin.Discover(graderDir, allowlist)
+	if err != nil {
+		return nil, fmt.Errorf("discovering grader plugins: %w", err)
+	}
+
+	return &server{
+		failuresDir: failuresDir,
+		reportsDir:  reportsDir,
+		graders:     graders,
+		clarity:     modelbased.NewSkillClarityGrader(graderOpts...),
+		metrics:     newGraderMetrics(),
+	}, nil
+}
+
+func (s *server) mux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.handleHealthz)
+	mux.HandleFunc("/metrics", s.handleMetrics)
+	mux.HandleFunc("/grade", s.handleGrade)
+	mux.HandleFunc("/eval", s.handleEval)
+	mux.HandleFunc("/reports/", s.handleReport)
+	return mux
+}
+
+func (s *server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]string{"status": "ok"})
+}
+
+// gradeRequestBody is the JSON body POST /grade accepts: the same
+// {kind, content, context} shape modelbased.GradeInput and the grader
+// plugin protocol (internal/graders/plugin.GradeRequest) already use.
+type gradeRequestBody struct {
+	Kind    string         `json:"kind"`
+	Content string         `json:"content"`
+	Context map[string]any `json:"context"`
+}
+
+// handleGrade runs the built-in SkillClarityGrader (for kind "skill")
+// plus every discovered grader-* plugin that supports kind, merges the
+// results the same way gradeSkillsWithFormats does, and returns the
+// merged modelbased.Result as JSON.
+func (s *server) handleGrade(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req gradeRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("decoding request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if req.Kind == "" {
+		req.Kind = "skill"
+	}
+
+	start := time.Now()
+
+	var builtin modelbased.Result
+	if req.Kind == "skill" {
+		var err error
+		builtin, err = s.clarity.Grade(modelbased.GradeInput{Content: req.Content, Context: req.Context, Kind: req.Kind})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("grading: %v", err), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	plugins := gradeWithPlugins(s.graders, req.Kind, req.Content, req.Context)
+	result := mergeGraderResults(builtin, plugins)
+	latency := time.Since(start)
+
+	s.metrics.record(req.Kind, result.Score, result.Passed, latency)
+	for _, pg := range plugins {
+		s.metrics.record(pg.name, pg.resp.Score, pg.resp.Passed, latency)
+	}
+
+	writeJSON(w, result)
+}
+
+// evalRequestBody is the JSON body POST /eval accepts.
+type evalRequestBody struct {
+	Category string `json:"category"`
+	K        int    `json:"k"`
+}
+
+// handleEval runs every failure case matching req.Category (all
+// categories if empty) req.K times, the same way `eval` does, and
+// returns the same document formatEvalSummaryJSON renders for --format
+// json.
+func (s *server) handleEval(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req evalRequestBody
+	if r.Body != nil {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("decoding request: %v", err), http.StatusBadRequest)
+			return
+		}
+	}
+	if req.K <= 0 {
+		req.K = 1
+	}
+
+	cases, err := findFailureCases(s.failuresDir, req.Category)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("finding failure cases: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	results := make([]EvalResult, 0, len(cases))
+	for _, fc := range cases {
+		result, err := runEvaluation(fc, req.K, 1)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("running %s: %v", fc.ID, err), http.StatusInternalServerError)
+			return
+		}
+		results = append(results, result)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, formatEvalSummaryJSON(results))
+}
+
+// handleReport streams a single stored report by name (e.g.
+// skill-clarity-2024-01-01.md). Reports live in a flat directory (see
+// findGradeReports), so filepath.Base strips any path separators in
+// name rather than allowing it to escape reportsDir.
+func (s *server) handleReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := filepath.Base(r.URL.Path[len("/reports/"):])
+	if name == "" || name == "." || name == "/" {
+		http.Error(w, "report name required", http.StatusBadRequest)
+		return
+	}
+
+	http.ServeFile(w, r, filepath.Join(s.reportsDir, name))
+}
+
+// graderStat accumulates /metrics counters for a single grader (the
+// built-in clarity grader, identified by kind, or a named plugin).
+type graderStat struct {
+	count             int
+	passCount         int
+	scoreSum          float64
+	scoreBuckets      map[string]int
+	latencySumSeconds float64
+}
+
+// graderMetrics is the process-lifetime metrics store /metrics renders.
+// There's no persistence across restarts, matching the rest of
+// yokay-evals' reports (which are files on disk, not a database).
+type graderMetrics struct {
+	mu    sync.Mutex
+	stats map[string]*graderStat
+}
+
+func newGraderMetrics() *graderMetrics {
+	return &graderMetrics{stats: make(map[string]*graderStat)}
+}
+
+// scoreBand buckets a 0-100 score into the same three bands
+// internal/report/formats' SARIF renderer uses for level banding, so
+// the two places a score turns into a category agree.
+func scoreBand(score float64) string {
+	switch {
+	case score < 50:
+		return "0_49"
+	case score < 80:
+		return "50_79"
+	default:
+		return "80_100"
+	}
+}
+
+func (m *graderMetrics) record(name string, score float64, passed bool, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	st, ok := m.stats[name]
+	if !ok {
+		st = &graderStat{scoreBuckets: make(map[string]int)}
+		m.stats[name] = st
+	}
+	st.count++
+	if passed {
+		st.passCount++
+	}
+	st.scoreSum += score
+	st.scoreBuckets[scoreBand(score)]++
+	st.latencySumSeconds += latency.Seconds()
+}
+
+// handleMetrics renders /metrics in the Prometheus text exposition
+// format: per-grader request count, pass rate, cumulative latency, and
+// a 3-bucket score histogram.
+func (s *server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	s.metrics.mu.Lock()
+	defer s.metrics.mu.Unlock()
+
+	names := make([]string, 0, len(s.metrics.stats))
+	for name := range s.metrics.stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "# HELP yokay_evals_grader_requests_total Total grade requests handled per grader")
+	fmt.Fprintln(w, "# TYPE yokay_evals_grader_requests_total counter")
+	for _, name := range names {
+		fmt.Fprintf(w, "yokay_evals_grader_requests_total{grader=%q} %d\n", name, s.metrics.stats[name].count)
+	}
+
+	fmt.Fprintln(w, "# HELP yokay_evals_grader_pass_rate Fraction of graded requests that passed")
+	fmt.Fprintln(w, "# TYPE yokay_evals_grader_pass_rate gauge")
+	for _, name := range names {
+		st := s.metrics.stats[name]
+		rate := 0.0
+		if st.count > 0 {
+			rate = float64(st.passCount) / float64(st.count)
+		}
+		fmt.Fprintf(w, "yokay_evals_grader_pass_rate{grader=%q} %.4f\n", name, rate)
+	}
+
+	fmt.Fprintln(w, "# HELP yokay_evals_grader_latency_seconds_sum Cumulative grading latency per grader")
+	fmt.Fprintln(w, "# TYPE yokay_evals_grader_latency_seconds_sum counter")
+	for _, name := range names {
+		fmt.Fprintf(w, "yokay_evals_grader_latency_seconds_sum{grader=%q} %.6f\n", name, s.metrics.stats[name].latencySumSeconds)
+	}
+
+	fmt.Fprintln(w, "# HELP yokay_evals_grader_score_bucket Count of graded requests by score band")
+	fmt.Fprintln(w, "# TYPE yokay_evals_grader_score_bucket counter")
+	for _, name := range names {
+		st := s.metrics.stats[name]
+		for _, band := range []string{"0_49", "50_79", "80_100"} {
+			fmt.Fprintf(w, "yokay_evals_grader_score_bucket{grader=%q,band=%q} %d\n", name, band, st.scoreBuckets[band])
+		}
+	}
+}
+
+// writeJSON encodes v as the response body with a JSON content type.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, fmt.Sprintf("encoding response: %v", err), http.StatusInternalServerError)
+	}
+}
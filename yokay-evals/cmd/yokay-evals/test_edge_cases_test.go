@@ -1,10 +1,13 @@
 package main
 
 import (
+	"encoding/xml"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/stevestomp/yokay-evals/internal/reportfs"
 )
 
 // TestRunReportCommandNoReports tests error handling when no reports exist
@@ -16,7 +19,7 @@ func TestRunReportCommandNoReports(t *testing.T) {
 		t.Fatalf("Failed to create test directory: %v", err)
 	}
 
-	err = runReportCommand("grade", "markdown", false, "", reportsDir)
+	err = runReportCommand("grade", "markdown", false, "", reportsDir, false, -1, 0)
 	if err == nil {
 		t.Fatalf("Expected error when no reports found, got nil")
 	}
@@ -48,7 +51,7 @@ Generated: 2026-01-26 21:30:43
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
-	err = runReportCommand("grade", "xml", false, "", reportsDir)
+	err = runReportCommand("grade", "yaml", false, "", reportsDir, false, -1, 0)
 	if err == nil {
 		t.Fatalf("Expected error for unsupported format, got nil")
 	}
@@ -80,7 +83,7 @@ Generated: 2026-01-26 21:30:43
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
-	err = runReportCommand("evaluation", "markdown", false, "", reportsDir)
+	err = runReportCommand("bogus-type", "markdown", false, "", reportsDir, false, -1, 0)
 	if err == nil {
 		t.Fatalf("Expected error for unsupported report type, got nil")
 	}
@@ -95,7 +98,7 @@ func TestFindGradeReportsDirectoryError(t *testing.T) {
 	nonExistentDir := filepath.Join(t.TempDir(), "does-not-exist")
 
 	// Test: Try to find reports in non-existent directory
-	reports, err := findGradeReports(nonExistentDir)
+	reports, err := findGradeReports(reportfs.OSFS(nonExistentDir))
 
 	// Verify: Should return error
 	if err == nil {
@@ -110,9 +113,7 @@ func TestFindGradeReportsDirectoryError(t *testing.T) {
 
 // TestParseGradeReportInvalidContent tests behavior with invalid report content
 func TestParseGradeReportInvalidContent(t *testing.T) {
-	// Create a temporary report file with invalid content (missing fields)
-	tmpDir := t.TempDir()
-	reportPath := filepath.Join(tmpDir, "skill-clarity-2026-01-26.md")
+	fsys := reportfs.NewMemFS()
 
 	// Create report with missing required fields
 	reportContent := `# Some Random Document
@@ -120,13 +121,10 @@ func TestParseGradeReportInvalidContent(t *testing.T) {
 This is not a valid skill clarity report.
 It doesn't have the expected structure.
 `
-	err := os.WriteFile(reportPath, []byte(reportContent), 0644)
-	if err != nil {
-		t.Fatalf("Failed to create test report: %v", err)
-	}
+	fsys.WriteFile("skill-clarity-2026-01-26.md", reportContent)
 
 	// Test: Parse the invalid report
-	report, err := parseGradeReport(reportPath)
+	report, err := parseGradeReport(fsys, "skill-clarity-2026-01-26.md")
 
 	// Verify: Should not return an error (parseGradeReport is lenient)
 	// but the report should have zero values for missing fields
@@ -150,11 +148,8 @@ It doesn't have the expected structure.
 
 // TestParseGradeReportFileNotFound tests error handling when report file doesn't exist
 func TestParseGradeReportFileNotFound(t *testing.T) {
-	// Use a non-existent file path
-	nonExistentPath := filepath.Join(t.TempDir(), "does-not-exist.md")
-
-	// Test: Try to parse non-existent file
-	_, err := parseGradeReport(nonExistentPath)
+	// Test: Try to parse a file that was never written to the MemFS
+	_, err := parseGradeReport(reportfs.NewMemFS(), "does-not-exist.md")
 
 	// Verify: Should return error
 	if err == nil {
@@ -192,7 +187,7 @@ Generated: 2026-01-26 21:30:43
 
 	// Test: Run report command with output file
 	outputFile := filepath.Join(tmpDir, "output.md")
-	err = runReportCommand("grade", "markdown", false, outputFile, reportsDir)
+	err = runReportCommand("grade", "markdown", false, outputFile, reportsDir, false, -1, 0)
 	if err != nil {
 		t.Fatalf("runReportCommand with output file failed: %v", err)
 	}
@@ -238,7 +233,7 @@ func TestRunReportCommandListModeWithOutputFile(t *testing.T) {
 
 	// Test: Run report command in list mode with output file
 	outputFile := filepath.Join(tmpDir, "list.md")
-	err = runReportCommand("grade", "markdown", true, outputFile, reportsDir)
+	err = runReportCommand("grade", "markdown", true, outputFile, reportsDir, false, -1, 0)
 	if err != nil {
 		t.Fatalf("runReportCommand list mode with output file failed: %v", err)
 	}
@@ -286,7 +281,7 @@ Generated: 2026-01-26 21:30:43
 
 	// Test: Run report command with JSON format and output file
 	outputFile := filepath.Join(tmpDir, "output.json")
-	err = runReportCommand("grade", "json", false, outputFile, reportsDir)
+	err = runReportCommand("grade", "json", false, outputFile, reportsDir, false, -1, 0)
 	if err != nil {
 		t.Fatalf("runReportCommand with JSON format failed: %v", err)
 	}
@@ -306,3 +301,67 @@ Generated: 2026-01-26 21:30:43
 		t.Error("Output doesn't appear to be JSON")
 	}
 }
+
+// TestRunReportCommandXMLFormat tests the JUnit XML output format
+func TestRunReportCommandXMLFormat(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportsDir := filepath.Join(tmpDir, "reports")
+	err := os.MkdirAll(reportsDir, 0755)
+	if err != nil {
+		t.Fatalf("Failed to create test directory: %v", err)
+	}
+
+	reportPath := filepath.Join(reportsDir, "skill-clarity-2026-01-26.md")
+	reportContent := `# Skill Clarity Report
+Generated: 2026-01-26 21:30:43
+## Summary
+- **Total Skills**: 2
+- **Average Score**: 75.0/100
+- **Pass Rate**: 50.0% (1/2)
+- **Passing Threshold**: 70.0
+
+## Skills by Score
+
+| Rank | Skill | Score | Status |
+|------|-------|-------|--------|
+| 1 | ux-design | 92.0 | ✅ Pass |
+| 2 | documentation | 58.0 | ❌ Fail |
+`
+	err = os.WriteFile(reportPath, []byte(reportContent), 0644)
+	if err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	outputFile := filepath.Join(tmpDir, "output.xml")
+	err = runReportCommand("grade", "xml", false, outputFile, reportsDir, false, -1, 0)
+	if err != nil {
+		t.Fatalf("runReportCommand with XML format failed: %v", err)
+	}
+
+	content, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+
+	var doc junitTestSuites
+	if err := xml.Unmarshal(content, &doc); err != nil {
+		t.Fatalf("Output is not valid JUnit XML: %v", err)
+	}
+
+	if len(doc.Suites) != 1 {
+		t.Fatalf("Expected 1 testsuite, got %d", len(doc.Suites))
+	}
+	suite := doc.Suites[0]
+	if suite.Tests != 2 || suite.Failures != 1 || suite.Passed != 1 {
+		t.Errorf("suite = %+v, want Tests=2 Failures=1 Passed=1", suite)
+	}
+	if len(suite.TestCases) != 2 {
+		t.Fatalf("Expected 2 testcases, got %d", len(suite.TestCases))
+	}
+	if suite.TestCases[0].Failure != nil {
+		t.Errorf("ux-design testcase should not have a failure, got %+v", suite.TestCases[0].Failure)
+	}
+	if suite.TestCases[1].Failure == nil {
+		t.Error("documentation testcase should have a failure, got none")
+	}
+}
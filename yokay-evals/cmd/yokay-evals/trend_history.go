@@ -0,0 +1,308 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stevestomp/yokay-evals/internal/history"
+	"github.com/stevestomp/yokay-evals/internal/reportfs"
+)
+
+// newReportTrendCmd builds the `report trend` subcommand. Unlike
+// `report --trend` (which re-parses every skill-clarity-*.md report on
+// each run, see runTrendReportCommand), this reads reports/index.jsonl
+// via internal/history, migrating it from the existing markdown reports
+// first if the index doesn't cover them yet, and flags regressions.
+func newReportTrendCmd() *cobra.Command {
+	var reportsDir, format, outputFile string
+	var regressionDelta float64
+	var failOnRegression bool
+	var sparklineRuns int
+
+	cmd := &cobra.Command{
+		Use:   "trend",
+		Short: "Show historical per-skill/criterion trend from reports/index.jsonl, flagging regressions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := reportsDir
+			if dir == "" {
+				dir = defaultReportsDir()
+			}
+
+			if err := runHistoryTrendCommand(dir, format, outputFile, regressionDelta, failOnRegression, sparklineRuns); err != nil {
+				return fmt.Errorf("Failed to run report trend command: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&reportsDir, "reports-dir", "", "Path to reports directory (default: reports/)")
+	cmd.Flags().StringVar(&format, "format", "markdown", "Output format: 'markdown' or 'json'")
+	cmd.Flags().StringVar(&outputFile, "output", "", "Write output to file instead of stdout")
+	cmd.Flags().Float64Var(&regressionDelta, "regression-delta", 5.0, "Flag a skill as regressed if its score drops by more than this many points between the two newest runs")
+	cmd.Flags().BoolVar(&failOnRegression, "fail-on-regression", false, "Exit non-zero if any regression is detected")
+	cmd.Flags().IntVar(&sparklineRuns, "sparkline-runs", 10, "Number of most recent runs to show in each regression's sparkline")
+
+	return cmd
+}
+
+// regression is one skill whose score dropped enough between the two
+// newest history.Records to be worth flagging.
+type regression struct {
+	Skill       string    `json:"skill"`
+	Previous    float64   `json:"previous"`
+	Latest      float64   `json:"latest"`
+	Delta       float64   `json:"delta"`
+	CrossedDown []float64 `json:"crossedDown,omitempty"`
+	Sparkline   string    `json:"sparkline"`
+	CommitLog   []string  `json:"commitLog,omitempty"`
+}
+
+// thresholdCrossings are the score bands skill-clarity reports already
+// call out (see formatGradeReportMarkdown's "Below Threshold" and "Pass
+// (Low)" bands): 70 is the hard passing threshold, 80 is the "needs
+// improvement" warning line. Dropping below either, even by less than
+// regressionDelta, is worth flagging on its own.
+var thresholdCrossings = []float64{80.0, 70.0}
+
+// detectHistoryRegressions compares the two newest records' per-skill
+// scores and flags any skill whose score dropped by more than delta
+// points, or that crossed a threshold in thresholdCrossings downward.
+func detectHistoryRegressions(records []history.Record, delta float64, sparklineRuns int) []regression {
+	if len(records) < 2 {
+		return nil
+	}
+
+	previous, latest := records[len(records)-2], records[len(records)-1]
+	prevScores := make(map[string]float64, len(previous.Skills))
+	for _, s := range previous.Skills {
+		prevScores[s.Name] = s.Score
+	}
+
+	window := records
+	if len(window) > sparklineRuns {
+		window = window[len(window)-sparklineRuns:]
+	}
+	seriesByName := make(map[string][]float64)
+	for _, rec := range window {
+		for _, s := range rec.Skills {
+			seriesByName[s.Name] = append(seriesByName[s.Name], s.Score)
+		}
+	}
+
+	var regressions []regression
+	for _, s := range latest.Skills {
+		prev, ok := prevScores[s.Name]
+		if !ok {
+			continue
+		}
+
+		drop := prev - s.Score
+		var crossed []float64
+		for _, t := range thresholdCrossings {
+			if prev >= t && s.Score < t {
+				crossed = append(crossed, t)
+			}
+		}
+
+		if drop <= delta && len(crossed) == 0 {
+			continue
+		}
+
+		regressions = append(regressions, regression{
+			Skill:       s.Name,
+			Previous:    prev,
+			Latest:      s.Score,
+			Delta:       drop,
+			CrossedDown: crossed,
+			Sparkline:   sparkline(seriesByName[s.Name]),
+			CommitLog:   skillCommitLog(s.Name, previous.Date, latest.Date),
+		})
+	}
+
+	sort.Slice(regressions, func(i, j int) bool { return regressions[i].Delta > regressions[j].Delta })
+	return regressions
+}
+
+// skillCommitLog runs `git log` over the skill's SKILL.md path (guessed
+// from the repo's `skills/<name>/SKILL.md` convention, same as
+// gradeReportToSuite) between the two report dates, so a regression
+// links straight to the commits that likely caused it. Returns nil
+// (silently) when git isn't available or the path has no history in
+// range - this is a best-effort convenience, not worth failing a report
+// over.
+func skillCommitLog(skillName, since, until string) []string {
+	path := filepath.Join("skills", skillName, "SKILL.md")
+	cmd := exec.Command("git", "log", "--oneline",
+		fmt.Sprintf("--since=%s", since),
+		fmt.Sprintf("--until=%s 23:59:59", until),
+		"--", path)
+	out, err := cmd.Output()
+	if err != nil || len(out) == 0 {
+		return nil
+	}
+
+	return strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+}
+
+// formatHistoryTrendMarkdown renders records as a trend table (mirroring
+// formatTrendMarkdown's shape) plus a "Regressions" section for any
+// skill detectHistoryRegressions flagged.
+func formatHistoryTrendMarkdown(records []history.Record, regressions []regression) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Grade Report Trend (history)\n\n")
+	sb.WriteString("| Date | Total Skills | Avg Score | Pass Rate | Δ vs previous |\n")
+	sb.WriteString("|------|--------------|-----------|-----------|---------------|\n")
+	for i, rec := range records {
+		delta := "—"
+		if i > 0 {
+			delta = fmt.Sprintf("%+.1f", rec.AverageScore-records[i-1].AverageScore)
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %d | %.1f | %.1f%% | %s |\n", rec.Date, rec.TotalSkills, rec.AverageScore, rec.PassRate, delta))
+	}
+
+	if len(regressions) == 0 {
+		return sb.String()
+	}
+
+	sb.WriteString("\n## Regressions\n\n")
+	for _, r := range regressions {
+		sb.WriteString(fmt.Sprintf("### %s\n\n", r.Skill))
+		sb.WriteString(fmt.Sprintf("- **Score**: %.1f -> %.1f (%+.1f)\n", r.Previous, r.Latest, -r.Delta))
+		if len(r.CrossedDown) > 0 {
+			thresholds := make([]string, len(r.CrossedDown))
+			for i, t := range r.CrossedDown {
+				thresholds[i] = fmt.Sprintf("%.0f", t)
+			}
+			sb.WriteString(fmt.Sprintf("- **Crossed threshold(s)**: %s\n", strings.Join(thresholds, ", ")))
+		}
+		sb.WriteString(fmt.Sprintf("- **Recent scores**: %s\n", r.Sparkline))
+		if len(r.CommitLog) > 0 {
+			sb.WriteString("- **Likely commits**:\n")
+			for _, line := range r.CommitLog {
+				sb.WriteString(fmt.Sprintf("  - %s\n", line))
+			}
+		} else {
+			sb.WriteString("- **Likely commits**: none found (or git history unavailable)\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// formatHistoryTrendJSON renders records and regressions as a single
+// JSON document for CI consumption.
+func formatHistoryTrendJSON(records []history.Record, regressions []regression) (string, error) {
+	data := map[string]any{
+		"records":     records,
+		"regressions": regressions,
+	}
+	bytes, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling to JSON: %w", err)
+	}
+	return string(bytes), nil
+}
+
+// runHistoryTrendCommand implements `report trend`: it reads
+// reports/index.jsonl (migrating it from existing skill-clarity-*.md
+// reports first if the index doesn't cover them all yet), flags
+// per-skill regressions, and renders the result as markdown or JSON.
+func runHistoryTrendCommand(reportsDir, format, outputPath string, delta float64, failOnRegression bool, sparklineRuns int) error {
+	indexPath := filepath.Join(reportsDir, "index.jsonl")
+
+	records, err := history.ReadIndex(indexPath)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := parseGradeReportsChronological(reportfs.OSFS(reportsDir))
+	if err != nil {
+		return err
+	}
+	if len(parsed) > len(records) {
+		if err := history.Migrate(indexPath, recordsFromGradeReports(parsed)); err != nil {
+			return fmt.Errorf("migrating history index: %w", err)
+		}
+		if records, err = history.ReadIndex(indexPath); err != nil {
+			return err
+		}
+	}
+
+	if len(records) == 0 {
+		return fmt.Errorf("no grade report history found in %s", reportsDir)
+	}
+
+	regressions := detectHistoryRegressions(records, delta, sparklineRuns)
+
+	var output string
+	switch format {
+	case "json":
+		output, err = formatHistoryTrendJSON(records, regressions)
+		if err != nil {
+			return err
+		}
+	case "markdown":
+		output = formatHistoryTrendMarkdown(records, regressions)
+	default:
+		return fmt.Errorf("unsupported format: %s (use 'markdown' or 'json')", format)
+	}
+
+	if outputPath != "" {
+		if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
+			return fmt.Errorf("writing output file: %w", err)
+		}
+		fmt.Printf("Report written to: %s\n", outputPath)
+	} else {
+		fmt.Print(output)
+	}
+
+	if failOnRegression && len(regressions) > 0 {
+		names := make([]string, len(regressions))
+		for i, r := range regressions {
+			names[i] = r.Skill
+		}
+		return fmt.Errorf("regression detected in: %s", strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+// recordsFromGradeReports converts parseGradeReportsChronological's
+// GradeReport slice into history.Records for Migrate, reusing the same
+// per-criterion averages each report already computed.
+func recordsFromGradeReports(reports []GradeReport) []history.Record {
+	records := make([]history.Record, 0, len(reports))
+	for _, report := range reports {
+		skills := make([]history.SkillPoint, 0, len(report.Skills))
+		for _, s := range report.Skills {
+			skills = append(skills, history.SkillPoint{Name: s.Name, Score: s.Score, Passed: s.Passed})
+		}
+		criteria := make([]history.CriterionPoint, 0, len(report.CriteriaScores))
+		for _, c := range report.CriteriaScores {
+			criteria = append(criteria, history.CriterionPoint{Name: c.Name, Average: c.Average})
+		}
+
+		date := report.GeneratedDate
+		if idx := strings.IndexByte(date, ' '); idx >= 0 {
+			date = date[:idx]
+		}
+
+		records = append(records, history.Record{
+			Date:         date,
+			TotalSkills:  report.TotalSkills,
+			AverageScore: report.AverageScore,
+			PassRate:     report.PassRate,
+			Skills:       skills,
+			Criteria:     criteria,
+		})
+	}
+	return records
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	agentNamePattern = regexp.MustCompile(`^yokay-[a-z-]+$`)
+	testIDPattern    = regexp.MustCompile(`^[A-Z]{2,3}-\d{3}$`)
+)
+
+// ValidationError is a single eval.yaml validation failure. TestCaseID is
+// empty for suite-level failures (e.g. a missing agent). Rule optionally
+// names the constraint that failed (e.g. "k_range"), for callers that want
+// to filter or dedupe by rule rather than by Message text.
+type ValidationError struct {
+	TestCaseID string
+	Path       string
+	Message    string
+	Rule       string
+}
+
+// Error returns Message. ValidateEvalConfig already bakes any "test case
+// X:" prefix into Message itself, so callers checking err.Error() for a
+// substring see exactly what they did before this type existed.
+func (e ValidationError) Error() string {
+	return e.Message
+}
+
+// ValidationErrors is every ValidationError a single ValidateEvalConfig
+// call collected. Its Error() joins every message with a newline, so
+// existing callers that only check err.Error() for a substring keep
+// working unchanged.
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// AsValidationErrors unwraps err into its []ValidationError, for callers
+// that want to inspect individual failures (TestCaseID, Path, Rule)
+// instead of just the joined message. The second return is false for any
+// other error, including nil.
+func AsValidationErrors(err error) ([]ValidationError, bool) {
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		return nil, false
+	}
+	return errs, true
+}
+
+// ValidateEvalConfig walks the whole config and collects every violation
+// (missing fields, out-of-range values, malformed IDs) instead of
+// stopping at the first one, so a single run can report problems with
+// test case 2, test case 5, and test case 7 all at once. It validates
+// against DefaultPolicy(); call ValidateEvalConfigWithPolicy directly to
+// enforce an org-specific ValidationPolicy instead. Returns nil when
+// config is valid, or a non-empty ValidationErrors otherwise.
+func ValidateEvalConfig(config *EvalConfig) error {
+	return ValidateEvalConfigWithPolicy(config, DefaultPolicy())
+}
+
+// ValidateEvalConfigWithPolicy is ValidateEvalConfig with an explicit
+// ValidationPolicy governing K bounds, consistency-threshold bounds, and
+// (optionally) which Expected verdicts each agent may use.
+func ValidateEvalConfigWithPolicy(config *EvalConfig, policy ValidationPolicy) error {
+	var errs ValidationErrors
+
+	if config.Agent == "" {
+		errs = append(errs, ValidationError{
+			Path:    "agent",
+			Message: "agent is required",
+			Rule:    "required",
+		})
+	} else if !agentNamePattern.MatchString(config.Agent) {
+		errs = append(errs, ValidationError{
+			Path:    "agent",
+			Message: "agent name must match pattern ^yokay-[a-z-]+$",
+			Rule:    "pattern",
+		})
+	}
+
+	if config.ConsistencyThreshold < policy.MinConsistencyThreshold || config.ConsistencyThreshold > policy.MaxConsistencyThreshold {
+		errs = append(errs, ValidationError{
+			Path:    "consistency_threshold",
+			Message: fmt.Sprintf("consistency_threshold must be between %.1f and %.1f", policy.MinConsistencyThreshold, policy.MaxConsistencyThreshold),
+			Rule:    "range",
+		})
+	}
+
+	if len(config.TestCases) == 0 {
+		errs = append(errs, ValidationError{
+			Path:    "test_cases",
+			Message: "test_cases must contain at least 1 test case",
+			Rule:    "required",
+		})
+	}
+
+	for i, tc := range config.TestCases {
+		errs = append(errs, validateTestCase(config.Agent, i, tc, policy)...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validateTestCase validates the test case at index in its parent
+// test_cases list, returning one ValidationError per violation. Input and
+// the agent-agnostic part of Expected are delegated to agent's registered
+// AgentValidator (see agent_validator.go); policy.AllowedExpectedValues,
+// when set for agent, further restricts which Expected verdicts pass.
+func validateTestCase(agent string, index int, tc TestCase, policy ValidationPolicy) ValidationErrors {
+	var errs ValidationErrors
+
+	path := func(suffix string) string {
+		return fmt.Sprintf("test_cases[%d]%s", index, suffix)
+	}
+
+	if tc.ID == "" || !testIDPattern.MatchString(tc.ID) {
+		errs = append(errs, ValidationError{
+			TestCaseID: tc.ID,
+			Path:       path(".id"),
+			Message:    fmt.Sprintf("test case ID '%s' must match pattern ^[A-Z]{2,3}-\\d{3}$", tc.ID),
+			Rule:       "pattern",
+		})
+	}
+
+	if tc.Name == "" {
+		errs = append(errs, ValidationError{
+			TestCaseID: tc.ID,
+			Path:       path(".name"),
+			Message:    fmt.Sprintf("test case %s: name is required", tc.ID),
+			Rule:       "required",
+		})
+	}
+
+	validator := agentValidatorFor(agent)
+
+	if err := validator.ValidateExpected(tc.Expected); err != nil {
+		errs = append(errs, ValidationError{
+			TestCaseID: tc.ID,
+			Path:       path(".expected"),
+			Message:    fmt.Sprintf("test case %s: %s", tc.ID, err),
+			Rule:       "agent_validator",
+		})
+	} else if allowed, ok := policy.AllowedExpectedValues[agent]; ok && !contains(allowed, tc.Expected) {
+		errs = append(errs, ValidationError{
+			TestCaseID: tc.ID,
+			Path:       path(".expected"),
+			Message:    fmt.Sprintf("test case %s: expected %q is not one of the values policy allows for %s: %s", tc.ID, tc.Expected, agent, strings.Join(allowed, ", ")),
+			Rule:       "policy_allowed_expected",
+		})
+	}
+
+	if tc.K != 0 && (tc.K < policy.MinK || tc.K > policy.MaxK) {
+		errs = append(errs, ValidationError{
+			TestCaseID: tc.ID,
+			Path:       path(".k"),
+			Message:    fmt.Sprintf("test case %s: k must be between %d and %d (or 0 for default)", tc.ID, policy.MinK, policy.MaxK),
+			Rule:       "range",
+		})
+	}
+
+	if err := validator.ValidateInput(tc.Input); err != nil {
+		errs = append(errs, ValidationError{
+			TestCaseID: tc.ID,
+			Path:       path(".input"),
+			Message:    fmt.Sprintf("test case %s: %s", tc.ID, err),
+			Rule:       "agent_validator",
+		})
+	}
+
+	if tc.Rationale == "" {
+		errs = append(errs, ValidationError{
+			TestCaseID: tc.ID,
+			Path:       path(".rationale"),
+			Message:    fmt.Sprintf("test case %s: rationale is required", tc.ID),
+			Rule:       "required",
+		})
+	}
+
+	return errs
+}
+
+func contains(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
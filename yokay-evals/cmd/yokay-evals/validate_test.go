@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateEvalConfigAggregatesAllErrors(t *testing.T) {
+	config := EvalConfig{
+		Agent:                "yokay-test",
+		ConsistencyThreshold: 0.95,
+		TestCases: []TestCase{
+			{
+				ID:   "BR-001",
+				Name: "Test case",
+				Input: TaskInput{
+					TaskTitle:       "Test",
+					TaskDescription: "Desc",
+				},
+				Expected:  "PASS",
+				Rationale: "",
+			},
+			{
+				ID:   "BR-002",
+				Name: "Test case",
+				Input: TaskInput{
+					TaskTitle:       "Test",
+					TaskDescription: "Desc",
+				},
+				Expected:  "PASS",
+				K:         150,
+				Rationale: "Reason",
+			},
+			{
+				ID:   "BR-003",
+				Name: "",
+				Input: TaskInput{
+					TaskTitle:       "Test",
+					TaskDescription: "Desc",
+				},
+				Expected:  "PASS",
+				Rationale: "Reason",
+			},
+		},
+	}
+
+	err := ValidateEvalConfig(&config)
+	if err == nil {
+		t.Fatal("expected a validation error, got nil")
+	}
+
+	errs, ok := AsValidationErrors(err)
+	if !ok {
+		t.Fatalf("expected AsValidationErrors to unwrap %v", err)
+	}
+	if len(errs) != 3 {
+		t.Fatalf("expected one error per invalid test case, got %d: %v", len(errs), errs)
+	}
+
+	for _, want := range []string{
+		"test case BR-001: rationale is required",
+		"test case BR-002: k must be between 1 and 100 (or 0 for default)",
+		"test case BR-003: name is required",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("err.Error() = %q, want it to contain %q", err.Error(), want)
+		}
+	}
+}
+
+func TestAsValidationErrorsRejectsOtherErrors(t *testing.T) {
+	if _, ok := AsValidationErrors(nil); ok {
+		t.Error("expected AsValidationErrors(nil) to return ok=false")
+	}
+	if _, ok := AsValidationErrors(errors.New("some other error")); ok {
+		t.Error("expected a non-ValidationErrors error to return ok=false")
+	}
+}
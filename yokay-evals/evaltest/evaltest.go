@@ -0,0 +1,169 @@
+// Package evaltest provides declarative test helpers for asserting on the
+// outcome of a yokay-evals-style config validator, mirroring the govytest
+// pattern. yokay-evals' own ValidateEvalConfig (and the ValidationError it
+// collects) live in cmd/yokay-evals, an unimportable main package, so these
+// helpers inspect any error value structurally instead of requiring a
+// concrete imported type: an error is treated as a validation failure if it
+// (or, for an aggregated multi-error, each element of it) exposes
+// TestCaseID, Path, Message, and Rule string fields. A downstream user's
+// own AgentValidator errors, or yokay-evals' own ValidationError/
+// ValidationErrors, both satisfy this shape without either package
+// depending on the other.
+package evaltest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidationError describes one validation failure to look for.
+// RuleContains is matched as a substring against the actual failure's
+// Rule field; leave it empty to match any rule.
+type ValidationError struct {
+	TestCaseID   string
+	Path         string
+	RuleContains string
+}
+
+// fieldError is evaltest's reflective view of a single validation
+// failure, regardless of which package defined its concrete type.
+type fieldError struct {
+	TestCaseID, Path, Message, Rule string
+}
+
+// extractFieldErrors reflects err (or, if err is a slice, each of its
+// elements) into a fieldError. ok is false if err is non-nil but some
+// element doesn't expose the expected string fields, meaning evaltest
+// can't make sense of it.
+func extractFieldErrors(err error) (errs []fieldError, ok bool) {
+	if err == nil {
+		return nil, true
+	}
+
+	v := reflect.ValueOf(err)
+	if v.Kind() == reflect.Slice {
+		errs = make([]fieldError, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			fe, ok := structToFieldError(v.Index(i))
+			if !ok {
+				return nil, false
+			}
+			errs = append(errs, fe)
+		}
+		return errs, true
+	}
+
+	fe, ok := structToFieldError(v)
+	if !ok {
+		return nil, false
+	}
+	return []fieldError{fe}, true
+}
+
+func structToFieldError(v reflect.Value) (fieldError, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return fieldError{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fieldError{}, false
+	}
+
+	var fe fieldError
+	for _, field := range []struct {
+		name string
+		dest *string
+	}{
+		{"TestCaseID", &fe.TestCaseID},
+		{"Path", &fe.Path},
+		{"Message", &fe.Message},
+		{"Rule", &fe.Rule},
+	} {
+		f := v.FieldByName(field.name)
+		if !f.IsValid() || f.Kind() != reflect.String {
+			return fieldError{}, false
+		}
+		*field.dest = f.String()
+	}
+	return fe, true
+}
+
+func formatFieldErrors(fes []fieldError) string {
+	if len(fes) == 0 {
+		return "  (no validation errors)\n"
+	}
+	var sb strings.Builder
+	for _, fe := range fes {
+		fmt.Fprintf(&sb, "  test case %s, path %s: %s (rule=%s)\n", fe.TestCaseID, fe.Path, fe.Message, fe.Rule)
+	}
+	return sb.String()
+}
+
+// TestingT is the subset of *testing.T the Assert* helpers need. It
+// exists so those helpers' own tests can drive the failing path with a
+// fake instead of a real *testing.T, which would report a failing
+// subtest to `go test` regardless of whether the helper behaved
+// correctly.
+type TestingT interface {
+	Helper()
+	Fatalf(format string, args ...interface{})
+}
+
+// AssertValidConfig fails the test if err (the result of running a
+// config through its validator) is non-nil, pretty-printing every
+// underlying failure it can extract.
+func AssertValidConfig(t TestingT, err error) {
+	t.Helper()
+	if err == nil {
+		return
+	}
+
+	if fes, ok := extractFieldErrors(err); ok {
+		t.Fatalf("expected no validation errors, got %d:\n%s", len(fes), formatFieldErrors(fes))
+		return
+	}
+	t.Fatalf("expected no validation errors, got: %v", err)
+}
+
+// AssertValidationError fails the test unless err (a single validation
+// failure or an aggregated multi-error) contains one matching want's
+// TestCaseID, Path, and RuleContains.
+func AssertValidationError(t TestingT, err error, want ValidationError) {
+	t.Helper()
+
+	fes, ok := extractFieldErrors(err)
+	if !ok {
+		t.Fatalf("could not inspect %T as a validation error (want a struct, or slice of structs, exposing TestCaseID/Path/Message/Rule string fields)", err)
+		return
+	}
+
+	for _, fe := range fes {
+		if fe.TestCaseID == want.TestCaseID && fe.Path == want.Path && strings.Contains(fe.Rule, want.RuleContains) {
+			return
+		}
+	}
+
+	t.Fatalf("expected a validation error matching %+v, actual:\n%s", want, formatFieldErrors(fes))
+}
+
+// AssertNoValidationError fails the test if err contains a failure for
+// the given testCaseID and path, regardless of rule or message.
+func AssertNoValidationError(t TestingT, err error, testCaseID, path string) {
+	t.Helper()
+
+	fes, ok := extractFieldErrors(err)
+	if !ok {
+		t.Fatalf("could not inspect %T as a validation error (want a struct, or slice of structs, exposing TestCaseID/Path/Message/Rule string fields)", err)
+		return
+	}
+
+	for _, fe := range fes {
+		if fe.TestCaseID == testCaseID && fe.Path == path {
+			t.Fatalf("expected no validation error for test case %q path %q, actual:\n%s", testCaseID, path, formatFieldErrors(fes))
+			return
+		}
+	}
+}
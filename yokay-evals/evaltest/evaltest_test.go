@@ -0,0 +1,126 @@
+package evaltest
+
+import (
+	"fmt"
+	"testing"
+)
+
+// fakeT is a minimal TestingT that records a would-be failure instead
+// of acting on it, so tests can assert on whether an Assert* helper
+// would have failed without the failure itself being reported as a
+// real failing test.
+type fakeT struct {
+	failed  bool
+	calls   int
+	message string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Fatalf(format string, args ...interface{}) {
+	f.failed = true
+	f.calls++
+	f.message = fmt.Sprintf(format, args...)
+}
+
+// fakeValidationError and fakeValidationErrors stand in for a downstream
+// package's own validator error shape (e.g. cmd/yokay-evals's
+// ValidationError/ValidationErrors, which evaltest can't import since
+// it's a main package) — any type exposing these four string fields
+// works with evaltest's helpers.
+type fakeValidationError struct {
+	TestCaseID string
+	Path       string
+	Message    string
+	Rule       string
+}
+
+func (fakeValidationError) Error() string { return "fake validation error" }
+
+type fakeValidationErrors []fakeValidationError
+
+func (errs fakeValidationErrors) Error() string { return "fake validation errors" }
+
+func TestAssertValidConfigPassesOnNilError(t *testing.T) {
+	AssertValidConfig(t, nil)
+}
+
+func TestAssertValidConfigFailsOnError(t *testing.T) {
+	err := fakeValidationErrors{{TestCaseID: "BR-001", Path: "input.task_title", Message: "required", Rule: "required"}}
+
+	ft := &fakeT{}
+	AssertValidConfig(ft, err)
+	if !ft.failed {
+		t.Error("expected AssertValidConfig to fail the test when err is non-nil")
+	}
+	if ft.calls != 1 {
+		t.Errorf("expected exactly 1 Fatalf call (a TestingT isn't guaranteed to abort on Fatalf the way *testing.T does), got %d", ft.calls)
+	}
+}
+
+func TestAssertValidationErrorFindsMatch(t *testing.T) {
+	err := fakeValidationErrors{
+		{TestCaseID: "BR-001", Path: "input.task_title", Message: "input.task_title is required", Rule: "required"},
+		{TestCaseID: "BR-002", Path: "k", Message: "k must be between 1 and 100", Rule: "range"},
+	}
+
+	AssertValidationError(t, err, ValidationError{
+		TestCaseID:   "BR-001",
+		Path:         "input.task_title",
+		RuleContains: "requir",
+	})
+}
+
+func TestAssertValidationErrorFailsWithoutMatch(t *testing.T) {
+	err := fakeValidationErrors{
+		{TestCaseID: "BR-001", Path: "input.task_title", Message: "required", Rule: "required"},
+	}
+
+	ft := &fakeT{}
+	AssertValidationError(ft, err, ValidationError{TestCaseID: "BR-002", Path: "k", RuleContains: "range"})
+	if !ft.failed {
+		t.Error("expected AssertValidationError to fail when no error matches")
+	}
+	if ft.calls != 1 {
+		t.Errorf("expected exactly 1 Fatalf call, got %d", ft.calls)
+	}
+}
+
+func TestAssertValidationErrorWorksOnSingleError(t *testing.T) {
+	err := fakeValidationError{TestCaseID: "BR-001", Path: "rationale", Message: "rationale is required", Rule: "required"}
+
+	AssertValidationError(t, err, ValidationError{TestCaseID: "BR-001", Path: "rationale"})
+}
+
+func TestAssertNoValidationErrorPasses(t *testing.T) {
+	err := fakeValidationErrors{{TestCaseID: "BR-001", Path: "input.task_title", Message: "required", Rule: "required"}}
+	AssertNoValidationError(t, err, "BR-002", "k")
+}
+
+func TestAssertNoValidationErrorFailsOnMatch(t *testing.T) {
+	err := fakeValidationErrors{{TestCaseID: "BR-001", Path: "input.task_title", Message: "required", Rule: "required"}}
+
+	ft := &fakeT{}
+	AssertNoValidationError(ft, err, "BR-001", "input.task_title")
+	if !ft.failed {
+		t.Error("expected AssertNoValidationError to fail when a matching error is present")
+	}
+	if ft.calls != 1 {
+		t.Errorf("expected exactly 1 Fatalf call, got %d", ft.calls)
+	}
+}
+
+func TestAssertValidationErrorOnUnrecognizedErrorType(t *testing.T) {
+	ft := &fakeT{}
+	AssertValidationError(ft, errUnrecognized{}, ValidationError{TestCaseID: "BR-001"})
+	if !ft.failed {
+		t.Error("expected AssertValidationError to fail when err doesn't expose the expected fields")
+	}
+	if ft.calls != 1 {
+		t.Errorf("expected exactly 1 Fatalf call, got %d", ft.calls)
+	}
+}
+
+type errUnrecognized struct{}
+
+func (errUnrecognized) Error() string { return "not a field error" }
@@ -0,0 +1,81 @@
+// Package adapters lets the meta eval command hand test cases to a real
+// agent instead of only exercising the harness with a stubbed verdict.
+// It is modelled on planr's build/evaluate split: Build prepares whatever
+// the adapter needs once per eval.yaml run, and Evaluate executes a single
+// test case k times.
+package adapters
+
+import (
+	"context"
+	"fmt"
+)
+
+// Case is the subset of a meta eval.yaml test case an Adapter needs to run
+// an agent and judge its verdict.
+type Case struct {
+	ID       string
+	Name     string
+	Input    any
+	Expected string
+	// Seed is a caller-assigned deterministic seed for this particular
+	// run (e.g. derived from the case ID and run index), for adapters
+	// whose underlying agent accepts one for reproducibility. Zero means
+	// the caller didn't request a specific seed.
+	Seed int64
+}
+
+// RunResult is the outcome of a single run of a Case against an agent.
+type RunResult struct {
+	// Verdict is the agent's PASS/FAIL verdict for this run.
+	Verdict string
+	// Output is the raw output the adapter parsed Verdict from, kept for
+	// debugging a run that produced an unexpected verdict.
+	Output string
+	// Err is set when the run itself failed (e.g. the subprocess couldn't
+	// start, the HTTP request errored) rather than simply disagreeing with
+	// the expected verdict.
+	Err error
+	// ExitCode is the subprocess exit code, for adapters that run one
+	// (ExecAdapter). Zero for adapters with no concept of an exit code
+	// (StubAdapter, HTTPAdapter) or when the process exited cleanly.
+	ExitCode int
+	// Stderr is the subprocess's captured standard error, for adapters
+	// that run one (ExecAdapter). Empty for adapters with no concept of
+	// stderr.
+	Stderr string
+}
+
+// Adapter executes meta eval test cases against an agent.
+type Adapter interface {
+	// Build prepares the adapter for the given cases (e.g. validating
+	// config, warming a connection). It is called once per eval.yaml run.
+	Build(cases []Case) error
+	// Evaluate runs case c k times and returns one RunResult per run.
+	Evaluate(ctx context.Context, c Case, k int) []RunResult
+}
+
+// Factory constructs an Adapter from its eval.yaml adapter_config block.
+type Factory func(config map[string]any) (Adapter, error)
+
+// registry maps an eval.yaml `adapter:` name to its Factory.
+var registry = map[string]Factory{
+	"stub": newStubAdapterFromConfig,
+	"exec": NewExecAdapter,
+	"http": NewHTTPAdapter,
+}
+
+// New builds the named adapter with the given config block. An empty name
+// defaults to "stub", preserving the harness's original stubbed behavior
+// for eval.yaml files that don't opt into a real adapter.
+func New(name string, config map[string]any) (Adapter, error) {
+	if name == "" {
+		name = "stub"
+	}
+
+	factory, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown adapter: %s", name)
+	}
+
+	return factory(config)
+}
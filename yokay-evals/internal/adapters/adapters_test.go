@@ -0,0 +1,158 @@
+package adapters
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewDefaultsToStub(t *testing.T) {
+	a, err := New("", nil)
+	if err != nil {
+		t.Fatalf("New(\"\", nil) error = %v", err)
+	}
+	if _, ok := a.(*StubAdapter); !ok {
+		t.Errorf("New(\"\", nil) = %T, want *StubAdapter", a)
+	}
+}
+
+func TestNewUnknownAdapter(t *testing.T) {
+	if _, err := New("bogus", nil); err == nil {
+		t.Error("New(\"bogus\", nil): expected error, got nil")
+	}
+}
+
+func TestStubAdapterEvaluate(t *testing.T) {
+	a := NewStubAdapter()
+	results := a.Evaluate(context.Background(), Case{ID: "TC-001", Expected: "PASS"}, 3)
+	if len(results) != 3 {
+		t.Fatalf("Evaluate() returned %d results, want 3", len(results))
+	}
+	for _, r := range results {
+		if r.Verdict != "PASS" {
+			t.Errorf("Evaluate() verdict = %q, want %q", r.Verdict, "PASS")
+		}
+	}
+}
+
+func TestNewExecAdapterRequiresCommand(t *testing.T) {
+	if _, err := NewExecAdapter(map[string]any{}); err == nil {
+		t.Error("NewExecAdapter(no command): expected error, got nil")
+	}
+}
+
+func TestNewHTTPAdapterRequiresEndpoint(t *testing.T) {
+	if _, err := NewHTTPAdapter(map[string]any{}); err == nil {
+		t.Error("NewHTTPAdapter(no endpoint): expected error, got nil")
+	}
+}
+
+func TestParseVerdict(t *testing.T) {
+	cases := []struct {
+		output string
+		want   string
+	}{
+		{"result: PASS\n", "PASS"},
+		{"result: FAIL\n", "FAIL"},
+		{"no verdict here\n", ""},
+	}
+
+	for _, c := range cases {
+		if got := parseVerdict(c.output); got != c.want {
+			t.Errorf("parseVerdict(%q) = %q, want %q", c.output, got, c.want)
+		}
+	}
+}
+
+func TestParseVerdictEnvelope(t *testing.T) {
+	cases := []struct {
+		output string
+		want   string
+	}{
+		{`{"verdict":"PASS","reasoning":"looks good","tool_calls":[]}`, "PASS"},
+		{"result: FAIL\n", "FAIL"},
+		{"no verdict here\n", ""},
+	}
+
+	for _, c := range cases {
+		if got := parseVerdictEnvelope(c.output); got != c.want {
+			t.Errorf("parseVerdictEnvelope(%q) = %q, want %q", c.output, got, c.want)
+		}
+	}
+}
+
+// writeScript creates an executable shell script in t.TempDir() so
+// ExecAdapter tests can exercise a real subprocess without depending on
+// anything outside the repo.
+func writeScript(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "agent.sh")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body), 0755); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestExecAdapterEvaluateParsesEnvelopeAndCapturesStderr(t *testing.T) {
+	script := writeScript(t, "cat >/dev/null\necho 'warning: noisy' >&2\necho '{\"verdict\":\"PASS\",\"reasoning\":\"ok\"}'\n")
+
+	a, err := NewExecAdapter(map[string]any{"command": script})
+	if err != nil {
+		t.Fatalf("NewExecAdapter() error = %v", err)
+	}
+
+	results := a.Evaluate(context.Background(), Case{ID: "TC-001", Expected: "PASS"}, 1)
+	if len(results) != 1 {
+		t.Fatalf("Evaluate() returned %d results, want 1", len(results))
+	}
+	r := results[0]
+	if r.Verdict != "PASS" {
+		t.Errorf("Verdict = %q, want PASS", r.Verdict)
+	}
+	if r.ExitCode != 0 {
+		t.Errorf("ExitCode = %d, want 0", r.ExitCode)
+	}
+	if !strings.Contains(r.Stderr, "warning: noisy") {
+		t.Errorf("Stderr = %q, want it to contain %q", r.Stderr, "warning: noisy")
+	}
+}
+
+func TestExecAdapterEvaluateReportsNonZeroExitCode(t *testing.T) {
+	script := writeScript(t, "cat >/dev/null\nexit 7\n")
+
+	a, err := NewExecAdapter(map[string]any{"command": script})
+	if err != nil {
+		t.Fatalf("NewExecAdapter() error = %v", err)
+	}
+
+	results := a.Evaluate(context.Background(), Case{ID: "TC-001", Expected: "PASS"}, 1)
+	if len(results) != 1 {
+		t.Fatalf("Evaluate() returned %d results, want 1", len(results))
+	}
+	r := results[0]
+	if r.Err == nil {
+		t.Error("Err = nil, want non-nil for a non-zero exit")
+	}
+	if r.ExitCode != 7 {
+		t.Errorf("ExitCode = %d, want 7", r.ExitCode)
+	}
+}
+
+func TestExecAdapterEvaluateExportsSeedAsEnvVar(t *testing.T) {
+	script := writeScript(t, "cat >/dev/null\necho \"{\\\"verdict\\\":\\\"PASS\\\",\\\"reasoning\\\":\\\"$YOKAY_SEED\\\"}\"\n")
+
+	a, err := NewExecAdapter(map[string]any{"command": script})
+	if err != nil {
+		t.Fatalf("NewExecAdapter() error = %v", err)
+	}
+
+	results := a.Evaluate(context.Background(), Case{ID: "TC-001", Expected: "PASS", Seed: 42}, 1)
+	if len(results) != 1 {
+		t.Fatalf("Evaluate() returned %d results, want 1", len(results))
+	}
+	if got, want := results[0].Output, "42"; !strings.Contains(got, want) {
+		t.Errorf("Output = %q, want it to contain YOKAY_SEED value %q", got, want)
+	}
+}
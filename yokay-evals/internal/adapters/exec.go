@@ -0,0 +1,153 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// ExecAdapter runs a configurable subprocess once per run, passing the
+// Case as JSON on stdin and parsing a PASS/FAIL verdict from stdout.
+type ExecAdapter struct {
+	command string
+	args    []string
+}
+
+// NewExecAdapter builds an ExecAdapter from an eval.yaml adapter_config
+// block shaped like:
+//
+//	adapter: exec
+//	adapter_config:
+//	  command: ./run-agent.sh
+//	  args: ["--mode", "judge"]
+func NewExecAdapter(config map[string]any) (Adapter, error) {
+	command, _ := config["command"].(string)
+	if command == "" {
+		return nil, fmt.Errorf("exec adapter requires a \"command\" in adapter_config")
+	}
+
+	var args []string
+	if raw, ok := config["args"].([]any); ok {
+		for _, a := range raw {
+			if s, ok := a.(string); ok {
+				args = append(args, s)
+			}
+		}
+	}
+
+	return &ExecAdapter{command: command, args: args}, nil
+}
+
+// Build validates the configured command can be found on PATH.
+func (a *ExecAdapter) Build(cases []Case) error {
+	if _, err := exec.LookPath(a.command); err != nil {
+		return fmt.Errorf("exec adapter command %q not found: %w", a.command, err)
+	}
+	return nil
+}
+
+// Evaluate runs the configured command k times, feeding c as JSON on
+// stdin and parsing PASS/FAIL from its stdout. c.Seed is also exported as
+// YOKAY_SEED (even when zero) so a runner script can read it without
+// parsing the stdin JSON.
+func (a *ExecAdapter) Evaluate(ctx context.Context, c Case, k int) []RunResult {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		results := make([]RunResult, k)
+		for i := range results {
+			results[i] = RunResult{Err: fmt.Errorf("marshaling case: %w", err)}
+		}
+		return results
+	}
+
+	results := make([]RunResult, k)
+	for i := 0; i < k; i++ {
+		cmd := exec.CommandContext(ctx, a.command, a.args...)
+		cmd.Stdin = bytes.NewReader(payload)
+		cmd.Env = append(os.Environ(), "YOKAY_SEED="+strconv.FormatInt(c.Seed, 10))
+
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		runErr := cmd.Run()
+		exitCode := exitCodeOf(runErr)
+		if runErr != nil {
+			results[i] = RunResult{
+				Output:   stdout.String(),
+				Stderr:   stderr.String(),
+				ExitCode: exitCode,
+				Err:      fmt.Errorf("running %s: %w", a.command, runErr),
+			}
+			continue
+		}
+
+		output := stdout.String()
+		results[i] = RunResult{
+			Verdict:  parseVerdictEnvelope(output),
+			Output:   output,
+			Stderr:   stderr.String(),
+			ExitCode: exitCode,
+		}
+	}
+
+	return results
+}
+
+// exitCodeOf extracts a subprocess's exit code from the error cmd.Run()
+// returned: 0 when it ran (err is nil), the process's actual code when it
+// ran and exited non-zero (*exec.ExitError), or -1 when it never started
+// (e.g. command not found).
+func exitCodeOf(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
+
+// parseVerdict extracts a PASS/FAIL verdict from adapter output, scanning
+// for the first line containing either token so adapters can surround the
+// verdict with their own logging.
+func parseVerdict(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		switch {
+		case strings.Contains(line, "PASS"):
+			return "PASS"
+		case strings.Contains(line, "FAIL"):
+			return "FAIL"
+		}
+	}
+	return ""
+}
+
+// verdictEnvelope is the structured verdict a real agent runner is
+// expected to print on its last line of stdout: {"verdict": "PASS",
+// "reasoning": "...", "tool_calls": [...]}. Only Verdict is consumed here;
+// reasoning and tool call detail are left in Output for the artifact log.
+type verdictEnvelope struct {
+	Verdict string `json:"verdict"`
+}
+
+// parseVerdictEnvelope extracts a verdict from agent output, preferring
+// the structured verdictEnvelope JSON a real agent runner emits and
+// falling back to parseVerdict's plain PASS/FAIL line scan for adapters
+// or scripts that just print the bare word.
+func parseVerdictEnvelope(output string) string {
+	if trimmed := strings.TrimSpace(output); trimmed != "" {
+		var env verdictEnvelope
+		if err := json.Unmarshal([]byte(trimmed), &env); err == nil && env.Verdict != "" {
+			return env.Verdict
+		}
+	}
+	return parseVerdict(output)
+}
@@ -0,0 +1,81 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPAdapter POSTs each Case as JSON to a user-supplied endpoint and
+// parses a PASS/FAIL verdict from the response body.
+type HTTPAdapter struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPAdapter builds an HTTPAdapter from an eval.yaml adapter_config
+// block shaped like:
+//
+//	adapter: http
+//	adapter_config:
+//	  endpoint: https://agent.example.com/evaluate
+func NewHTTPAdapter(config map[string]any) (Adapter, error) {
+	endpoint, _ := config["endpoint"].(string)
+	if endpoint == "" {
+		return nil, fmt.Errorf("http adapter requires an \"endpoint\" in adapter_config")
+	}
+
+	return &HTTPAdapter{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Build implements Adapter by doing nothing; the endpoint is validated
+// lazily on the first request.
+func (a *HTTPAdapter) Build(cases []Case) error { return nil }
+
+// Evaluate POSTs c as JSON to the configured endpoint k times and parses
+// PASS/FAIL from each response body.
+func (a *HTTPAdapter) Evaluate(ctx context.Context, c Case, k int) []RunResult {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		results := make([]RunResult, k)
+		for i := range results {
+			results[i] = RunResult{Err: fmt.Errorf("marshaling case: %w", err)}
+		}
+		return results
+	}
+
+	results := make([]RunResult, k)
+	for i := 0; i < k; i++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint, bytes.NewReader(payload))
+		if err != nil {
+			results[i] = RunResult{Err: fmt.Errorf("building request: %w", err)}
+			continue
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := a.client.Do(req)
+		if err != nil {
+			results[i] = RunResult{Err: fmt.Errorf("posting to %s: %w", a.endpoint, err)}
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			results[i] = RunResult{Err: fmt.Errorf("reading response body: %w", err)}
+			continue
+		}
+
+		output := string(body)
+		results[i] = RunResult{Verdict: parseVerdictEnvelope(output), Output: output}
+	}
+
+	return results
+}
@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"context"
+	"time"
+)
+
+// StubAdapter is a placeholder Adapter that always returns a case's own
+// Expected verdict, so the harness's metrics calculation can be exercised
+// without a real agent wired in.
+// TODO: Replace callers' default use of this adapter with a real one once
+// an agent runner is available for every suite.
+type StubAdapter struct {
+	// delay is an optional per-run sleep, configured via adapter_config's
+	// "delay_ms". It exists so callers (e.g. the meta command's
+	// --parallel tests) can simulate a slow agent without spawning a real
+	// subprocess.
+	delay time.Duration
+}
+
+// NewStubAdapter returns a StubAdapter with no artificial delay.
+func NewStubAdapter() *StubAdapter {
+	return &StubAdapter{}
+}
+
+// NewStubAdapterWithDelay returns a StubAdapter that sleeps delay before
+// returning each run's verdict.
+func NewStubAdapterWithDelay(delay time.Duration) *StubAdapter {
+	return &StubAdapter{delay: delay}
+}
+
+// newStubAdapterFromConfig builds a StubAdapter from an eval.yaml
+// adapter_config block shaped like:
+//
+//	adapter: stub
+//	adapter_config:
+//	  delay_ms: 50
+func newStubAdapterFromConfig(config map[string]any) (Adapter, error) {
+	return NewStubAdapterWithDelay(delayFromConfig(config)), nil
+}
+
+// delayFromConfig reads "delay_ms" out of an adapter_config block, tolerant
+// of both int (set directly in Go, e.g. tests) and float64 (as YAML/JSON
+// numbers unmarshal into map[string]any).
+func delayFromConfig(config map[string]any) time.Duration {
+	switch ms := config["delay_ms"].(type) {
+	case int:
+		return time.Duration(ms) * time.Millisecond
+	case float64:
+		return time.Duration(ms) * time.Millisecond
+	default:
+		return 0
+	}
+}
+
+// Build implements Adapter by doing nothing.
+func (*StubAdapter) Build(cases []Case) error { return nil }
+
+// Evaluate implements Adapter by returning c.Expected for every run, after
+// waiting a.delay (if any) or until ctx is cancelled.
+func (a *StubAdapter) Evaluate(ctx context.Context, c Case, k int) []RunResult {
+	results := make([]RunResult, k)
+	for i := range results {
+		if a.delay > 0 {
+			select {
+			case <-time.After(a.delay):
+			case <-ctx.Done():
+			}
+		}
+		results[i] = RunResult{Verdict: c.Expected}
+	}
+	return results
+}
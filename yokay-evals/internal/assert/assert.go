@@ -0,0 +1,174 @@
+// Package assert implements a small venom-style assertion DSL for eval
+// criteria: an Assertion names an Operator and a Selector into a
+// structured adapter result (e.g. "result.stdout", "result.exit_code",
+// "result.artifacts.diff"), and Apply evaluates it against a Vars bag
+// populated by the caller, producing a structured Failure on mismatch.
+package assert
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Operator names one of the supported assertion checks. Operator names
+// follow the venom/GoConvey convention (ShouldX) so criteria read like
+// the assertions they desugar from.
+type Operator string
+
+const (
+	ShouldEqual            Operator = "ShouldEqual"
+	ShouldContainSubstring Operator = "ShouldContainSubstring"
+	ShouldMatchRegex       Operator = "ShouldMatchRegex"
+	ShouldBeGreaterThan    Operator = "ShouldBeGreaterThan"
+	ShouldNotContain       Operator = "ShouldNotContain"
+	ShouldBeEmpty          Operator = "ShouldBeEmpty"
+)
+
+// operators is the set of Operators ValidateOperator accepts, keyed for
+// an O(1) lookup.
+var operators = map[Operator]bool{
+	ShouldEqual:            true,
+	ShouldContainSubstring: true,
+	ShouldMatchRegex:       true,
+	ShouldBeGreaterThan:    true,
+	ShouldNotContain:       true,
+	ShouldBeEmpty:          true,
+}
+
+// ValidateOperator reports an error naming the invalid operator (and
+// listing the valid ones) if op is not one of the supported Operators.
+// Callers should run this at YAML load time so a typo in an operator
+// name fails fast instead of silently never matching.
+func ValidateOperator(op Operator) error {
+	if operators[op] {
+		return nil
+	}
+	return fmt.Errorf("unknown assertion operator %q (want one of ShouldEqual, ShouldContainSubstring, ShouldMatchRegex, ShouldBeGreaterThan, ShouldNotContain, ShouldBeEmpty)", op)
+}
+
+// Assertion is a single check against a selector into a structured
+// adapter result.
+type Assertion struct {
+	Operator Operator `yaml:"operator"`
+	// Selector is a JSONPath-style path into Vars, e.g. "result.stdout",
+	// "result.exit_code", or "result.artifacts.<key>".
+	Selector string `yaml:"selector"`
+	Expected string `yaml:"expected,omitempty"`
+	// Message, when set, replaces the generated failure reason so authors
+	// can explain why a check matters in their own words.
+	Message string `yaml:"message,omitempty"`
+}
+
+// Vars is the structured adapter result an Assertion's Selector resolves
+// against.
+type Vars struct {
+	Stdout    string
+	Stderr    string
+	ExitCode  int
+	Artifacts map[string]string
+}
+
+// Failure describes an Assertion that did not hold.
+type Failure struct {
+	Assertion Assertion
+	Actual    string
+	Expected  string
+	Message   string
+}
+
+func (f *Failure) Error() string {
+	if f.Message != "" {
+		return f.Message
+	}
+	return fmt.Sprintf("%s %s: expected %q, got %q", f.Assertion.Selector, f.Assertion.Operator, f.Expected, f.Actual)
+}
+
+// Apply evaluates assertion against vars, returning a non-nil Failure
+// when it does not hold and a non-nil error only when the assertion
+// itself could not be evaluated (e.g. an unresolvable selector or an
+// invalid regex/number).
+func Apply(ctx context.Context, vars Vars, assertion Assertion) (*Failure, error) {
+	if err := ValidateOperator(assertion.Operator); err != nil {
+		return nil, err
+	}
+
+	actual, ok := resolveSelector(vars, assertion.Selector)
+	if !ok {
+		return nil, fmt.Errorf("selector %q did not resolve against the result", assertion.Selector)
+	}
+
+	fail := func() *Failure {
+		return &Failure{Assertion: assertion, Actual: actual, Expected: assertion.Expected, Message: assertion.Message}
+	}
+
+	switch assertion.Operator {
+	case ShouldEqual:
+		if actual != assertion.Expected {
+			return fail(), nil
+		}
+	case ShouldContainSubstring:
+		if !strings.Contains(actual, assertion.Expected) {
+			return fail(), nil
+		}
+	case ShouldNotContain:
+		if strings.Contains(actual, assertion.Expected) {
+			return fail(), nil
+		}
+	case ShouldMatchRegex:
+		re, err := regexp.Compile(assertion.Expected)
+		if err != nil {
+			return nil, fmt.Errorf("assertion %s: invalid regex %q: %w", assertion.Selector, assertion.Expected, err)
+		}
+		if !re.MatchString(actual) {
+			return fail(), nil
+		}
+	case ShouldBeGreaterThan:
+		actualNum, err := strconv.ParseFloat(strings.TrimSpace(actual), 64)
+		if err != nil {
+			return nil, fmt.Errorf("assertion %s: actual value %q is not a number: %w", assertion.Selector, actual, err)
+		}
+		wantNum, err := strconv.ParseFloat(strings.TrimSpace(assertion.Expected), 64)
+		if err != nil {
+			return nil, fmt.Errorf("assertion %s: expected value %q is not a number: %w", assertion.Selector, assertion.Expected, err)
+		}
+		if !(actualNum > wantNum) {
+			return fail(), nil
+		}
+	case ShouldBeEmpty:
+		if actual != "" {
+			return fail(), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// resolveSelector resolves a JSONPath-style selector ("result.stdout",
+// "result.exit_code", "result.artifacts.<key>") against vars, returning
+// its string value and whether it resolved.
+func resolveSelector(vars Vars, selector string) (string, bool) {
+	const prefix = "result."
+	if !strings.HasPrefix(selector, prefix) {
+		return "", false
+	}
+	path := strings.TrimPrefix(selector, prefix)
+
+	if rest, ok := strings.CutPrefix(path, "artifacts."); ok {
+		v, ok := vars.Artifacts[rest]
+		return v, ok
+	}
+
+	switch path {
+	case "stdout":
+		return vars.Stdout, true
+	case "stderr":
+		return vars.Stderr, true
+	case "exit_code":
+		return strconv.Itoa(vars.ExitCode), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,71 @@
+package assert
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValidateOperator(t *testing.T) {
+	if err := ValidateOperator(ShouldMatchRegex); err != nil {
+		t.Errorf("ValidateOperator(ShouldMatchRegex) = %v, want nil", err)
+	}
+	if err := ValidateOperator("ShouldEquall"); err == nil {
+		t.Error("ValidateOperator(\"ShouldEquall\") = nil, want an error")
+	}
+}
+
+func TestApply(t *testing.T) {
+	vars := Vars{
+		Stdout:    "tests passed: 12",
+		Stderr:    "",
+		ExitCode:  0,
+		Artifacts: map[string]string{"diff": ""},
+	}
+
+	cases := []struct {
+		name      string
+		assertion Assertion
+		wantFail  bool
+		wantErr   bool
+	}{
+		{"ShouldEqual pass", Assertion{Operator: ShouldEqual, Selector: "result.exit_code", Expected: "0"}, false, false},
+		{"ShouldEqual fail", Assertion{Operator: ShouldEqual, Selector: "result.exit_code", Expected: "1"}, true, false},
+		{"ShouldContainSubstring pass", Assertion{Operator: ShouldContainSubstring, Selector: "result.stdout", Expected: "passed"}, false, false},
+		{"ShouldContainSubstring fail", Assertion{Operator: ShouldContainSubstring, Selector: "result.stdout", Expected: "failed"}, true, false},
+		{"ShouldNotContain pass", Assertion{Operator: ShouldNotContain, Selector: "result.stdout", Expected: "failed"}, false, false},
+		{"ShouldNotContain fail", Assertion{Operator: ShouldNotContain, Selector: "result.stdout", Expected: "passed"}, true, false},
+		{"ShouldMatchRegex pass", Assertion{Operator: ShouldMatchRegex, Selector: "result.stdout", Expected: `passed: \d+`}, false, false},
+		{"ShouldMatchRegex fail", Assertion{Operator: ShouldMatchRegex, Selector: "result.stdout", Expected: `^nope$`}, true, false},
+		{"ShouldMatchRegex invalid", Assertion{Operator: ShouldMatchRegex, Selector: "result.stdout", Expected: `(`}, false, true},
+		{"ShouldBeGreaterThan pass", Assertion{Operator: ShouldBeGreaterThan, Selector: "result.exit_code", Expected: "-1"}, false, false},
+		{"ShouldBeGreaterThan fail", Assertion{Operator: ShouldBeGreaterThan, Selector: "result.exit_code", Expected: "1"}, true, false},
+		{"ShouldBeEmpty pass", Assertion{Operator: ShouldBeEmpty, Selector: "result.artifacts.diff"}, false, false},
+		{"ShouldBeEmpty fail", Assertion{Operator: ShouldBeEmpty, Selector: "result.stdout"}, true, false},
+		{"unresolvable selector", Assertion{Operator: ShouldEqual, Selector: "result.bogus", Expected: "x"}, false, true},
+		{"unknown operator", Assertion{Operator: "ShouldExplode", Selector: "result.stdout", Expected: "x"}, false, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			failure, err := Apply(context.Background(), vars, c.assertion)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, c.wantErr)
+			}
+			if (failure != nil) != c.wantFail {
+				t.Errorf("failure = %v, wantFail %v", failure, c.wantFail)
+			}
+		})
+	}
+}
+
+func TestFailureError(t *testing.T) {
+	f := &Failure{Assertion: Assertion{Operator: ShouldEqual, Selector: "result.stdout"}, Actual: "a", Expected: "b"}
+	if f.Error() == "" {
+		t.Error("Error() returned empty string")
+	}
+
+	f.Message = "custom explanation"
+	if f.Error() != "custom explanation" {
+		t.Errorf("Error() = %q, want the custom Message", f.Error())
+	}
+}
@@ -0,0 +1,309 @@
+// Package codebased executes `type: code-based` eval_criteria that need a
+// real artifact tree and a real shell, rather than
+// judge.CodeJudge's deterministic key==value clause grammar (see
+// internal/judge/code.go). A FailureCase's evidence.what_was_built, and
+// optionally a candidate directory produced by a real agent run, are
+// materialized into a working directory, and the criterion's check is run
+// there as either a registered named check (see RegisterNamedCheck) or a
+// plain shell snippet, with a non-zero exit counting as a failure. Result
+// is shape-compatible with internal/graders/modelbased.Result so a
+// code-based verdict and a model-based grade can be reported the same
+// way.
+package codebased
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Result is an Executor's verdict on a single check.
+type Result struct {
+	// Passed indicates whether the check exited zero (or, for a named
+	// check, whatever it considers success).
+	Passed bool
+	// Score is 0 or 100, mirroring judge.Verdict's CodeJudge convention.
+	Score float64
+	// Message is human-readable: the exit code on success, or exit code
+	// plus captured stdout/stderr on failure.
+	Message string
+	// Details holds exit_code/stdout/stderr (shell checks) or
+	// matched_files (grep_test_file/has_symbol), for callers that want
+	// more than Message's flattened text.
+	Details map[string]any
+}
+
+// DefaultTimeout bounds how long a single check may run before Execute
+// kills it and reports a failure.
+const DefaultTimeout = 30 * time.Second
+
+// whatWasBuiltFile is the name Execute materializes
+// FailureCase.Evidence.WhatWasBuilt under in the working directory, so a
+// named check like grep_test_file can inspect it the same way it would
+// inspect any file a real agent run produced.
+const whatWasBuiltFile = "WHAT_WAS_BUILT.md"
+
+// NamedCheck runs a registered check against dir, with arg being
+// whatever followed the check's name in its check string.
+type NamedCheck func(ctx context.Context, dir, arg string) (Result, error)
+
+var namedChecks = map[string]NamedCheck{
+	"grep_test_file": grepTestFile,
+	"go_test":        goTest,
+	"has_symbol":     hasSymbol,
+}
+
+// RegisterNamedCheck adds or replaces the NamedCheck for name, so a
+// project can add its own checks without forking this package.
+func RegisterNamedCheck(name string, fn NamedCheck) {
+	namedChecks[name] = fn
+}
+
+// Executor runs a single eval_criteria check in an isolated working
+// directory, with Timeout bounding how long it may run.
+type Executor struct {
+	Timeout time.Duration
+}
+
+// NewExecutor returns an Executor with the given timeout, or
+// DefaultTimeout if timeout is 0.
+func NewExecutor(timeout time.Duration) *Executor {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Executor{Timeout: timeout}
+}
+
+// Execute materializes whatWasBuilt and, if set, the contents of
+// candidateDir into dir, then runs check: a registered named check
+// (given as "<name> <arg>") if its name is registered, otherwise a shell
+// snippet run via `sh -c check` with dir as its working directory. A
+// non-zero exit (or a named check reporting failure) comes back as
+// Result.Passed == false rather than as an error; Execute's own error
+// return is reserved for setup failures such as an unreadable
+// candidateDir or a check that can't be started at all.
+func (e *Executor) Execute(ctx context.Context, dir, whatWasBuilt, candidateDir, check string) (Result, error) {
+	if err := materialize(dir, whatWasBuilt, candidateDir); err != nil {
+		return Result{}, fmt.Errorf("materializing artifacts into %s: %w", dir, err)
+	}
+
+	check = strings.TrimSpace(check)
+	if check == "" {
+		return Result{}, errors.New("codebased: empty check")
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, e.Timeout)
+	defer cancel()
+
+	if name, arg, ok := parseNamedCheck(check); ok {
+		return namedChecks[name](runCtx, dir, arg)
+	}
+
+	return runShellCheck(runCtx, dir, check)
+}
+
+// parseNamedCheck splits check into "<name> <arg>" and reports whether
+// name is a registered NamedCheck; if not, the caller falls back to
+// treating the whole string as a shell snippet.
+func parseNamedCheck(check string) (name, arg string, ok bool) {
+	fields := strings.SplitN(check, " ", 2)
+	name = fields[0]
+	if _, registered := namedChecks[name]; !registered {
+		return "", "", false
+	}
+	if len(fields) > 1 {
+		arg = strings.TrimSpace(fields[1])
+	}
+	return name, arg, true
+}
+
+func scoreFor(passed bool) float64 {
+	if passed {
+		return 100
+	}
+	return 0
+}
+
+// runShellCheck runs check as `sh -c check` with dir as its working
+// directory, treating a non-zero exit as a failing (not erroring)
+// Result.
+func runShellCheck(ctx context.Context, dir, check string) (Result, error) {
+	cmd := exec.CommandContext(ctx, "sh", "-c", check)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	exitCode := 0
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return Result{}, fmt.Errorf("running check %q: %w", check, err)
+		}
+		exitCode = exitErr.ExitCode()
+	}
+
+	passed := exitCode == 0
+	message := fmt.Sprintf("exit code %d", exitCode)
+	if !passed {
+		message = fmt.Sprintf("exit code %d\nstdout:\n%s\nstderr:\n%s", exitCode, stdout.String(), stderr.String())
+	}
+
+	return Result{
+		Passed:  passed,
+		Score:   scoreFor(passed),
+		Message: message,
+		Details: map[string]any{
+			"exit_code": exitCode,
+			"stdout":    stdout.String(),
+			"stderr":    stderr.String(),
+		},
+	}, nil
+}
+
+// goTest runs `go test <arg>` (defaulting arg to "./...") in dir.
+func goTest(ctx context.Context, dir, arg string) (Result, error) {
+	args := []string{"test"}
+	if strings.TrimSpace(arg) != "" {
+		args = append(args, strings.Fields(arg)...)
+	} else {
+		args = append(args, "./...")
+	}
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	passed := cmd.Run() == nil
+	message := stdout.String()
+	if !passed {
+		message = fmt.Sprintf("go test failed:\n%s\n%s", stdout.String(), stderr.String())
+	}
+
+	return Result{
+		Passed:  passed,
+		Score:   scoreFor(passed),
+		Message: message,
+		Details: map[string]any{"stdout": stdout.String(), "stderr": stderr.String()},
+	}, nil
+}
+
+// grepTestFile reports whether any *_test.go file under dir contains
+// arg, treated as a plain substring rather than a regex.
+func grepTestFile(ctx context.Context, dir, arg string) (Result, error) {
+	return grepFiles(dir, arg, "grep_test_file", func(name string) bool {
+		return strings.HasSuffix(name, "_test.go")
+	})
+}
+
+// hasSymbol reports whether any .go file under dir contains arg, treated
+// as a plain substring rather than a regex.
+func hasSymbol(ctx context.Context, dir, arg string) (Result, error) {
+	return grepFiles(dir, arg, "has_symbol", func(name string) bool {
+		return strings.HasSuffix(name, ".go")
+	})
+}
+
+// grepFiles walks dir, reading every file whose name satisfies match,
+// and reports whether pattern appears in at least one of them.
+func grepFiles(dir, pattern, checkName string, match func(name string) bool) (Result, error) {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return Result{}, fmt.Errorf("%s: missing pattern argument", checkName)
+	}
+
+	var matchedFiles []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !match(info.Name()) {
+			return nil
+		}
+		data, readErr := os.ReadFile(path)
+		if readErr != nil {
+			return readErr
+		}
+		if strings.Contains(string(data), pattern) {
+			matchedFiles = append(matchedFiles, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("%s: walking %s: %w", checkName, dir, err)
+	}
+
+	passed := len(matchedFiles) > 0
+	message := fmt.Sprintf("%q found in %d file(s)", pattern, len(matchedFiles))
+	if !passed {
+		message = fmt.Sprintf("%q not found under %s", pattern, dir)
+	}
+	return Result{
+		Passed:  passed,
+		Score:   scoreFor(passed),
+		Message: message,
+		Details: map[string]any{"matched_files": matchedFiles},
+	}, nil
+}
+
+// materialize writes whatWasBuilt (if non-empty) to whatWasBuiltFile
+// under dir, then copies candidateDir's tree (if set) on top of dir.
+func materialize(dir, whatWasBuilt, candidateDir string) error {
+	if whatWasBuilt != "" {
+		if err := os.WriteFile(filepath.Join(dir, whatWasBuiltFile), []byte(whatWasBuilt), 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", whatWasBuiltFile, err)
+		}
+	}
+	if candidateDir == "" {
+		return nil
+	}
+	return copyTree(candidateDir, dir)
+}
+
+// copyTree recursively copies src's contents into dst, creating
+// directories as needed.
+func copyTree(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, relErr := filepath.Rel(src, path)
+		if relErr != nil {
+			return relErr
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,150 @@
+package codebased
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExecuteShellCheckPass(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExecutor(0)
+
+	result, err := e.Execute(context.Background(), dir, "", "", "exit 0")
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !result.Passed {
+		t.Errorf("Passed = false, want true: %s", result.Message)
+	}
+	if result.Score != 100 {
+		t.Errorf("Score = %v, want 100", result.Score)
+	}
+}
+
+func TestExecuteShellCheckFail(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExecutor(0)
+
+	result, err := e.Execute(context.Background(), dir, "", "", "exit 1")
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if result.Passed {
+		t.Error("Passed = true, want false")
+	}
+	if result.Score != 0 {
+		t.Errorf("Score = %v, want 0", result.Score)
+	}
+}
+
+func TestExecuteMaterializesWhatWasBuilt(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExecutor(0)
+
+	_, err := e.Execute(context.Background(), dir, "built a login form", "", "grep_test_file login")
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, whatWasBuiltFile))
+	if err != nil {
+		t.Fatalf("expected %s to be written: %v", whatWasBuiltFile, err)
+	}
+	if string(data) != "built a login form" {
+		t.Errorf("%s contents = %q, want %q", whatWasBuiltFile, data, "built a login form")
+	}
+}
+
+func TestExecuteMaterializesCandidateDir(t *testing.T) {
+	candidate := t.TempDir()
+	if err := os.WriteFile(filepath.Join(candidate, "main_test.go"), []byte("func TestLogin(t *testing.T) {}"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	dir := t.TempDir()
+	e := NewExecutor(0)
+
+	result, err := e.Execute(context.Background(), dir, "", candidate, "grep_test_file TestLogin")
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !result.Passed {
+		t.Errorf("expected grep_test_file to find TestLogin after materializing candidateDir, got: %s", result.Message)
+	}
+}
+
+func TestExecuteGrepTestFileNoMatch(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main_test.go"), []byte("func TestOther(t *testing.T) {}"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	e := NewExecutor(0)
+	result, err := e.Execute(context.Background(), dir, "", "", "grep_test_file TestLogin")
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if result.Passed {
+		t.Error("expected no match for TestLogin")
+	}
+}
+
+func TestExecuteHasSymbol(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "handler.go"), []byte("func LoginHandler() {}"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	e := NewExecutor(0)
+	result, err := e.Execute(context.Background(), dir, "", "", "has_symbol LoginHandler")
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !result.Passed {
+		t.Errorf("expected has_symbol to find LoginHandler, got: %s", result.Message)
+	}
+}
+
+func TestExecuteGrepTestFileMissingArgument(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExecutor(0)
+
+	if _, err := e.Execute(context.Background(), dir, "", "", "grep_test_file"); err == nil {
+		t.Error("expected an error for grep_test_file with no pattern argument")
+	}
+}
+
+func TestExecuteEmptyCheckErrors(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExecutor(0)
+
+	if _, err := e.Execute(context.Background(), dir, "", "", "   "); err == nil {
+		t.Error("expected an error for an empty check")
+	}
+}
+
+func TestRegisterNamedCheck(t *testing.T) {
+	RegisterNamedCheck("always_pass", func(ctx context.Context, dir, arg string) (Result, error) {
+		return Result{Passed: true, Score: 100, Message: "stubbed pass"}, nil
+	})
+	defer delete(namedChecks, "always_pass")
+
+	dir := t.TempDir()
+	e := NewExecutor(0)
+	result, err := e.Execute(context.Background(), dir, "", "", "always_pass")
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !result.Passed {
+		t.Error("expected the registered always_pass check to pass")
+	}
+}
+
+func TestNewExecutorDefaultsTimeout(t *testing.T) {
+	e := NewExecutor(0)
+	if e.Timeout != DefaultTimeout {
+		t.Errorf("Timeout = %v, want DefaultTimeout %v", e.Timeout, DefaultTimeout)
+	}
+}
@@ -0,0 +1,95 @@
+package criteria
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stevestomp/yokay-evals/internal/codebased"
+	"github.com/stevestomp/yokay-evals/internal/judge"
+)
+
+// codeAdapter delegates to judge.CodeJudge (see internal/judge/code.go),
+// so a "code-based" criterion behaves identically whether it's reached
+// through this registry or a direct internal/judge caller.
+type codeAdapter struct{}
+
+func newCodeAdapter(config map[string]any) (Adapter, error) {
+	return codeAdapter{}, nil
+}
+
+func (codeAdapter) Build(criteria []Criterion) error { return nil }
+
+func (codeAdapter) Evaluate(ctx context.Context, c Criterion, rc RunContext) (Result, error) {
+	verdict, err := judge.NewCodeJudge().Evaluate(ctx, judge.Case{
+		ID:           c.Name,
+		Check:        c.Check,
+		Task:         rc.Task,
+		RootCause:    rc.RootCause,
+		WorkingDir:   rc.WorkingDir,
+		WhatWasBuilt: rc.WhatWasBuilt,
+		CandidateDir: rc.CandidateDir,
+	}, judge.Transcript{Stdout: rc.Stdout, Stderr: rc.Stderr, ExitCode: rc.ExitCode})
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Passed: verdict.Pass, Score: verdict.Score, Message: verdict.Reasoning}, nil
+}
+
+// modelAdapter delegates to judge.ModelJudge (see internal/judge/model.go).
+type modelAdapter struct {
+	judge judge.Judge
+}
+
+func newModelAdapter(config map[string]any) (Adapter, error) {
+	j, err := judge.New("model-based", config)
+	if err != nil {
+		return nil, fmt.Errorf("building model-based judge: %w", err)
+	}
+	return modelAdapter{judge: j}, nil
+}
+
+func (modelAdapter) Build(criteria []Criterion) error { return nil }
+
+func (a modelAdapter) Evaluate(ctx context.Context, c Criterion, rc RunContext) (Result, error) {
+	verdict, err := a.judge.Evaluate(ctx, judge.Case{
+		ID:        c.Name,
+		Check:     c.Check,
+		Task:      rc.Task,
+		RootCause: rc.RootCause,
+	}, judge.Transcript{Stdout: rc.Stdout, Stderr: rc.Stderr, ExitCode: rc.ExitCode})
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Passed: verdict.Pass, Score: verdict.Score, Message: verdict.Reasoning}, nil
+}
+
+// goTestAdapter runs `go test <check>` (defaulting to `go test ./...`)
+// in the run's working directory via codebased's "go_test" named check
+// (see internal/codebased), so a check like "-run TestLogin ./..." scopes
+// the run to a single test.
+type goTestAdapter struct {
+	executor *codebased.Executor
+}
+
+func newGoTestAdapter(config map[string]any) (Adapter, error) {
+	return goTestAdapter{executor: codebased.NewExecutor(0)}, nil
+}
+
+func (goTestAdapter) Build(criteria []Criterion) error { return nil }
+
+func (a goTestAdapter) Evaluate(ctx context.Context, c Criterion, rc RunContext) (Result, error) {
+	if rc.WorkingDir == "" {
+		return Result{}, fmt.Errorf("go-test criterion %q requires a working directory", c.Name)
+	}
+
+	check := "go_test"
+	if c.Check != "" {
+		check = "go_test " + c.Check
+	}
+
+	result, err := a.executor.Execute(ctx, rc.WorkingDir, rc.WhatWasBuilt, rc.CandidateDir, check)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Passed: result.Passed, Score: result.Score, Message: result.Message}, nil
+}
@@ -0,0 +1,112 @@
+// Package criteria generalizes eval_criteria's `type:` dispatch, which
+// used to be a hard-coded "code-based"/"model-based" special case in
+// cmd/yokay-evals/assertions.go, into a registry any project can extend.
+//
+// This is a different registry from internal/adapters: that package
+// dispatches an eval.yaml `adapter:` name to something that runs an
+// agent; this one dispatches an eval_criteria `type:` to an Adapter that
+// judges a single criterion's Check against a run's working directory or
+// captured transcript. Both packages share the same build/evaluate split
+// for the same reason: setup (compiling a test binary, pulling a
+// container image, prewarming a model client) only needs to happen once
+// per eval run, not once per criterion.
+package criteria
+
+import (
+	"context"
+	"fmt"
+)
+
+// Criterion is the subset of an eval_criteria entry (EvalCriterion in
+// cmd/yokay-evals) an Adapter needs to evaluate it. cmd/yokay-evals is an
+// unimportable main package (see internal/adapters.Case for the same
+// constraint), so this is a small package-local copy rather than a
+// shared type.
+type Criterion struct {
+	// Name identifies the criterion for error messages; it's
+	// EvalCriterion.Name (or Type as a fallback).
+	Name string
+	// Check is the criterion's check field: its meaning is entirely up
+	// to the Adapter registered for its Type.
+	Check string
+}
+
+// RunContext is what an Adapter needs from the FailureCase and isolated
+// run its Criterion belongs to.
+type RunContext struct {
+	// WorkingDir is the run's harness.IsolatedContext.WorkingDir(), for
+	// adapters (like the built-in "code-based" and "go-test") that judge
+	// the contents of a real working directory rather than just a
+	// transcript.
+	WorkingDir string
+	// Task and RootCause mirror FailureCase.Context.Task and
+	// FailureCase.Failure.RootCause.
+	Task      string
+	RootCause string
+	// WhatWasBuilt mirrors FailureCase.Evidence.WhatWasBuilt.
+	WhatWasBuilt string
+	// CandidateDir, when set, is an agent-produced artifact tree an
+	// adapter may materialize into WorkingDir before judging it.
+	CandidateDir string
+	// Stdout, Stderr, and ExitCode are the driver command's captured
+	// transcript, for adapters that judge output rather than the working
+	// directory's contents.
+	Stdout   string
+	Stderr   string
+	ExitCode int
+}
+
+// Result is an Adapter's verdict on a single Criterion evaluation.
+type Result struct {
+	Passed  bool
+	Score   float64
+	Message string
+}
+
+// Adapter evaluates eval_criteria of one EvalCriterion.Type.
+type Adapter interface {
+	// Build prepares the adapter for the given criteria (e.g.
+	// precompiling test binaries, pulling container images, prewarming a
+	// model client). It is called once per eval run.
+	Build(criteria []Criterion) error
+	// Evaluate judges c against rc and returns a Result.
+	Evaluate(ctx context.Context, c Criterion, rc RunContext) (Result, error)
+}
+
+// Factory constructs an Adapter from its eval_criteria-level config, if
+// any (the code-based and go-test adapters ignore it; the model-based
+// adapter passes it on to judge.New; the parameter mirrors
+// internal/judge.Factory and internal/adapters.Factory for forward
+// compatibility with a future `adapter_config:` block).
+type Factory func(config map[string]any) (Adapter, error)
+
+// registry maps an eval_criteria `type` to the Factory that builds its
+// Adapter.
+var registry = map[string]Factory{
+	"code-based":  newCodeAdapter,
+	"model-based": newModelAdapter,
+	"go-test":     newGoTestAdapter,
+}
+
+// Register adds or replaces the Factory for criterionType, so a project
+// can add its own criterion types (e.g. "container", "http-probe")
+// without forking this package.
+func Register(criterionType string, factory Factory) {
+	registry[criterionType] = factory
+}
+
+// Registered reports whether criterionType has a registered Factory.
+func Registered(criterionType string) bool {
+	_, ok := registry[criterionType]
+	return ok
+}
+
+// New builds the Adapter registered for criterionType with the given
+// config.
+func New(criterionType string, config map[string]any) (Adapter, error) {
+	factory, ok := registry[criterionType]
+	if !ok {
+		return nil, fmt.Errorf("unknown criterion adapter: %s", criterionType)
+	}
+	return factory(config)
+}
@@ -0,0 +1,95 @@
+package criteria
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRegisteredBuiltins(t *testing.T) {
+	for _, want := range []string{"code-based", "model-based", "go-test"} {
+		if !Registered(want) {
+			t.Errorf("Registered(%q) = false, want true", want)
+		}
+	}
+	if Registered("container") {
+		t.Error(`Registered("container") = true, want false before anyone registers it`)
+	}
+}
+
+func TestNewUnknownCriterionType(t *testing.T) {
+	if _, err := New("container", nil); err == nil {
+		t.Fatal("expected an error for an unregistered criterion type")
+	}
+}
+
+func TestRegisterAddsNewCriterionType(t *testing.T) {
+	Register("always-pass", func(config map[string]any) (Adapter, error) {
+		return stubAdapter{}, nil
+	})
+	defer delete(registry, "always-pass")
+
+	if !Registered("always-pass") {
+		t.Fatal("expected Register to make the type Registered")
+	}
+
+	adapter, err := New("always-pass", nil)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	result, err := adapter.Evaluate(context.Background(), Criterion{Name: "x"}, RunContext{})
+	if err != nil {
+		t.Fatalf("Evaluate failed: %v", err)
+	}
+	if !result.Passed {
+		t.Error("expected stubAdapter to always pass")
+	}
+}
+
+type stubAdapter struct{}
+
+func (stubAdapter) Build(criteria []Criterion) error { return nil }
+
+func (stubAdapter) Evaluate(ctx context.Context, c Criterion, rc RunContext) (Result, error) {
+	return Result{Passed: true, Score: 100, Message: "stub"}, nil
+}
+
+func TestCodeAdapterEvaluatesClauseExpression(t *testing.T) {
+	adapter, err := New("code-based", nil)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	result, err := adapter.Evaluate(context.Background(), Criterion{Name: "exit-code", Check: "exit_code==0"}, RunContext{ExitCode: 0})
+	if err != nil {
+		t.Fatalf("Evaluate failed: %v", err)
+	}
+	if !result.Passed {
+		t.Errorf("expected exit_code==0 to pass against ExitCode=0, got: %s", result.Message)
+	}
+}
+
+func TestCodeAdapterEvaluatesShellCheck(t *testing.T) {
+	adapter, err := New("code-based", nil)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	result, err := adapter.Evaluate(context.Background(), Criterion{Name: "shell", Check: "exit 0"}, RunContext{WorkingDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("Evaluate failed: %v", err)
+	}
+	if !result.Passed {
+		t.Errorf("expected shell check to pass, got: %s", result.Message)
+	}
+}
+
+func TestGoTestAdapterRequiresWorkingDir(t *testing.T) {
+	adapter, err := New("go-test", nil)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	if _, err := adapter.Evaluate(context.Background(), Criterion{Name: "gt"}, RunContext{}); err == nil {
+		t.Fatal("expected an error when no WorkingDir is given")
+	}
+}
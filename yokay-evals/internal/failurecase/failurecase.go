@@ -0,0 +1,133 @@
+// Package failurecase defines a typed schema for the YAML failure case
+// files under yokay-evals/failures/*, replacing ad hoc strings.Contains
+// scans of the raw file bytes. Load decodes a case with yaml.v3's
+// KnownFields(true), so a malformed document, a misnested field, or a
+// duplicated key fails at load time with a precise error instead of
+// silently passing a substring check, and reports structural issues
+// (bad ID shape, category/directory mismatch, unknown criterion type) as
+// non-fatal Diagnostics a caller can choose to surface or ignore.
+package failurecase
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CriterionType enumerates the supported eval_criteria.type values.
+type CriterionType string
+
+const (
+	CodeBased  CriterionType = "code-based"
+	ModelBased CriterionType = "model-based"
+)
+
+// IDPattern matches the <PREFIX>-<NNN> shape used across
+// yokay-evals/failures/* (2-3 uppercase letters, a dash, 3 digits).
+var IDPattern = regexp.MustCompile(`^[A-Z]{2,3}-\d{3}$`)
+
+// FailureCase mirrors the YAML shape of a documented agent failure case.
+// It carries the same fields as main.FailureCase in cmd/yokay-evals, but
+// Load additionally validates them against IDPattern and the directory
+// the file lives under.
+type FailureCase struct {
+	ID           string         `yaml:"id"`
+	Category     string         `yaml:"category"`
+	Discovered   string         `yaml:"discovered"`
+	Severity     string         `yaml:"severity"`
+	Context      Context        `yaml:"context"`
+	Failure      Details        `yaml:"failure"`
+	Evidence     Evidence       `yaml:"evidence"`
+	EvalCriteria []EvalCriteria `yaml:"eval_criteria"`
+}
+
+// Context contains context about where/when the failure occurred.
+type Context struct {
+	Task      string `yaml:"task"`
+	SessionID string `yaml:"session_id,omitempty"`
+}
+
+// Details describes what went wrong.
+type Details struct {
+	Description string `yaml:"description"`
+	RootCause   string `yaml:"root_cause"`
+}
+
+// Evidence contains the evidence of the failure.
+type Evidence struct {
+	TaskSpec     string `yaml:"task_spec"`
+	WhatWasBuilt string `yaml:"what_was_built"`
+}
+
+// EvalCriteria is a single evaluation check.
+type EvalCriteria struct {
+	Type  CriterionType `yaml:"type"`
+	Check string        `yaml:"check"`
+}
+
+// Diagnostic is a non-fatal issue found while validating a loaded
+// FailureCase's structure. Unlike a Load error (which means the YAML
+// itself could not be decoded), a Diagnostic means the document parsed
+// fine but doesn't meet the conventions the rest of the package assumes.
+type Diagnostic struct {
+	Path    string
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s", d.Path, d.Message)
+}
+
+// Load decodes the failure case YAML file at path with KnownFields(true)
+// so unknown or duplicated fields fail the decode, then validates its ID,
+// category, and criteria, returning any issues as Diagnostics rather than
+// failing the load outright. The returned error is non-nil only when the
+// file could not be read or decoded.
+func Load(path string) (*FailureCase, []Diagnostic, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading failure case: %w", err)
+	}
+
+	var fc FailureCase
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&fc); err != nil {
+		return nil, nil, fmt.Errorf("parsing failure case %s: %w", path, err)
+	}
+
+	return &fc, diagnose(path, &fc), nil
+}
+
+// diagnose reports structural issues with fc that don't prevent it from
+// being decoded: a malformed ID, an ID/directory category mismatch, an
+// empty criteria list, or a criterion with an unrecognized Type.
+func diagnose(path string, fc *FailureCase) []Diagnostic {
+	var diags []Diagnostic
+	add := func(format string, args ...any) {
+		diags = append(diags, Diagnostic{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if !IDPattern.MatchString(fc.ID) {
+		add("id %q does not match %s", fc.ID, IDPattern.String())
+	}
+
+	if dir := filepath.Base(filepath.Dir(path)); dir != "fixtures" && fc.Category != dir {
+		add("category %q does not match directory %q", fc.Category, dir)
+	}
+
+	if len(fc.EvalCriteria) == 0 {
+		add("eval_criteria is empty")
+	}
+	for i, c := range fc.EvalCriteria {
+		if c.Type != CodeBased && c.Type != ModelBased {
+			add("eval_criteria[%d]: unknown type %q (want %q or %q)", i, c.Type, CodeBased, ModelBased)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,139 @@
+package failurecase
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeCase(t *testing.T, dir, category, body string) string {
+	t.Helper()
+	catDir := filepath.Join(dir, category)
+	if err := os.MkdirAll(catDir, 0755); err != nil {
+		t.Fatalf("creating %s: %v", catDir, err)
+	}
+	path := filepath.Join(catDir, "MT-001.yaml")
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatalf("writing %s: %v", path, err)
+	}
+	return path
+}
+
+const validCase = `
+id: MT-001
+category: missed-tasks
+discovered: "2024-01-01"
+severity: high
+context:
+  task: do the thing
+failure:
+  description: it didn't
+  root_cause: reasons
+evidence:
+  task_spec: spec
+  what_was_built: nothing
+eval_criteria:
+  - type: code-based
+    check: "go test ./..."
+`
+
+func TestLoadValidCase(t *testing.T) {
+	dir := t.TempDir()
+	path := writeCase(t, dir, "missed-tasks", validCase)
+
+	fc, diags, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(diags) != 0 {
+		t.Errorf("Load() diagnostics = %v, want none", diags)
+	}
+	if fc.ID != "MT-001" || fc.Category != "missed-tasks" {
+		t.Errorf("Load() = %+v, want ID=MT-001 Category=missed-tasks", fc)
+	}
+}
+
+func TestLoadRejectsUnknownField(t *testing.T) {
+	dir := t.TempDir()
+	path := writeCase(t, dir, "missed-tasks", validCase+"bogus_field: oops\n")
+
+	if _, _, err := Load(path); err == nil {
+		t.Error("Load() error = nil, want an error for an unknown top-level field")
+	}
+}
+
+func TestLoadDiagnosesMalformedID(t *testing.T) {
+	dir := t.TempDir()
+	body := `
+id: bogus-id
+category: missed-tasks
+context:
+  task: x
+failure:
+  description: x
+  root_cause: x
+evidence:
+  task_spec: x
+  what_was_built: x
+eval_criteria:
+  - type: code-based
+    check: x
+`
+	path := writeCase(t, dir, "missed-tasks", body)
+
+	_, diags, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(diags) != 1 {
+		t.Fatalf("Load() diagnostics = %v, want exactly one for the bad ID", diags)
+	}
+}
+
+func TestLoadDiagnosesCategoryMismatch(t *testing.T) {
+	dir := t.TempDir()
+	path := writeCase(t, dir, "missed-tasks", validCase) // category field says "missed-tasks"
+	renamed := filepath.Join(filepath.Dir(filepath.Dir(path)), "security-flaw", filepath.Base(path))
+	if err := os.MkdirAll(filepath.Dir(renamed), 0755); err != nil {
+		t.Fatalf("creating %s: %v", filepath.Dir(renamed), err)
+	}
+	if err := os.Rename(path, renamed); err != nil {
+		t.Fatalf("renaming %s: %v", path, err)
+	}
+
+	_, diags, err := Load(renamed)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(diags) != 1 {
+		t.Fatalf("Load() diagnostics = %v, want exactly one for the category/directory mismatch", diags)
+	}
+}
+
+func TestLoadDiagnosesUnknownCriterionType(t *testing.T) {
+	dir := t.TempDir()
+	body := `
+id: MT-001
+category: missed-tasks
+context:
+  task: x
+failure:
+  description: x
+  root_cause: x
+evidence:
+  task_spec: x
+  what_was_built: x
+eval_criteria:
+  - type: regex-based
+    check: x
+`
+	path := writeCase(t, dir, "missed-tasks", body)
+
+	_, diags, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(diags) != 1 {
+		t.Fatalf("Load() diagnostics = %v, want exactly one for the unknown criterion type", diags)
+	}
+}
@@ -0,0 +1,153 @@
+package modelbased
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CachingProvider wraps an LLMProvider with a content-addressed on-disk
+// cache, so repeated k-runs of the same eval case (see
+// cmd/yokay-evals/meta.go's seedForRun) and re-runs of the same failure
+// case across sessions don't re-bill an identical prompt. Each cache
+// entry is one JSON file named by cacheKey(Model, prompt, schema,
+// Temperature) under Dir; Seed isn't part of the key - it's recorded in the entry
+// purely for audit, since grading the same prompt twice should hit the
+// cache even when the two runs used different seeds.
+type CachingProvider struct {
+	Provider    LLMProvider
+	Dir         string
+	Model       string
+	Temperature float64
+	// Seed is recorded in every cache entry written by this provider, not
+	// used to compute the cache key.
+	Seed int64
+}
+
+// NewCachingProvider wraps provider with an on-disk cache rooted at dir.
+func NewCachingProvider(provider LLMProvider, dir string) *CachingProvider {
+	return &CachingProvider{Provider: provider, Dir: dir}
+}
+
+// SetModel records model for the cache key and, if the wrapped Provider
+// is itself configurable, forwards it so the upstream call actually uses
+// that model too.
+func (p *CachingProvider) SetModel(model string) {
+	p.Model = model
+	if cfg, ok := p.Provider.(configurableProvider); ok {
+		cfg.SetModel(model)
+	}
+}
+
+// SetTemperature records temperature for the cache key and forwards it to
+// the wrapped Provider, same as SetModel.
+func (p *CachingProvider) SetTemperature(temperature float64) {
+	p.Temperature = temperature
+	if cfg, ok := p.Provider.(configurableProvider); ok {
+		cfg.SetTemperature(temperature)
+	}
+}
+
+// cacheEntry is one cached prompt/response pair's on-disk JSON shape.
+type cacheEntry struct {
+	Model       string  `json:"model"`
+	Prompt      string  `json:"prompt"`
+	Schema      string  `json:"schema"`
+	Temperature float64 `json:"temperature"`
+	Seed        int64   `json:"seed"`
+	Timestamp   string  `json:"timestamp"`
+	Response    string  `json:"response"`
+	Usage       Usage   `json:"usage"`
+}
+
+// cacheKey returns the content-addressed cache key for a (model, prompt,
+// schema, temperature) tuple. schema must be included: two callers can
+// share a prompt's text while requiring different response shapes (e.g.
+// SkillClarityGrader's criterionResponseSchema vs. RubricGrader's
+// rubricResponseSchema), and without it they'd collide on the same cache
+// entry and replay a response shaped for the wrong schema.
+func cacheKey(model, prompt, schema string, temperature float64) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%v", model, prompt, schema, temperature)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (p *CachingProvider) path(key string) string {
+	return filepath.Join(p.Dir, key+".json")
+}
+
+func (p *CachingProvider) load(key string) (cacheEntry, bool) {
+	data, err := os.ReadFile(p.path(key))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (p *CachingProvider) store(key string, entry cacheEntry) error {
+	if err := os.MkdirAll(p.Dir, 0755); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling cache entry: %w", err)
+	}
+	return os.WriteFile(p.path(key), data, 0644)
+}
+
+// Complete serves prompt from the cache when present, otherwise delegates
+// to Provider and caches the result before returning it.
+func (p *CachingProvider) Complete(ctx context.Context, prompt string, schema string) (string, error) {
+	text, _, err := p.CompleteWithUsage(ctx, prompt, schema)
+	return text, err
+}
+
+// CompleteWithUsage behaves like Complete but also caches (and, on a hit,
+// replays) the provider's reported token usage.
+func (p *CachingProvider) CompleteWithUsage(ctx context.Context, prompt string, schema string) (string, Usage, error) {
+	key := cacheKey(p.Model, prompt, schema, p.Temperature)
+	if entry, ok := p.load(key); ok {
+		return entry.Response, entry.Usage, nil
+	}
+
+	text, usage, err := p.completeUpstream(ctx, prompt, schema)
+	if err != nil {
+		return "", Usage{}, err
+	}
+
+	entry := cacheEntry{
+		Model:       p.Model,
+		Prompt:      prompt,
+		Schema:      schema,
+		Temperature: p.Temperature,
+		Seed:        p.Seed,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Response:    text,
+		Usage:       usage,
+	}
+	if err := p.store(key, entry); err != nil {
+		return text, usage, fmt.Errorf("writing cache entry: %w", err)
+	}
+
+	return text, usage, nil
+}
+
+// completeUpstream calls Provider.CompleteWithUsage when it supports
+// usage reporting, falling back to plain Complete (with zero Usage)
+// otherwise.
+func (p *CachingProvider) completeUpstream(ctx context.Context, prompt string, schema string) (string, Usage, error) {
+	if up, ok := p.Provider.(UsageLLMProvider); ok {
+		return up.CompleteWithUsage(ctx, prompt, schema)
+	}
+	text, err := p.Provider.Complete(ctx, prompt, schema)
+	return text, Usage{}, err
+}
@@ -0,0 +1,129 @@
+package modelbased
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+// countingProvider counts Complete/CompleteWithUsage calls, so cache
+// tests can assert the upstream provider was (or wasn't) actually
+// invoked.
+type countingProvider struct {
+	calls    int
+	response string
+	usage    Usage
+}
+
+func (c *countingProvider) Complete(ctx context.Context, prompt string, schema string) (string, error) {
+	text, _, err := c.CompleteWithUsage(ctx, prompt, schema)
+	return text, err
+}
+
+func (c *countingProvider) CompleteWithUsage(ctx context.Context, prompt string, schema string) (string, Usage, error) {
+	c.calls++
+	return c.response, c.usage, nil
+}
+
+func TestCachingProviderCachesIdenticalPrompt(t *testing.T) {
+	inner := &countingProvider{response: "cached answer", usage: Usage{TotalTokens: 42}}
+	cache := NewCachingProvider(inner, t.TempDir())
+	cache.Model = "gpt-4o-mini"
+
+	for i := 0; i < 3; i++ {
+		text, usage, err := cache.CompleteWithUsage(context.Background(), "grade this", "")
+		if err != nil {
+			t.Fatalf("CompleteWithUsage() error = %v", err)
+		}
+		if text != "cached answer" {
+			t.Errorf("text = %q, want %q", text, "cached answer")
+		}
+		if usage.TotalTokens != 42 {
+			t.Errorf("usage.TotalTokens = %d, want 42", usage.TotalTokens)
+		}
+	}
+
+	if inner.calls != 1 {
+		t.Errorf("upstream calls = %d, want 1 (subsequent calls should hit the cache)", inner.calls)
+	}
+}
+
+func TestCachingProviderDistinguishesModelTemperature(t *testing.T) {
+	inner := &countingProvider{response: "answer"}
+	cache := NewCachingProvider(inner, t.TempDir())
+
+	cache.Model = "model-a"
+	if _, _, err := cache.CompleteWithUsage(context.Background(), "prompt", ""); err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+
+	cache.Model = "model-b"
+	if _, _, err := cache.CompleteWithUsage(context.Background(), "prompt", ""); err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+
+	cache.Temperature = 0.5
+	if _, _, err := cache.CompleteWithUsage(context.Background(), "prompt", ""); err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+
+	if inner.calls != 3 {
+		t.Errorf("upstream calls = %d, want 3 (model/temperature changes should miss the cache)", inner.calls)
+	}
+}
+
+func TestCachingProviderDistinguishesSchema(t *testing.T) {
+	inner := &countingProvider{response: "answer"}
+	cache := NewCachingProvider(inner, t.TempDir())
+	cache.Model = "model-a"
+
+	if _, _, err := cache.CompleteWithUsage(context.Background(), "prompt", "criterion-schema"); err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+	if _, _, err := cache.CompleteWithUsage(context.Background(), "prompt", "rubric-schema"); err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+
+	if inner.calls != 2 {
+		t.Errorf("upstream calls = %d, want 2 (different schemas should miss the cache)", inner.calls)
+	}
+}
+
+func TestCachingProviderEntryRecordsSeedAndTimestamp(t *testing.T) {
+	dir := t.TempDir()
+	inner := &countingProvider{response: "answer"}
+	cache := NewCachingProvider(inner, dir)
+	cache.Model = "gpt-4o-mini"
+	cache.Seed = 12345
+
+	if _, _, err := cache.CompleteWithUsage(context.Background(), "grade this", ""); err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+
+	key := cacheKey(cache.Model, "grade this", "", cache.Temperature)
+	entry, ok := cache.load(key)
+	if !ok {
+		t.Fatalf("expected a cache entry at %s", filepath.Join(dir, key+".json"))
+	}
+	if entry.Seed != 12345 {
+		t.Errorf("entry.Seed = %d, want 12345", entry.Seed)
+	}
+	if entry.Timestamp == "" {
+		t.Error("entry.Timestamp is empty, want a recorded time")
+	}
+}
+
+func TestCachingProviderSetModelForwardsToWrappedProvider(t *testing.T) {
+	inner := &fakeProvider{response: `{"score":1}`}
+	cache := NewCachingProvider(inner, t.TempDir())
+
+	cache.SetModel("claude-3-5-haiku-latest")
+	cache.SetTemperature(0.2)
+
+	if inner.model != "claude-3-5-haiku-latest" {
+		t.Errorf("inner.model = %q, want forwarded model", inner.model)
+	}
+	if inner.temp != 0.2 {
+		t.Errorf("inner.temp = %v, want 0.2", inner.temp)
+	}
+}
@@ -0,0 +1,122 @@
+package modelbased
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CriterionConfig is one user-defined grading dimension: its weight in
+// the overall score, the guidance text used as the LLM prompt's guidance
+// in evaluateCriteriaLLM (the heuristic evaluateCriteria keys on the
+// criterion's Name, not this text), an optional few-shot example to
+// steer the LLM prompt, and an optional per-criterion pass threshold.
+type CriterionConfig struct {
+	Name string `yaml:"name" json:"name"`
+	// Weight is this criterion's share of the overall weighted score;
+	// every criterion's Weight in a GraderConfig must sum to 1.0.
+	Weight float64 `yaml:"weight" json:"weight"`
+	// Rubric is the guidance text describing what this criterion judges.
+	Rubric string `yaml:"rubric" json:"rubric"`
+	// FewShot is an optional example response appended to the LLM prompt
+	// for this criterion.
+	FewShot string `yaml:"few_shot,omitempty" json:"few_shot,omitempty"`
+	// MinScore is the minimum Score (0-100) this criterion must reach to
+	// be considered passing on its own, independent of the weighted
+	// total; 0 means no per-criterion threshold is enforced.
+	MinScore float64 `yaml:"min_score,omitempty" json:"min_score,omitempty"`
+}
+
+// GraderConfig is the top-level shape of a LoadGraderConfig file: an
+// arbitrary set of weighted criteria plus the overall passing score.
+type GraderConfig struct {
+	Criteria []CriterionConfig `yaml:"criteria" json:"criteria"`
+	// PassingScore is the overall weighted-score threshold; 0 (the zero
+	// value) keeps NewSkillClarityGrader's default of 70.
+	PassingScore float64 `yaml:"passing_score,omitempty" json:"passing_score,omitempty"`
+}
+
+// defaultCriteria mirrors the four criteria NewSkillClarityGrader used
+// before criteria became configurable, so the zero-config path behaves
+// exactly as it always has.
+var defaultCriteria = []CriterionConfig{
+	{Name: "clear_instructions", Weight: 0.30, Rubric: criterionGuidance["clear_instructions"]},
+	{Name: "actionable_steps", Weight: 0.25, Rubric: criterionGuidance["actionable_steps"]},
+	{Name: "good_examples", Weight: 0.25, Rubric: criterionGuidance["good_examples"]},
+	{Name: "appropriate_scope", Weight: 0.20, Rubric: criterionGuidance["appropriate_scope"]},
+}
+
+// weightTolerance allows for the rounding error inherent in hand-writing
+// weights like 0.33/0.33/0.34.
+const weightTolerance = 0.001
+
+// validateGraderConfig checks that cfg has at least one criterion, every
+// criterion has a unique non-empty name and a positive weight, and the
+// weights sum to 1.0 within weightTolerance.
+func validateGraderConfig(cfg GraderConfig) error {
+	if len(cfg.Criteria) == 0 {
+		return fmt.Errorf("grader config must define at least one criterion")
+	}
+
+	seen := make(map[string]bool, len(cfg.Criteria))
+	totalWeight := 0.0
+	for i, c := range cfg.Criteria {
+		if c.Name == "" {
+			return fmt.Errorf("criterion %d: name is required", i)
+		}
+		if seen[c.Name] {
+			return fmt.Errorf("criterion %q: duplicate name", c.Name)
+		}
+		seen[c.Name] = true
+
+		if c.Weight <= 0 {
+			return fmt.Errorf("criterion %q: weight must be positive, got %v", c.Name, c.Weight)
+		}
+		totalWeight += c.Weight
+	}
+
+	if math.Abs(totalWeight-1.0) > weightTolerance {
+		return fmt.Errorf("criteria weights must sum to 1.0, got %v", totalWeight)
+	}
+
+	return nil
+}
+
+// LoadGraderConfig reads a GraderConfig from path (YAML, or JSON when
+// path ends in ".json"), validates it, and returns a SkillClarityGrader
+// built from it - equivalent to
+// NewSkillClarityGrader(WithCriteria(cfg.Criteria), WithPassingScore(cfg.PassingScore)),
+// except that WithPassingScore is left out when PassingScore is 0.
+func LoadGraderConfig(path string) (*SkillClarityGrader, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading grader config %s: %w", path, err)
+	}
+
+	var cfg GraderConfig
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("parsing grader config %s as JSON: %w", path, err)
+		}
+	} else {
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("parsing grader config %s as YAML: %w", path, err)
+		}
+	}
+
+	if err := validateGraderConfig(cfg); err != nil {
+		return nil, fmt.Errorf("invalid grader config %s: %w", path, err)
+	}
+
+	opts := []Option{WithCriteria(cfg.Criteria)}
+	if cfg.PassingScore != 0 {
+		opts = append(opts, WithPassingScore(cfg.PassingScore))
+	}
+
+	return NewSkillClarityGrader(opts...), nil
+}
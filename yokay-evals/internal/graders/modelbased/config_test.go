@@ -0,0 +1,183 @@
+package modelbased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateGraderConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     GraderConfig
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cfg: GraderConfig{Criteria: []CriterionConfig{
+				{Name: "a", Weight: 0.5},
+				{Name: "b", Weight: 0.5},
+			}},
+		},
+		{
+			name:    "no criteria",
+			cfg:     GraderConfig{},
+			wantErr: true,
+		},
+		{
+			name: "missing name",
+			cfg: GraderConfig{Criteria: []CriterionConfig{
+				{Weight: 1.0},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			cfg: GraderConfig{Criteria: []CriterionConfig{
+				{Name: "a", Weight: 0.5},
+				{Name: "a", Weight: 0.5},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "non-positive weight",
+			cfg: GraderConfig{Criteria: []CriterionConfig{
+				{Name: "a", Weight: 0},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "weights don't sum to one",
+			cfg: GraderConfig{Criteria: []CriterionConfig{
+				{Name: "a", Weight: 0.5},
+				{Name: "b", Weight: 0.2},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGraderConfig(tt.cfg)
+			if tt.wantErr && err == nil {
+				t.Error("validateGraderConfig() error = nil, want error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("validateGraderConfig() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestLoadGraderConfig_YAML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "grader.yaml")
+	contents := `
+criteria:
+  - name: security_considerations
+    weight: 0.6
+    rubric: Does the skill call out security-sensitive operations?
+    min_score: 60
+  - name: clear_instructions
+    weight: 0.4
+    rubric: Are the instructions unambiguous?
+passing_score: 65
+`
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	grader, err := LoadGraderConfig(path)
+	if err != nil {
+		t.Fatalf("LoadGraderConfig() error = %v", err)
+	}
+
+	result, err := grader.Grade(GradeInput{Content: "this skill covers security_considerations and clear_instructions in depth"})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+
+	for _, name := range []string{"security_considerations", "clear_instructions"} {
+		if _, ok := result.Details[name]; !ok {
+			t.Errorf("Details missing configured criterion %q", name)
+		}
+	}
+}
+
+func TestLoadGraderConfig_JSON(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "grader.json")
+	contents := `{
+		"criteria": [
+			{"name": "only_criterion", "weight": 1.0, "rubric": "Is it good?"}
+		]
+	}`
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	grader, err := LoadGraderConfig(path)
+	if err != nil {
+		t.Fatalf("LoadGraderConfig() error = %v", err)
+	}
+
+	result, err := grader.Grade(GradeInput{Content: "some content"})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if _, ok := result.Details["only_criterion"]; !ok {
+		t.Error("Details missing configured criterion \"only_criterion\"")
+	}
+}
+
+func TestLoadGraderConfig_InvalidWeightsError(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "grader.yaml")
+	contents := `
+criteria:
+  - name: a
+    weight: 0.9
+`
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	if _, err := LoadGraderConfig(path); err == nil {
+		t.Error("LoadGraderConfig() error = nil, want error for weights not summing to 1.0")
+	}
+}
+
+func TestSkillClarityGrader_PerCriterionPassThreshold(t *testing.T) {
+	grader := NewSkillClarityGrader(WithCriteria([]CriterionConfig{
+		{Name: "clear_instructions", Weight: 1.0, Rubric: "x", MinScore: 80},
+	}))
+
+	result, err := grader.Grade(GradeInput{Content: "no matching keyword here"})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+
+	details, ok := result.Details["clear_instructions"].(map[string]any)
+	if !ok {
+		t.Fatal("Details[\"clear_instructions\"] is not a map[string]any")
+	}
+	if details["passed"] != false {
+		t.Errorf("Details[\"clear_instructions\"][\"passed\"] = %v, want false (score 50 < min_score 80)", details["passed"])
+	}
+}
+
+func TestSkillClarityGrader_WithPassingScore(t *testing.T) {
+	grader := NewSkillClarityGrader(WithPassingScore(10))
+
+	result, err := grader.Grade(GradeInput{Content: "## Instructions\n- step\n## Examples\nstuff"})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if !result.Passed {
+		t.Errorf("Passed = false, want true with a passing score of 10 and a heuristic score well above it")
+	}
+	if !strings.Contains(result.Message, "passed") {
+		t.Errorf("Message = %q, want it to report a pass", result.Message)
+	}
+}
@@ -0,0 +1,119 @@
+package modelbased
+
+import "fmt"
+
+// Evaluator runs a named, weighted set of Graders (clarity, reference,
+// or any custom implementation of the Grader interface) over a dataset
+// of GradeInputs and aggregates their scores per example, so a caller
+// can judge a skill document - or a whole dataset - by more than one
+// dimension at once instead of picking a single grader.
+type Evaluator struct {
+	graders map[string]Grader
+	weights map[string]float64
+}
+
+// NewEvaluator creates an Evaluator with no registered graders; call
+// Register to add them before Evaluate.
+func NewEvaluator() *Evaluator {
+	return &Evaluator{
+		graders: make(map[string]Grader),
+		weights: make(map[string]float64),
+	}
+}
+
+// Register adds a named Grader to the evaluator with the given weight.
+// Weights don't need to sum to 1: Evaluate normalizes by the sum of
+// weights of the graders that actually ran for each example. Calling
+// Register again with the same name replaces that grader.
+func (e *Evaluator) Register(name string, grader Grader, weight float64) {
+	e.graders[name] = grader
+	e.weights[name] = weight
+}
+
+// ExampleResult is one dataset item's result from every registered
+// grader, plus the weighted aggregate across them.
+type ExampleResult struct {
+	Input          GradeInput
+	GraderResults  map[string]Result
+	AggregateScore float64
+}
+
+// EvaluationReport is Evaluate's output: one ExampleResult per dataset
+// item, plus the dataset-wide average aggregate score and pass rate
+// (an example "passes" when every registered grader's Result.Passed is
+// true).
+type EvaluationReport struct {
+	Examples     []ExampleResult
+	AverageScore float64
+	PassRate     float64
+}
+
+// Evaluate runs every registered grader over every item in dataset and
+// returns the aggregated report. It returns an error, abandoning the
+// rest of the dataset, if any grader's Grade call fails - callers
+// grading an untrusted or best-effort dataset should wrap individual
+// graders to recover from their own errors instead.
+func (e *Evaluator) Evaluate(dataset []GradeInput) (EvaluationReport, error) {
+	examples := make([]ExampleResult, 0, len(dataset))
+	totalScore := 0.0
+	passed := 0
+
+	for _, input := range dataset {
+		example, err := e.evaluateOne(input)
+		if err != nil {
+			return EvaluationReport{}, err
+		}
+		examples = append(examples, example)
+		totalScore += example.AggregateScore
+		if allPassed(example.GraderResults) {
+			passed++
+		}
+	}
+
+	report := EvaluationReport{Examples: examples}
+	if len(dataset) > 0 {
+		report.AverageScore = totalScore / float64(len(dataset))
+		report.PassRate = float64(passed) / float64(len(dataset))
+	}
+
+	return report, nil
+}
+
+// evaluateOne runs every registered grader over a single input and
+// computes its weighted aggregate score.
+func (e *Evaluator) evaluateOne(input GradeInput) (ExampleResult, error) {
+	graderResults := make(map[string]Result, len(e.graders))
+	weightedSum, totalWeight := 0.0, 0.0
+
+	for name, grader := range e.graders {
+		result, err := grader.Grade(input)
+		if err != nil {
+			return ExampleResult{}, fmt.Errorf("grader %q: %w", name, err)
+		}
+		graderResults[name] = result
+
+		weight := e.weights[name]
+		if weight == 0 {
+			weight = 1
+		}
+		weightedSum += result.Score * weight
+		totalWeight += weight
+	}
+
+	aggregate := 0.0
+	if totalWeight > 0 {
+		aggregate = weightedSum / totalWeight
+	}
+
+	return ExampleResult{Input: input, GraderResults: graderResults, AggregateScore: aggregate}, nil
+}
+
+// allPassed reports whether every grader result in results passed.
+func allPassed(results map[string]Result) bool {
+	for _, result := range results {
+		if !result.Passed {
+			return false
+		}
+	}
+	return true
+}
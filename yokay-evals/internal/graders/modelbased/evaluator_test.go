@@ -0,0 +1,80 @@
+package modelbased
+
+import (
+	"errors"
+	"testing"
+)
+
+// stubGrader is a minimal Grader for exercising Evaluator/HillClimb
+// without a real provider or heuristic.
+type stubGrader struct {
+	score float64
+	pass  bool
+	err   error
+}
+
+func (g stubGrader) Grade(input GradeInput) (Result, error) {
+	if g.err != nil {
+		return Result{}, g.err
+	}
+	return Result{Score: g.score, Passed: g.pass, Message: "stub"}, nil
+}
+
+func TestEvaluator_EvaluateAggregatesWeightedScores(t *testing.T) {
+	e := NewEvaluator()
+	e.Register("clarity", stubGrader{score: 80, pass: true}, 0.7)
+	e.Register("reference", stubGrader{score: 60, pass: true}, 0.3)
+
+	report, err := e.Evaluate([]GradeInput{{Content: "example"}})
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(report.Examples) != 1 {
+		t.Fatalf("len(Examples) = %d, want 1", len(report.Examples))
+	}
+
+	want := 80*0.7 + 60*0.3
+	got := report.Examples[0].AggregateScore
+	if got != want {
+		t.Errorf("AggregateScore = %v, want %v", got, want)
+	}
+	if report.PassRate != 1.0 {
+		t.Errorf("PassRate = %v, want 1.0", report.PassRate)
+	}
+}
+
+func TestEvaluator_PassRateRequiresAllGradersToPass(t *testing.T) {
+	e := NewEvaluator()
+	e.Register("clarity", stubGrader{score: 90, pass: true}, 1)
+	e.Register("reference", stubGrader{score: 40, pass: false}, 1)
+
+	report, err := e.Evaluate([]GradeInput{{Content: "example"}})
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if report.PassRate != 0.0 {
+		t.Errorf("PassRate = %v, want 0.0 when one registered grader fails", report.PassRate)
+	}
+}
+
+func TestEvaluator_EvaluatePropagatesGraderError(t *testing.T) {
+	e := NewEvaluator()
+	e.Register("broken", stubGrader{err: errors.New("boom")}, 1)
+
+	if _, err := e.Evaluate([]GradeInput{{Content: "example"}}); err == nil {
+		t.Error("Evaluate() error = nil, want an error from the failing grader")
+	}
+}
+
+func TestEvaluator_EvaluateEmptyDataset(t *testing.T) {
+	e := NewEvaluator()
+	e.Register("clarity", stubGrader{score: 90, pass: true}, 1)
+
+	report, err := e.Evaluate(nil)
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if report.AverageScore != 0 || report.PassRate != 0 {
+		t.Errorf("Evaluate(nil) = %+v, want zero-valued report", report)
+	}
+}
@@ -0,0 +1,223 @@
+package modelbased
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Judge scores a single G-Eval-style form-filling prompt (see
+// buildGEvalPrompt). It returns the raw sampled integer score as a
+// float64 - used as a fallback when logprobs aren't available - a map
+// of every candidate 1-N score token the backend reported a
+// log-probability for, and the chain-of-thought rationale the model
+// produced before emitting that score. logprobs is nil when the backend
+// doesn't expose token logprobs (e.g. a provider without logprob
+// support, or a non-OpenAI-shaped API).
+type Judge interface {
+	Score(ctx context.Context, prompt string) (score float64, logprobs map[int]float64, rationale string, err error)
+}
+
+// gEvalMinScore and gEvalMaxScore bound the Likert scale every
+// criterionRubric's anchors describe, following the G-Eval paper's
+// form-filling paradigm (Liu et al., 2023, "G-Eval: NLG Evaluation using
+// GPT-4 with Better Human Alignment").
+const gEvalMinScore, gEvalMaxScore = 1, 5
+
+// criterionRubric is one criterion's G-Eval prompt material: its
+// definition, one rubric-anchor description per point on the 1-5 scale,
+// and a single few-shot example demonstrating the expected "Evaluation
+// Steps" + score output shape.
+type criterionRubric struct {
+	Definition string
+	Anchors    [gEvalMaxScore]string
+	FewShot    string
+}
+
+// criterionRubrics holds the G-Eval prompt material for each of
+// SkillClarityGrader's four criteria. Keep these in sync with
+// criterionGuidance (the simpler single-sentence prompts
+// evaluateCriteriaLLM uses) - they grade the same dimensions, just with
+// a richer rubric and chain-of-thought.
+var criterionRubrics = map[string]criterionRubric{
+	"clear_instructions": {
+		Definition: "Clear Instructions - whether the skill's instructions are unambiguous and easy for an agent to follow without guessing.",
+		Anchors: [gEvalMaxScore]string{
+			"1: Instructions are missing, contradictory, or so vague the agent cannot act on them.",
+			"2: Instructions exist but leave major ambiguity about what to do or when.",
+			"3: Instructions are understandable but require some inference to execute correctly.",
+			"4: Instructions are clear with only minor ambiguity in edge cases.",
+			"5: Instructions are unambiguous, ordered, and leave no room for misinterpretation.",
+		},
+		FewShot: `Evaluation Steps:
+1. The skill states "Run the linter, then fix every reported issue before committing."
+2. This is a single, ordered, unambiguous sequence of actions.
+3. No step requires guessing what "fix every reported issue" means.
+Score: 5`,
+	},
+	"actionable_steps": {
+		Definition: "Actionable Steps - whether the skill's steps are concrete, ordered, and directly executable rather than abstract advice.",
+		Anchors: [gEvalMaxScore]string{
+			"1: No steps are given, only abstract goals.",
+			"2: Steps are listed but are too vague to execute directly.",
+			"3: Steps are mostly concrete but skip details needed to execute some of them.",
+			"4: Steps are concrete and ordered, with only minor gaps.",
+			"5: Every step is concrete, ordered, and directly executable as written.",
+		},
+		FewShot: `Evaluation Steps:
+1. The skill lists "1. Open the file. 2. Replace the deprecated call. 3. Run the tests."
+2. Each step names a specific, executable action in sequence.
+3. Nothing requires the agent to invent intermediate steps.
+Score: 5`,
+	},
+	"good_examples": {
+		Definition: "Good Examples - whether the skill's examples are realistic, representative, and genuinely clarify how to apply the instructions.",
+		Anchors: [gEvalMaxScore]string{
+			"1: No examples are given.",
+			"2: Examples exist but are contrived or unrelated to real usage.",
+			"3: Examples are plausible but too sparse to cover the common cases.",
+			"4: Examples are realistic and cover most common cases.",
+			"5: Examples are realistic, representative, and cover the common and edge cases.",
+		},
+		FewShot: `Evaluation Steps:
+1. The skill includes a worked example showing input, command, and expected output.
+2. The example matches a real usage pattern described in the instructions.
+3. A second example covers an edge case (an empty input).
+Score: 5`,
+	},
+	"appropriate_scope": {
+		Definition: "Appropriate Scope - whether the skill is focused on one coherent task, neither so broad it tries to do everything nor so narrow it's useless on its own.",
+		Anchors: [gEvalMaxScore]string{
+			"1: The skill has no discernible focus, or covers a single trivial action not worth a skill.",
+			"2: The skill is noticeably too broad or too narrow for its stated purpose.",
+			"3: The skill's scope is workable but could be split or broadened.",
+			"4: The skill's scope is well-matched to its purpose with minor room to tighten.",
+			"5: The skill is focused on exactly one coherent task at the right level of granularity.",
+		},
+		FewShot: `Evaluation Steps:
+1. The skill's stated purpose is "review a pull request diff for common bug patterns."
+2. Every instruction and example serves that one purpose.
+3. It doesn't also try to cover deployment or release management.
+Score: 5`,
+	},
+}
+
+// gEvalPromptTemplate is the form-filling prompt every criterion is
+// scored with: a definition, rubric anchors, instructions to reason step
+// by step under "Evaluation Steps:" before scoring, a few-shot example,
+// and finally the skill documentation under evaluation.
+const gEvalPromptTemplate = `You are evaluating pokayokay skill documentation using the G-Eval method.
+
+Criterion: %s
+
+Scoring rubric (1-5):
+%s
+
+First think step by step under "Evaluation Steps:", listing the concrete reasoning that leads to your score. Then, on its own line, output "Score: <N>" where N is a single integer from 1 to 5. Do not output anything after the score line.
+
+Example:
+%s
+
+Skill documentation to evaluate:
+%s
+`
+
+// buildGEvalPrompt renders gEvalPromptTemplate for the named criterion,
+// or an error if no criterionRubric is registered for it.
+func buildGEvalPrompt(name, content string) (string, error) {
+	rubric, ok := criterionRubrics[name]
+	if !ok {
+		return "", fmt.Errorf("no G-Eval rubric registered for criterion %q", name)
+	}
+	anchors := strings.Join(rubric.Anchors[:], "\n")
+	return fmt.Sprintf(gEvalPromptTemplate, rubric.Definition, anchors, rubric.FewShot, content), nil
+}
+
+// gEvalExpectation computes the G-Eval probability-weighted score: the
+// expectation sum(i * softmax(logprob_i)) over every candidate score
+// token the judge reported a log-probability for. This is the paper's
+// key smoothing step - it turns a single bucketed integer sample into a
+// continuous score, which correlates better with human judgment than
+// the raw sampled token.
+func gEvalExpectation(logprobs map[int]float64) float64 {
+	if len(logprobs) == 0 {
+		return 0
+	}
+
+	maxLogprob := math.Inf(-1)
+	for _, lp := range logprobs {
+		if lp > maxLogprob {
+			maxLogprob = lp
+		}
+	}
+
+	var sumExp, weighted float64
+	for score, lp := range logprobs {
+		// Subtracting the max log-probability before exponentiating is
+		// the standard softmax stabilization trick: it cancels out in the
+		// final ratio but keeps exp() from overflowing on logprobs near 0
+		// or underflowing on very negative ones.
+		p := math.Exp(lp - maxLogprob)
+		sumExp += p
+		weighted += float64(score) * p
+	}
+
+	return weighted / sumExp
+}
+
+// scaleToHundred maps a score on the 1-5 G-Eval scale (whether a
+// smoothed expectation or a raw integer fallback) onto the grader's
+// 0-100 scale, so G-Eval criteria compose with the heuristic and
+// LLMProvider-based ones in the same weighted sum.
+func scaleToHundred(score float64) float64 {
+	return (score - gEvalMinScore) / (gEvalMaxScore - gEvalMinScore) * 100
+}
+
+// summarizeRationale extracts a one-line feedback summary from a judge's
+// full chain-of-thought rationale, for Details[name]["feedback"]; the
+// full rationale itself still goes into Details[name]["reasoning"].
+func summarizeRationale(rationale string) string {
+	for _, line := range strings.Split(rationale, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.EqualFold(line, "Evaluation Steps:") || strings.HasPrefix(strings.ToLower(line), "score:") {
+			continue
+		}
+		return line
+	}
+	return "G-Eval judge provided no rationale."
+}
+
+// evaluateCriteriaGEval scores each of criterionRubrics's criteria via
+// g.judge, smoothing the result through gEvalExpectation when logprobs
+// are available and falling back to the judge's raw sampled score
+// otherwise. It returns an error (leaving Grade to fall back further) if
+// any criterion's prompt can't be built or its Judge call fails.
+func (g *SkillClarityGrader) evaluateCriteriaGEval(ctx context.Context, content string) (map[string]Criterion, error) {
+	criteria := make(map[string]Criterion, len(criterionRubrics))
+
+	for name := range criterionRubrics {
+		prompt, err := buildGEvalPrompt(name, content)
+		if err != nil {
+			return nil, err
+		}
+
+		rawScore, logprobs, rationale, err := g.judge.Score(ctx, prompt)
+		if err != nil {
+			return nil, fmt.Errorf("G-Eval scoring %s: %w", name, err)
+		}
+
+		score := rawScore
+		if len(logprobs) > 0 {
+			score = gEvalExpectation(logprobs)
+		}
+
+		criteria[name] = Criterion{
+			Score:     scaleToHundred(score),
+			Feedback:  summarizeRationale(rationale),
+			Reasoning: rationale,
+		}
+	}
+
+	return criteria, nil
+}
@@ -0,0 +1,166 @@
+package modelbased
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestGEvalExpectation(t *testing.T) {
+	tests := []struct {
+		name     string
+		logprobs map[int]float64
+		want     float64
+		epsilon  float64
+	}{
+		{
+			name:     "empty logprobs",
+			logprobs: map[int]float64{},
+			want:     0,
+		},
+		{
+			name:     "single candidate returns that score",
+			logprobs: map[int]float64{5: -0.01},
+			want:     5,
+			epsilon:  0.001,
+		},
+		{
+			name:     "dominant candidate pulls expectation toward it",
+			logprobs: map[int]float64{5: -0.01, 4: -10, 1: -20},
+			want:     5,
+			epsilon:  0.01,
+		},
+		{
+			name:     "even split averages the two candidates",
+			logprobs: map[int]float64{3: 0, 5: 0},
+			want:     4,
+			epsilon:  0.001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gEvalExpectation(tt.logprobs)
+			if math.Abs(got-tt.want) > tt.epsilon {
+				t.Errorf("gEvalExpectation(%v) = %v, want %v (+/- %v)", tt.logprobs, got, tt.want, tt.epsilon)
+			}
+		})
+	}
+}
+
+func TestScaleToHundred(t *testing.T) {
+	tests := []struct {
+		score float64
+		want  float64
+	}{
+		{score: 1, want: 0},
+		{score: 3, want: 50},
+		{score: 5, want: 100},
+	}
+
+	for _, tt := range tests {
+		got := scaleToHundred(tt.score)
+		if math.Abs(got-tt.want) > 0.001 {
+			t.Errorf("scaleToHundred(%v) = %v, want %v", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestBuildGEvalPrompt(t *testing.T) {
+	prompt, err := buildGEvalPrompt("clear_instructions", "some skill content")
+	if err != nil {
+		t.Fatalf("buildGEvalPrompt() error = %v", err)
+	}
+	for _, want := range []string{"Clear Instructions", "Evaluation Steps:", "Score:", "some skill content"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt missing %q:\n%s", want, prompt)
+		}
+	}
+
+	if _, err := buildGEvalPrompt("not_a_criterion", "content"); err == nil {
+		t.Error("buildGEvalPrompt() with unknown criterion should error")
+	}
+}
+
+func TestSummarizeRationale(t *testing.T) {
+	rationale := "Evaluation Steps:\n1. The skill has a heading.\n2. It reads clearly.\nScore: 4"
+	got := summarizeRationale(rationale)
+	if got != "1. The skill has a heading." {
+		t.Errorf("summarizeRationale() = %q, want first reasoning line", got)
+	}
+
+	if got := summarizeRationale(""); got == "" {
+		t.Error("summarizeRationale(\"\") should return a non-empty fallback")
+	}
+}
+
+// fakeJudge is a stub Judge for exercising WithJudge without a real model
+// call, mirroring fakeProvider above.
+type fakeJudge struct {
+	score     float64
+	logprobs  map[int]float64
+	rationale string
+	err       error
+}
+
+func (f *fakeJudge) Score(ctx context.Context, prompt string) (float64, map[int]float64, string, error) {
+	if f.err != nil {
+		return 0, nil, "", f.err
+	}
+	return f.score, f.logprobs, f.rationale, nil
+}
+
+func TestSkillClarityGrader_WithJudgeUsesGEvalScores(t *testing.T) {
+	judge := &fakeJudge{
+		logprobs:  map[int]float64{5: 0},
+		rationale: "Evaluation Steps:\n1. Looks great.\nScore: 5",
+	}
+	grader := NewSkillClarityGraderWithJudge(judge)
+
+	result, err := grader.Grade(GradeInput{Content: "some skill content", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if result.Score != 100 {
+		t.Errorf("Score = %v, want 100 (every criterion scored 5/5 by the fake judge)", result.Score)
+	}
+	if strings.Contains(result.Message, "stub evaluation") {
+		t.Errorf("Message = %q, should not mention stub evaluation when the G-Eval path succeeded", result.Message)
+	}
+
+	details, ok := result.Details["clear_instructions"].(map[string]any)
+	if !ok {
+		t.Fatal("Details[\"clear_instructions\"] is not a map[string]any")
+	}
+	if details["reasoning"] != judge.rationale {
+		t.Errorf("Details[\"clear_instructions\"][\"reasoning\"] = %v, want %q", details["reasoning"], judge.rationale)
+	}
+}
+
+func TestSkillClarityGrader_WithJudgeFallsBackOnError(t *testing.T) {
+	judge := &fakeJudge{err: errors.New("model error")}
+	grader := NewSkillClarityGraderWithJudge(judge)
+
+	result, err := grader.Grade(GradeInput{Content: "## Instructions\n- step one\n## Examples\nstuff", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if !strings.Contains(result.Message, "stub evaluation") {
+		t.Errorf("Message = %q, want fallback to mention stub evaluation when the judge failed", result.Message)
+	}
+}
+
+func TestSkillClarityGrader_WithJudgeFallsBackToRawScoreWithoutLogprobs(t *testing.T) {
+	judge := &fakeJudge{score: 4, rationale: "Evaluation Steps:\n1. Solid.\nScore: 4"}
+	grader := NewSkillClarityGraderWithJudge(judge)
+
+	result, err := grader.Grade(GradeInput{Content: "some skill content", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if result.Score != 75 {
+		t.Errorf("Score = %v, want 75 (every criterion scored 4/5 by the fake judge, no logprobs to smooth)", result.Score)
+	}
+}
@@ -13,6 +13,16 @@ type GradeInput struct {
 	Content string
 	// Context provides additional metadata or parameters for grading
 	Context map[string]any
+	// Kind identifies the artifact being graded (e.g. "skill",
+	// "eval-run"). Plugin graders (see internal/graders/plugin) declare
+	// which kinds they support via --describe; built-in graders that
+	// only ever see one kind can leave it unset.
+	Kind string
+	// Reference is an optional gold-standard document to grade Content
+	// against instead of (or in addition to) a fixed rubric. Only
+	// reference-based graders (see SkillReferenceGrader) consult it;
+	// reference-free graders like SkillClarityGrader ignore it.
+	Reference string
 }
 
 // Result represents the grading outcome
@@ -0,0 +1,126 @@
+package modelbased
+
+import "fmt"
+
+// DevExample is one labeled item in a HillClimb dev set: an input to
+// grade, paired with the score a human labeler assigned it, so a
+// candidate prompt can be judged by how closely its grader's scores
+// track the labels.
+type DevExample struct {
+	Input         GradeInput
+	ExpectedScore float64
+}
+
+// PromptMutator proposes a new candidate prompt template derived from
+// current - e.g. swapping in a different few-shot example, or asking an
+// LLM to reword the rubric - for HillClimb.Run to try next.
+type PromptMutator func(current string) (string, error)
+
+// GraderFactory builds a Grader from a candidate prompt template, so
+// HillClimb can score that candidate against the dev set without
+// knowing which concrete grader (SkillClarityGrader, a G-Eval Judge,
+// ...) the prompt belongs to.
+type GraderFactory func(promptTemplate string) Grader
+
+// Experiment is one hill-climbing iteration's outcome: the prompt that
+// was tried, its aggregate score on the dev set, and the score it got on
+// each dev example individually - so a caller can see exactly which
+// examples regressed between two experiments, not just the aggregate.
+type Experiment struct {
+	Prompt           string
+	Score            float64
+	PerExampleScores []float64
+}
+
+// HillClimb iteratively searches for a better grader prompt: each
+// iteration mutates the current best prompt, scores the mutation's
+// grader against DevSet, and keeps the mutation as the new best only if
+// it beats the previous best by at least Margin. This turns grader
+// prompt design from a one-shot guess into an actual improvement loop,
+// with every attempt (kept or discarded) recorded for inspection.
+type HillClimb struct {
+	// NewGrader builds a Grader from a candidate prompt template.
+	NewGrader GraderFactory
+	// DevSet is the small labeled set every candidate is scored
+	// against.
+	DevSet []DevExample
+	// Mutate proposes the next candidate prompt from the current best.
+	Mutate PromptMutator
+	// Margin is how much a candidate's aggregate score must exceed the
+	// current best's to be accepted; a margin of 0 accepts any
+	// improvement, however small.
+	Margin float64
+}
+
+// Run executes up to iterations hill-climbing steps starting from
+// initialPrompt, returning one Experiment per prompt tried (the initial
+// prompt first, then one per iteration, in order) regardless of whether
+// that prompt was ultimately kept. It stops early and returns an error,
+// along with the experiments run so far, if Mutate or a grader's Grade
+// call fails.
+func (h *HillClimb) Run(initialPrompt string, iterations int) ([]Experiment, error) {
+	best := initialPrompt
+	bestExperiment, err := h.score(best)
+	if err != nil {
+		return nil, fmt.Errorf("scoring initial prompt: %w", err)
+	}
+	experiments := []Experiment{bestExperiment}
+
+	for i := 0; i < iterations; i++ {
+		candidate, err := h.Mutate(best)
+		if err != nil {
+			return experiments, fmt.Errorf("mutating prompt on iteration %d: %w", i, err)
+		}
+
+		candidateExperiment, err := h.score(candidate)
+		if err != nil {
+			return experiments, fmt.Errorf("scoring candidate on iteration %d: %w", i, err)
+		}
+		experiments = append(experiments, candidateExperiment)
+
+		if candidateExperiment.Score-bestExperiment.Score >= h.Margin {
+			best = candidate
+			bestExperiment = candidateExperiment
+		}
+	}
+
+	return experiments, nil
+}
+
+// score builds a grader from prompt and runs it over every DevSet
+// example, scoring each one by how closely the grader's Result.Score
+// tracks that example's ExpectedScore label (100 minus the absolute
+// difference, floored at 0) - not by the raw grader score itself, since
+// a prompt that grades everything a flat 100 would otherwise look
+// perfect on an unlabeled dev set.
+func (h *HillClimb) score(prompt string) (Experiment, error) {
+	grader := h.NewGrader(prompt)
+
+	scores := make([]float64, len(h.DevSet))
+	total := 0.0
+	for i, example := range h.DevSet {
+		result, err := grader.Grade(example.Input)
+		if err != nil {
+			return Experiment{}, fmt.Errorf("grading dev example %d: %w", i, err)
+		}
+
+		diff := result.Score - example.ExpectedScore
+		if diff < 0 {
+			diff = -diff
+		}
+		alignment := 100 - diff
+		if alignment < 0 {
+			alignment = 0
+		}
+
+		scores[i] = alignment
+		total += alignment
+	}
+
+	avg := 0.0
+	if len(h.DevSet) > 0 {
+		avg = total / float64(len(h.DevSet))
+	}
+
+	return Experiment{Prompt: prompt, Score: avg, PerExampleScores: scores}, nil
+}
@@ -0,0 +1,106 @@
+package modelbased
+
+import (
+	"errors"
+	"testing"
+)
+
+// promptScoredGrader is a stubGrader whose score depends on the prompt
+// template it was built from, for exercising HillClimb without a real
+// LLM judge.
+type promptScoredGrader struct {
+	score float64
+}
+
+func (g promptScoredGrader) Grade(input GradeInput) (Result, error) {
+	return Result{Score: g.score, Passed: true}, nil
+}
+
+func TestHillClimb_RunKeepsCandidateThatBeatsMargin(t *testing.T) {
+	prompts := map[string]float64{
+		"initial": 50,
+		"better":  90,
+	}
+
+	hc := &HillClimb{
+		NewGrader: func(prompt string) Grader { return promptScoredGrader{score: prompts[prompt]} },
+		DevSet:    []DevExample{{Input: GradeInput{Content: "ex1"}, ExpectedScore: prompts["better"]}},
+		Mutate:    func(current string) (string, error) { return "better", nil },
+		Margin:    5,
+	}
+
+	experiments, err := hc.Run("initial", 1)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(experiments) != 2 {
+		t.Fatalf("len(experiments) = %d, want 2 (initial + 1 iteration)", len(experiments))
+	}
+	if experiments[0].Prompt != "initial" {
+		t.Errorf("experiments[0].Prompt = %q, want %q", experiments[0].Prompt, "initial")
+	}
+	if experiments[1].Prompt != "better" {
+		t.Errorf("experiments[1].Prompt = %q, want %q", experiments[1].Prompt, "better")
+	}
+	// "better"'s grader score (90) exactly matches the dev example's
+	// ExpectedScore, so its alignment score is a perfect 100.
+	if experiments[1].Score != 100 {
+		t.Errorf("experiments[1].Score = %v, want 100", experiments[1].Score)
+	}
+}
+
+func TestHillClimb_RunDiscardsCandidateBelowMargin(t *testing.T) {
+	prompts := map[string]float64{
+		"initial":       70,
+		"barely-better": 71,
+	}
+
+	var lastGrader string
+	hc := &HillClimb{
+		NewGrader: func(prompt string) Grader {
+			lastGrader = prompt
+			return promptScoredGrader{score: prompts[prompt]}
+		},
+		DevSet: []DevExample{{Input: GradeInput{Content: "ex1"}, ExpectedScore: 100}},
+		Mutate: func(current string) (string, error) { return "barely-better", nil },
+		Margin: 10,
+	}
+
+	experiments, err := hc.Run("initial", 1)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(experiments) != 2 {
+		t.Fatalf("len(experiments) = %d, want 2", len(experiments))
+	}
+	if lastGrader != "barely-better" {
+		t.Fatalf("last grader built from prompt %q, want %q", lastGrader, "barely-better")
+	}
+
+	// The second iteration should still be scored against "initial" as
+	// the current best, since "barely-better" didn't clear the margin.
+	secondRun, err := hc.Run("initial", 2)
+	if err != nil {
+		t.Fatalf("second Run() error = %v", err)
+	}
+	if secondRun[1].Prompt != secondRun[2].Prompt {
+		t.Errorf("both mutation attempts should propose the same candidate from the same unchanged best prompt")
+	}
+}
+
+func TestHillClimb_RunPropagatesMutateError(t *testing.T) {
+	hc := &HillClimb{
+		NewGrader: func(prompt string) Grader { return promptScoredGrader{score: 50} },
+		DevSet:    []DevExample{{Input: GradeInput{Content: "ex1"}, ExpectedScore: 50}},
+		Mutate:    func(current string) (string, error) { return "", errors.New("mutation failed") },
+		Margin:    0,
+	}
+
+	experiments, err := hc.Run("initial", 1)
+	if err == nil {
+		t.Fatal("Run() error = nil, want an error from the failing mutator")
+	}
+	if len(experiments) != 1 {
+		t.Errorf("len(experiments) = %d, want 1 (just the initial prompt's experiment)", len(experiments))
+	}
+}
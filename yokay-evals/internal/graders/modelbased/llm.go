@@ -0,0 +1,64 @@
+package modelbased
+
+import (
+	"context"
+	"errors"
+)
+
+// LLMProvider completes a single grading prompt against a hosted or
+// local model and returns its raw text response. SkillClarityGrader
+// parses that text as JSON itself (see evaluateCriteriaLLM), so a
+// provider only needs to speak "send prompt, get text back" - schema is
+// passed through for providers that support constrained/structured
+// output (e.g. OpenAI's response_format) to use; providers that don't
+// support it may ignore it and rely on the prompt's own instructions.
+type LLMProvider interface {
+	Complete(ctx context.Context, prompt string, schema string) (string, error)
+}
+
+// ErrNoProvider is returned by NoopProvider.Complete, and (wrapped) by
+// RubricGrader.Grade when no provider is configured. SkillClarityGrader
+// and SkillReferenceGrader never call a NoopProvider: with no provider,
+// or with a configured one that fails, they fall back to their offline
+// heuristics, and their Message carries the same heuristic note in both
+// cases.
+var ErrNoProvider = errors.New("modelbased: no LLM provider configured")
+
+// NoopProvider is the zero-value LLMProvider: it never calls out to a
+// model. It's the default in NewSkillClarityGrader so `grade-skills`
+// keeps working offline without an API key; pass WithProvider to opt
+// into LLM-backed grading.
+type NoopProvider struct{}
+
+func (NoopProvider) Complete(ctx context.Context, prompt string, schema string) (string, error) {
+	return "", ErrNoProvider
+}
+
+// configurableProvider is implemented by every concrete LLMProvider in
+// this package (but not NoopProvider) so WithModel/WithTemperature can
+// reach whichever provider WithProvider installed, regardless of the
+// order the two options are given in.
+type configurableProvider interface {
+	SetModel(model string)
+	SetTemperature(temperature float64)
+}
+
+// Usage is a provider's token accounting for a single Complete call, when
+// available.
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+}
+
+// UsageLLMProvider is an LLMProvider that can also report the token usage
+// of a single Complete call. Usage is returned directly from the call
+// rather than stored on the provider, so concurrent callers sharing one
+// provider instance (e.g. runEvaluation's worker pool, see
+// cmd/yokay-evals/eval.go) never race reading it. Implemented by every
+// concrete provider in this package; callers that only need the
+// response text can keep using the plain LLMProvider.Complete.
+type UsageLLMProvider interface {
+	LLMProvider
+	CompleteWithUsage(ctx context.Context, prompt string, schema string) (text string, usage Usage, err error)
+}
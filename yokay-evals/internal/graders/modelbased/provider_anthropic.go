@@ -0,0 +1,120 @@
+package modelbased
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AnthropicProvider completes prompts against the Anthropic Messages
+// API. BaseURL and HTTPClient are exported so tests can point it at an
+// httptest.Server instead of the real API.
+type AnthropicProvider struct {
+	APIKey      string
+	Model       string
+	Temperature float64
+	MaxTokens   int
+	BaseURL     string
+	HTTPClient  *http.Client
+}
+
+// NewAnthropicProvider returns an AnthropicProvider defaulting to
+// claude-3-5-haiku-latest at the standard Anthropic API base URL.
+func NewAnthropicProvider(apiKey string) *AnthropicProvider {
+	return &AnthropicProvider{
+		APIKey:     apiKey,
+		Model:      "claude-3-5-haiku-latest",
+		MaxTokens:  1024,
+		BaseURL:    "https://api.anthropic.com",
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+func (p *AnthropicProvider) SetModel(model string)              { p.Model = model }
+func (p *AnthropicProvider) SetTemperature(temperature float64) { p.Temperature = temperature }
+
+type anthropicMessagesRequest struct {
+	Model       string             `json:"model"`
+	MaxTokens   int                `json:"max_tokens"`
+	Temperature float64            `json:"temperature"`
+	Messages    []anthropicMessage `json:"messages"`
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicMessagesResponse struct {
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+	Usage *struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Complete posts prompt as a single user message to /v1/messages and
+// returns the first content block's text. schema isn't sent separately -
+// prompt is expected to already instruct the model to respond with that
+// shape.
+func (p *AnthropicProvider) Complete(ctx context.Context, prompt string, schema string) (string, error) {
+	text, _, err := p.CompleteWithUsage(ctx, prompt, schema)
+	return text, err
+}
+
+// CompleteWithUsage behaves like Complete but also returns the token
+// usage Anthropic's response reports (input_tokens + output_tokens).
+func (p *AnthropicProvider) CompleteWithUsage(ctx context.Context, prompt string, schema string) (string, Usage, error) {
+	body, err := json.Marshal(anthropicMessagesRequest{
+		Model:       p.Model,
+		MaxTokens:   p.MaxTokens,
+		Temperature: p.Temperature,
+		Messages:    []anthropicMessage{{Role: "user", Content: prompt}},
+	})
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("marshaling Anthropic request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.BaseURL+"/v1/messages", bytes.NewReader(body))
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("building Anthropic request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", p.APIKey)
+	req.Header.Set("anthropic-version", "2023-06-01")
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("calling Anthropic: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed anthropicMessagesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", Usage{}, fmt.Errorf("parsing Anthropic response: %w", err)
+	}
+	if parsed.Error != nil {
+		return "", Usage{}, fmt.Errorf("Anthropic error: %s", parsed.Error.Message)
+	}
+	if len(parsed.Content) == 0 {
+		return "", Usage{}, fmt.Errorf("Anthropic response had no content")
+	}
+
+	var usage Usage
+	if parsed.Usage != nil {
+		usage = Usage{
+			PromptTokens:     parsed.Usage.InputTokens,
+			CompletionTokens: parsed.Usage.OutputTokens,
+			TotalTokens:      parsed.Usage.InputTokens + parsed.Usage.OutputTokens,
+		}
+	}
+
+	return parsed.Content[0].Text, usage, nil
+}
@@ -0,0 +1,85 @@
+package modelbased
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAnthropicProviderComplete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req anthropicMessagesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Messages[0].Content != "grade this" {
+			t.Errorf("prompt = %q, want %q", req.Messages[0].Content, "grade this")
+		}
+		if got := r.Header.Get("x-api-key"); got != "test-key" {
+			t.Errorf("x-api-key header = %q", got)
+		}
+
+		json.NewEncoder(w).Encode(anthropicMessagesResponse{
+			Content: []struct {
+				Text string `json:"text"`
+			}{{Text: `{"score":80,"rationale":"fine"}`}},
+		})
+	}))
+	defer server.Close()
+
+	provider := NewAnthropicProvider("test-key")
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	got, err := provider.Complete(context.Background(), "grade this", criterionResponseSchema)
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if got != `{"score":80,"rationale":"fine"}` {
+		t.Errorf("Complete() = %q", got)
+	}
+}
+
+func TestAnthropicProviderCompleteWithUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(anthropicMessagesResponse{
+			Content: []struct {
+				Text string `json:"text"`
+			}{{Text: "ok"}},
+			Usage: &struct {
+				InputTokens  int `json:"input_tokens"`
+				OutputTokens int `json:"output_tokens"`
+			}{InputTokens: 12, OutputTokens: 4},
+		})
+	}))
+	defer server.Close()
+
+	provider := NewAnthropicProvider("test-key")
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	_, usage, err := provider.CompleteWithUsage(context.Background(), "grade this", "")
+	if err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+	if usage != (Usage{PromptTokens: 12, CompletionTokens: 4, TotalTokens: 16}) {
+		t.Errorf("usage = %+v, want {12 4 16}", usage)
+	}
+}
+
+func TestAnthropicProviderCompleteNoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(anthropicMessagesResponse{})
+	}))
+	defer server.Close()
+
+	provider := NewAnthropicProvider("test-key")
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	if _, err := provider.Complete(context.Background(), "prompt", ""); err == nil {
+		t.Fatal("Complete() error = nil, want non-nil")
+	}
+}
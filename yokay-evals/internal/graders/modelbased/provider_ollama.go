@@ -0,0 +1,101 @@
+package modelbased
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// OllamaProvider completes prompts against a local Ollama server's
+// generate endpoint. BaseURL and HTTPClient are exported so tests can
+// point it at an httptest.Server instead of a real Ollama install.
+type OllamaProvider struct {
+	Model       string
+	Temperature float64
+	BaseURL     string
+	HTTPClient  *http.Client
+}
+
+// NewOllamaProvider returns an OllamaProvider defaulting to llama3
+// against Ollama's default local port. Unlike the hosted providers, it
+// needs no API key.
+func NewOllamaProvider() *OllamaProvider {
+	return &OllamaProvider{
+		Model:      "llama3",
+		BaseURL:    "http://localhost:11434",
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+func (p *OllamaProvider) SetModel(model string)              { p.Model = model }
+func (p *OllamaProvider) SetTemperature(temperature float64) { p.Temperature = temperature }
+
+type ollamaGenerateRequest struct {
+	Model   string                 `json:"model"`
+	Prompt  string                 `json:"prompt"`
+	Stream  bool                   `json:"stream"`
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+type ollamaGenerateResponse struct {
+	Response string `json:"response"`
+	// PromptEvalCount and EvalCount are Ollama's equivalent of
+	// prompt/completion token counts - it has no combined "usage" object
+	// like OpenAI or Anthropic, just these two top-level fields.
+	PromptEvalCount int    `json:"prompt_eval_count"`
+	EvalCount       int    `json:"eval_count"`
+	Error           string `json:"error"`
+}
+
+// Complete posts prompt to /api/generate with streaming disabled (so
+// the whole response arrives as one JSON document) and returns the
+// response field. schema isn't sent separately - prompt is expected to
+// already instruct the model to respond with that shape.
+func (p *OllamaProvider) Complete(ctx context.Context, prompt string, schema string) (string, error) {
+	text, _, err := p.CompleteWithUsage(ctx, prompt, schema)
+	return text, err
+}
+
+// CompleteWithUsage behaves like Complete but also returns the token
+// usage Ollama's response reports (prompt_eval_count/eval_count).
+func (p *OllamaProvider) CompleteWithUsage(ctx context.Context, prompt string, schema string) (string, Usage, error) {
+	body, err := json.Marshal(ollamaGenerateRequest{
+		Model:   p.Model,
+		Prompt:  prompt,
+		Stream:  false,
+		Options: map[string]interface{}{"temperature": p.Temperature},
+	})
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("marshaling Ollama request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.BaseURL+"/api/generate", bytes.NewReader(body))
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("building Ollama request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("calling Ollama: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed ollamaGenerateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", Usage{}, fmt.Errorf("parsing Ollama response: %w", err)
+	}
+	if parsed.Error != "" {
+		return "", Usage{}, fmt.Errorf("Ollama error: %s", parsed.Error)
+	}
+
+	usage := Usage{
+		PromptTokens:     parsed.PromptEvalCount,
+		CompletionTokens: parsed.EvalCount,
+		TotalTokens:      parsed.PromptEvalCount + parsed.EvalCount,
+	}
+
+	return parsed.Response, usage, nil
+}
@@ -0,0 +1,73 @@
+package modelbased
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOllamaProviderComplete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ollamaGenerateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Stream {
+			t.Error("Stream = true, want false")
+		}
+		if req.Prompt != "grade this" {
+			t.Errorf("prompt = %q, want %q", req.Prompt, "grade this")
+		}
+
+		json.NewEncoder(w).Encode(ollamaGenerateResponse{Response: `{"score":80,"rationale":"fine"}`})
+	}))
+	defer server.Close()
+
+	provider := NewOllamaProvider()
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	got, err := provider.Complete(context.Background(), "grade this", criterionResponseSchema)
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if got != `{"score":80,"rationale":"fine"}` {
+		t.Errorf("Complete() = %q", got)
+	}
+}
+
+func TestOllamaProviderCompleteWithUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(ollamaGenerateResponse{Response: "ok", PromptEvalCount: 7, EvalCount: 3})
+	}))
+	defer server.Close()
+
+	provider := NewOllamaProvider()
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	_, usage, err := provider.CompleteWithUsage(context.Background(), "grade this", "")
+	if err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+	if usage != (Usage{PromptTokens: 7, CompletionTokens: 3, TotalTokens: 10}) {
+		t.Errorf("usage = %+v, want {7 3 10}", usage)
+	}
+}
+
+func TestOllamaProviderCompleteError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(ollamaGenerateResponse{Error: "model not found"})
+	}))
+	defer server.Close()
+
+	provider := NewOllamaProvider()
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	if _, err := provider.Complete(context.Background(), "prompt", ""); err == nil {
+		t.Fatal("Complete() error = nil, want non-nil")
+	}
+}
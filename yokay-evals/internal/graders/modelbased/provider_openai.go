@@ -0,0 +1,116 @@
+package modelbased
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// OpenAIProvider completes prompts against the OpenAI chat completions
+// API. BaseURL and HTTPClient are exported so tests can point it at an
+// httptest.Server instead of the real API.
+type OpenAIProvider struct {
+	APIKey      string
+	Model       string
+	Temperature float64
+	BaseURL     string
+	HTTPClient  *http.Client
+}
+
+// NewOpenAIProvider returns an OpenAIProvider defaulting to gpt-4o-mini
+// at the standard OpenAI API base URL.
+func NewOpenAIProvider(apiKey string) *OpenAIProvider {
+	return &OpenAIProvider{
+		APIKey:     apiKey,
+		Model:      "gpt-4o-mini",
+		BaseURL:    "https://api.openai.com",
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+func (p *OpenAIProvider) SetModel(model string)              { p.Model = model }
+func (p *OpenAIProvider) SetTemperature(temperature float64) { p.Temperature = temperature }
+
+type openAIChatRequest struct {
+	Model       string              `json:"model"`
+	Temperature float64             `json:"temperature"`
+	Messages    []openAIChatMessage `json:"messages"`
+}
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIChatMessage `json:"message"`
+	} `json:"choices"`
+	Usage *struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+		TotalTokens      int `json:"total_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Complete posts prompt as a single user message to /v1/chat/completions
+// and returns the first choice's content. schema isn't sent separately -
+// Complete relies on prompt already instructing the model to respond
+// with that shape, the same way Anthropic and Ollama's Complete do.
+func (p *OpenAIProvider) Complete(ctx context.Context, prompt string, schema string) (string, error) {
+	text, _, err := p.CompleteWithUsage(ctx, prompt, schema)
+	return text, err
+}
+
+// CompleteWithUsage behaves like Complete but also returns the token
+// usage OpenAI's response reports.
+func (p *OpenAIProvider) CompleteWithUsage(ctx context.Context, prompt string, schema string) (string, Usage, error) {
+	body, err := json.Marshal(openAIChatRequest{
+		Model:       p.Model,
+		Temperature: p.Temperature,
+		Messages:    []openAIChatMessage{{Role: "user", Content: prompt}},
+	})
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("marshaling OpenAI request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.BaseURL+"/v1/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("building OpenAI request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.APIKey)
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("calling OpenAI: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed openAIChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", Usage{}, fmt.Errorf("parsing OpenAI response: %w", err)
+	}
+	if parsed.Error != nil {
+		return "", Usage{}, fmt.Errorf("OpenAI error: %s", parsed.Error.Message)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", Usage{}, fmt.Errorf("OpenAI response had no choices")
+	}
+
+	var usage Usage
+	if parsed.Usage != nil {
+		usage = Usage{
+			PromptTokens:     parsed.Usage.PromptTokens,
+			CompletionTokens: parsed.Usage.CompletionTokens,
+			TotalTokens:      parsed.Usage.TotalTokens,
+		}
+	}
+
+	return parsed.Choices[0].Message.Content, usage, nil
+}
@@ -0,0 +1,90 @@
+package modelbased
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOpenAIProviderComplete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openAIChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Messages[0].Content != "grade this" {
+			t.Errorf("prompt = %q, want %q", req.Messages[0].Content, "grade this")
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-key" {
+			t.Errorf("Authorization header = %q", got)
+		}
+
+		json.NewEncoder(w).Encode(openAIChatResponse{
+			Choices: []struct {
+				Message openAIChatMessage `json:"message"`
+			}{{Message: openAIChatMessage{Role: "assistant", Content: `{"score":80,"rationale":"fine"}`}}},
+		})
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider("test-key")
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	got, err := provider.Complete(context.Background(), "grade this", criterionResponseSchema)
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if got != `{"score":80,"rationale":"fine"}` {
+		t.Errorf("Complete() = %q", got)
+	}
+}
+
+func TestOpenAIProviderCompleteWithUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(openAIChatResponse{
+			Choices: []struct {
+				Message openAIChatMessage `json:"message"`
+			}{{Message: openAIChatMessage{Role: "assistant", Content: "ok"}}},
+			Usage: &struct {
+				PromptTokens     int `json:"prompt_tokens"`
+				CompletionTokens int `json:"completion_tokens"`
+				TotalTokens      int `json:"total_tokens"`
+			}{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+		})
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider("test-key")
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	_, usage, err := provider.CompleteWithUsage(context.Background(), "grade this", "")
+	if err != nil {
+		t.Fatalf("CompleteWithUsage() error = %v", err)
+	}
+	if usage != (Usage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15}) {
+		t.Errorf("usage = %+v, want {10 5 15}", usage)
+	}
+}
+
+func TestOpenAIProviderCompleteAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(openAIChatResponse{
+			Error: &struct {
+				Message string `json:"message"`
+			}{Message: "invalid api key"},
+		})
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider("bad-key")
+	provider.BaseURL = server.URL
+	provider.HTTPClient = server.Client()
+
+	if _, err := provider.Complete(context.Background(), "prompt", ""); err == nil {
+		t.Fatal("Complete() error = nil, want non-nil")
+	}
+}
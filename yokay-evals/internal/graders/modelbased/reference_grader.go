@@ -0,0 +1,262 @@
+package modelbased
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// referenceHeadingPattern matches markdown ATX headings, used by
+// referenceHeuristic to compare which of the reference's headings the
+// candidate reproduces.
+var referenceHeadingPattern = regexp.MustCompile(`(?m)^#{1,6}\s+(.+)$`)
+
+// SkillReferenceGrader grades a candidate skill document against a gold
+// reference skill on a 1-10 scale, inspired by LangChain's
+// ScoreStringEvalChain. It mirrors SkillClarityGrader's shape (an
+// LLMProvider-backed Option-configured grader with a NoopProvider
+// fallback) but judges "how close is this to the reference" rather than
+// scoring against a fixed rubric.
+type SkillReferenceGrader struct {
+	// provider grades the candidate against GradeInput.Reference via an
+	// LLM when set to anything other than NoopProvider; NoopProvider
+	// (the default) keeps Grade on the heuristic referenceHeuristic
+	// path, so grading still works offline.
+	provider LLMProvider
+	// model and temperature are applied to provider via
+	// configurableProvider, if it implements that interface, once every
+	// ReferenceOption has run.
+	model       string
+	temperature float64
+	// passingScore is on the grader's native 1-10 scale, not the
+	// normalized [0,1] one.
+	passingScore float64
+	// normalize rescales Result.Score from the native 1-10 scale to
+	// [0,1], for aggregation with clarity scores elsewhere in a report.
+	normalize bool
+}
+
+// ReferenceOption configures a SkillReferenceGrader built by
+// NewSkillReferenceGrader.
+type ReferenceOption func(*SkillReferenceGrader)
+
+// WithReferenceProvider sets the LLMProvider Grade and ComparePair use.
+// Without this option, they use a built-in heuristic.
+func WithReferenceProvider(provider LLMProvider) ReferenceOption {
+	return func(g *SkillReferenceGrader) { g.provider = provider }
+}
+
+// WithReferenceModel sets the model name passed to the configured
+// provider (via its SetModel method), overriding the provider's own
+// default.
+func WithReferenceModel(model string) ReferenceOption {
+	return func(g *SkillReferenceGrader) { g.model = model }
+}
+
+// WithReferenceTemperature sets the sampling temperature passed to the
+// configured provider (via its SetTemperature method), overriding the
+// provider's own default.
+func WithReferenceTemperature(temperature float64) ReferenceOption {
+	return func(g *SkillReferenceGrader) { g.temperature = temperature }
+}
+
+// WithNormalize rescales Result.Score from the grader's native 1-10
+// scale to [0,1], for callers that aggregate it alongside
+// SkillClarityGrader's 0-100 scores (after a corresponding rescale on
+// their side) rather than reporting it on its own.
+func WithNormalize(normalize bool) ReferenceOption {
+	return func(g *SkillReferenceGrader) { g.normalize = normalize }
+}
+
+// NewSkillReferenceGrader creates a new reference grader with a default
+// passing score of 7 (on the native 1-10 scale) and, unless
+// WithReferenceProvider says otherwise, the heuristic NoopProvider.
+func NewSkillReferenceGrader(opts ...ReferenceOption) *SkillReferenceGrader {
+	g := &SkillReferenceGrader{
+		passingScore: 7.0,
+		provider:     NoopProvider{},
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	if cfg, ok := g.provider.(configurableProvider); ok {
+		if g.model != "" {
+			cfg.SetModel(g.model)
+		}
+		if g.temperature != 0 {
+			cfg.SetTemperature(g.temperature)
+		}
+	}
+
+	return g
+}
+
+// referenceResponseSchema is the JSON Schema the reference-grading and
+// pairwise-comparison prompts ask the model to conform to.
+const referenceResponseSchema = `{"type":"object","properties":{"score":{"type":"number"},"rationale":{"type":"string"}},"required":["score","rationale"]}`
+
+const referencePromptTemplate = `You are grading a candidate skill document against a gold reference skill document, following the ScoreStringEvalChain approach: judge how well the candidate matches the reference's clarity, completeness, and correctness.
+
+Reference skill:
+%s
+
+Candidate skill:
+%s
+
+Respond with a JSON object matching {"score": <1-10>, "rationale": "<one sentence>"} and nothing else.
+`
+
+// referenceLLMResponse is the JSON shape the reference-grading prompt
+// asks the model to return.
+type referenceLLMResponse struct {
+	Score     float64 `json:"score"`
+	Rationale string  `json:"rationale"`
+}
+
+// Grade scores input.Content against input.Reference on a 1-10 scale
+// (rescaled to [0,1] when WithNormalize is set). When provider is
+// configured it asks the LLM to score the pair; if that fails for any
+// reason, or no provider is configured, it falls back to
+// referenceHeuristic.
+func (g *SkillReferenceGrader) Grade(input GradeInput) (Result, error) {
+	score, feedback := g.referenceHeuristic(input.Content, input.Reference)
+	usedLLM := false
+
+	if _, isNoop := g.provider.(NoopProvider); !isNoop {
+		if llmScore, llmFeedback, err := g.scoreAgainstReference(context.Background(), input.Content, input.Reference); err == nil {
+			score, feedback = llmScore, llmFeedback
+			usedLLM = true
+		}
+	}
+
+	reported := score
+	if g.normalize {
+		reported = (score - 1) / 9
+	}
+
+	note := " Note: Scored by heuristic evaluation; no LLM grading result was used."
+	if usedLLM {
+		note = ""
+	}
+
+	message := fmt.Sprintf("Reference comparison score %.1f/10: %s%s", score, feedback, note)
+
+	return Result{
+		Passed:  score >= g.passingScore,
+		Score:   reported,
+		Message: message,
+		Details: map[string]any{
+			"reference_score": score,
+			"feedback":        feedback,
+			"normalized":      g.normalize,
+		},
+	}, nil
+}
+
+// scoreAgainstReference asks g.provider to score candidate against
+// reference, returning an error (and leaving Grade to fall back to
+// referenceHeuristic) if the call or response fails to validate.
+func (g *SkillReferenceGrader) scoreAgainstReference(ctx context.Context, candidate, reference string) (float64, string, error) {
+	prompt := fmt.Sprintf(referencePromptTemplate, reference, candidate)
+	raw, err := g.provider.Complete(ctx, prompt, referenceResponseSchema)
+	if err != nil {
+		return 0, "", fmt.Errorf("scoring against reference: %w", err)
+	}
+
+	var resp referenceLLMResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		return 0, "", fmt.Errorf("parsing reference score response: %w", err)
+	}
+	if resp.Score < 1 || resp.Score > 10 {
+		return 0, "", fmt.Errorf("reference score %v out of range [1,10]", resp.Score)
+	}
+
+	return resp.Score, resp.Rationale, nil
+}
+
+// referenceHeuristic is the offline fallback used when no provider (or a
+// failing one) is configured: it scores the candidate by how much of the
+// reference's headings it reproduces, plus a length-similarity bonus.
+// TODO: Replace with LLM-based evaluation once a provider is always
+// available.
+func (g *SkillReferenceGrader) referenceHeuristic(candidate, reference string) (float64, string) {
+	if reference == "" {
+		return 5.0, "Heuristic evaluation: no reference provided, defaulting to a neutral score"
+	}
+	if candidate == "" {
+		return 1.0, "Heuristic evaluation: candidate is empty"
+	}
+
+	refHeadings := referenceHeadingPattern.FindAllStringSubmatch(reference, -1)
+	matched := 0
+	for _, h := range refHeadings {
+		if strings.Contains(candidate, h[0]) {
+			matched++
+		}
+	}
+
+	headingScore := 5.0
+	if len(refHeadings) > 0 {
+		headingScore = 1 + 9*float64(matched)/float64(len(refHeadings))
+	}
+
+	lengthRatio := float64(len(candidate)) / float64(len(reference))
+	if lengthRatio > 1 {
+		lengthRatio = 1 / lengthRatio
+	}
+	lengthScore := 1 + 9*lengthRatio
+
+	score := (headingScore + lengthScore) / 2
+	feedback := fmt.Sprintf("Heuristic evaluation: matched %d/%d reference headings, length ratio %.2f", matched, len(refHeadings), lengthRatio)
+
+	return score, feedback
+}
+
+// Comparison is ComparePair's result: which of two candidate skill
+// documents is preferred, and by how much.
+type Comparison struct {
+	// Preferred is "A", "B", or "tie".
+	Preferred string
+	// Margin is the absolute difference between a's and b's scores,
+	// on the grader's native 1-10 scale regardless of WithNormalize.
+	Margin float64
+	// Rationale explains the preference.
+	Rationale string
+}
+
+// ComparePair scores a against b and b against a, each one standing in as
+// the other's reference, and reports which one is preferred and by how
+// much - useful for A/B-testing two revisions of a skill during
+// authoring.
+func (g *SkillReferenceGrader) ComparePair(a, b string) (Comparison, error) {
+	scoreA, feedbackA := g.referenceHeuristic(a, b)
+	scoreB, feedbackB := g.referenceHeuristic(b, a)
+
+	if _, isNoop := g.provider.(NoopProvider); !isNoop {
+		if llmScoreA, llmFeedbackA, err := g.scoreAgainstReference(context.Background(), a, b); err == nil {
+			scoreA, feedbackA = llmScoreA, llmFeedbackA
+		}
+		if llmScoreB, llmFeedbackB, err := g.scoreAgainstReference(context.Background(), b, a); err == nil {
+			scoreB, feedbackB = llmScoreB, llmFeedbackB
+		}
+	}
+
+	margin := scoreA - scoreB
+	preferred := "tie"
+	rationale := fmt.Sprintf("A: %s; B: %s", feedbackA, feedbackB)
+	switch {
+	case margin > 0:
+		preferred = "A"
+	case margin < 0:
+		preferred = "B"
+		margin = -margin
+	default:
+		margin = 0
+	}
+
+	return Comparison{Preferred: preferred, Margin: margin, Rationale: rationale}, nil
+}
@@ -0,0 +1,111 @@
+package modelbased
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSkillReferenceGrader_New(t *testing.T) {
+	grader := NewSkillReferenceGrader()
+	if grader == nil {
+		t.Fatal("Expected NewSkillReferenceGrader to return non-nil grader")
+	}
+}
+
+func TestSkillReferenceGrader_GradeNoReference(t *testing.T) {
+	grader := NewSkillReferenceGrader()
+
+	result, err := grader.Grade(GradeInput{Content: "# Some Skill\nDo the thing.", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if result.Score < 1 || result.Score > 10 {
+		t.Errorf("Score = %v, want between 1 and 10 on the native scale", result.Score)
+	}
+}
+
+func TestSkillReferenceGrader_GradeMatchingHeadings(t *testing.T) {
+	grader := NewSkillReferenceGrader()
+	reference := "# Skill\n## Instructions\nDo X.\n## Examples\nLike this.\n"
+	candidate := "# Skill\n## Instructions\nDo X carefully.\n## Examples\nLike this, but longer.\n"
+
+	result, err := grader.Grade(GradeInput{Content: candidate, Reference: reference, Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if result.Score < 5 {
+		t.Errorf("Score = %v, want a high heuristic score for a candidate reproducing every reference heading", result.Score)
+	}
+}
+
+func TestSkillReferenceGrader_WithNormalizeRescalesToUnitInterval(t *testing.T) {
+	provider := &fakeProvider{response: `{"score":10,"rationale":"identical"}`}
+	grader := NewSkillReferenceGrader(WithReferenceProvider(provider), WithNormalize(true))
+
+	result, err := grader.Grade(GradeInput{Content: "candidate", Reference: "reference", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if result.Score != 1.0 {
+		t.Errorf("Score = %v, want 1.0 (a 10/10 native score normalized to [0,1])", result.Score)
+	}
+}
+
+func TestSkillReferenceGrader_WithReferenceProviderFallsBackOnError(t *testing.T) {
+	provider := &fakeProvider{err: errors.New("network error")}
+	grader := NewSkillReferenceGrader(WithReferenceProvider(provider))
+
+	result, err := grader.Grade(GradeInput{Content: "candidate", Reference: "reference", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if !strings.Contains(result.Message, "Heuristic evaluation") {
+		t.Errorf("Message = %q, want fallback to mention heuristic evaluation when the provider failed", result.Message)
+	}
+}
+
+func TestSkillReferenceGrader_WithReferenceModelAndTemperatureConfigureProvider(t *testing.T) {
+	provider := &fakeProvider{response: `{"score":5,"rationale":"ok"}`}
+	NewSkillReferenceGrader(WithReferenceProvider(provider), WithReferenceModel("gpt-5"), WithReferenceTemperature(0.2))
+
+	if provider.model != "gpt-5" {
+		t.Errorf("provider.model = %q, want %q", provider.model, "gpt-5")
+	}
+	if provider.temp != 0.2 {
+		t.Errorf("provider.temp = %v, want 0.2", provider.temp)
+	}
+}
+
+func TestSkillReferenceGrader_ComparePairPrefersHigherScoringCandidate(t *testing.T) {
+	grader := NewSkillReferenceGrader()
+
+	a := "# Skill\n## Instructions\nDo X.\n## Examples\nLike this.\n"
+	b := "nothing useful here"
+
+	cmp, err := grader.ComparePair(a, b)
+	if err != nil {
+		t.Fatalf("ComparePair() error = %v", err)
+	}
+	if cmp.Preferred != "A" {
+		t.Errorf("Preferred = %q, want %q", cmp.Preferred, "A")
+	}
+	if cmp.Margin <= 0 {
+		t.Errorf("Margin = %v, want > 0", cmp.Margin)
+	}
+}
+
+func TestSkillReferenceGrader_ComparePairTieOnIdenticalContent(t *testing.T) {
+	grader := NewSkillReferenceGrader()
+
+	cmp, err := grader.ComparePair("same content", "same content")
+	if err != nil {
+		t.Fatalf("ComparePair() error = %v", err)
+	}
+	if cmp.Preferred != "tie" {
+		t.Errorf("Preferred = %q, want %q for identical candidates", cmp.Preferred, "tie")
+	}
+	if cmp.Margin != 0 {
+		t.Errorf("Margin = %v, want 0 for identical candidates", cmp.Margin)
+	}
+}
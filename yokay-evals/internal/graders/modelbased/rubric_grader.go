@@ -0,0 +1,212 @@
+package modelbased
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// RubricGrader scores content against a free-text rubric check via an
+// LLM, the same Option-configured/NoopProvider-default shape as
+// SkillClarityGrader and SkillReferenceGrader. Unlike those two, its
+// prompt is rendered from a template: failures/<category>/rubric.tmpl
+// under RubricDir when one exists, falling back to
+// defaultRubricTemplate, so individual failure categories can tailor
+// grading instructions without forking the grader. The check text and
+// category come from GradeInput.Context["check"] and
+// GradeInput.Context["category"]; GradeInput.Context["seed"], if
+// present, is forwarded to a CachingProvider (see WithRubricProvider) so
+// cache entries record which run produced them.
+type RubricGrader struct {
+	provider     LLMProvider
+	model        string
+	temperature  float64
+	passingScore float64
+	rubricDir    string
+}
+
+// RubricOption configures a RubricGrader built by NewRubricGrader.
+type RubricOption func(*RubricGrader)
+
+// WithRubricProvider sets the LLMProvider Grade uses. Without this
+// option, Grade returns ErrNoProvider - unlike SkillClarityGrader's
+// offline heuristic, there's no reasonable content-free way to score an
+// arbitrary rubric check.
+func WithRubricProvider(provider LLMProvider) RubricOption {
+	return func(g *RubricGrader) { g.provider = provider }
+}
+
+// WithRubricModel sets the model name passed to the configured provider
+// (via its SetModel method), overriding the provider's own default.
+func WithRubricModel(model string) RubricOption {
+	return func(g *RubricGrader) { g.model = model }
+}
+
+// WithRubricTemperature sets the sampling temperature passed to the
+// configured provider (via its SetTemperature method), overriding the
+// provider's own default.
+func WithRubricTemperature(temperature float64) RubricOption {
+	return func(g *RubricGrader) { g.temperature = temperature }
+}
+
+// WithRubricPassingScore overrides the default overall passing threshold
+// (70).
+func WithRubricPassingScore(passingScore float64) RubricOption {
+	return func(g *RubricGrader) { g.passingScore = passingScore }
+}
+
+// WithRubricDir sets the failures directory rubric.tmpl files are looked
+// up under (failures/<category>/rubric.tmpl). Left unset, every category
+// uses defaultRubricTemplate.
+func WithRubricDir(dir string) RubricOption {
+	return func(g *RubricGrader) { g.rubricDir = dir }
+}
+
+// NewRubricGrader creates a new rubric grader with a default passing
+// score of 70 and, unless WithRubricProvider says otherwise, the
+// NoopProvider (which makes Grade return ErrNoProvider).
+func NewRubricGrader(opts ...RubricOption) *RubricGrader {
+	g := &RubricGrader{
+		passingScore: 70.0,
+		provider:     NoopProvider{},
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	if cfg, ok := g.provider.(configurableProvider); ok {
+		if g.model != "" {
+			cfg.SetModel(g.model)
+		}
+		if g.temperature != 0 {
+			cfg.SetTemperature(g.temperature)
+		}
+	}
+
+	return g
+}
+
+// defaultRubricTemplate is used for any category with no
+// failures/<category>/rubric.tmpl override.
+const defaultRubricTemplate = `You are grading candidate output against a single rubric check, as part of an automated eval suite.
+
+Check: {{.Check}}
+
+Respond with a JSON object matching {"score": <0-100>, "passed": <true/false>, "rationale": "<one sentence>"} and nothing else.
+
+Candidate output:
+{{.Content}}
+`
+
+// rubricResponseSchema is the JSON Schema the rubric prompt asks the
+// model to conform to; passed to LLMProvider.Complete for providers that
+// support constrained output.
+const rubricResponseSchema = `{"type":"object","properties":{"score":{"type":"number"},"passed":{"type":"boolean"},"rationale":{"type":"string"}},"required":["score","passed","rationale"]}`
+
+// rubricTemplateData is the data defaultRubricTemplate (and any
+// failures/<category>/rubric.tmpl override) renders against.
+type rubricTemplateData struct {
+	Check   string
+	Content string
+}
+
+// rubricLLMResponse is the JSON shape the rubric prompt asks the model to
+// return.
+type rubricLLMResponse struct {
+	Score     float64 `json:"score"`
+	Passed    bool    `json:"passed"`
+	Rationale string  `json:"rationale"`
+}
+
+// rubricTemplate loads failures/<category>/rubric.tmpl under g.rubricDir,
+// falling back to defaultRubricTemplate when category is empty,
+// rubricDir is unset, or no override file exists for that category.
+func (g *RubricGrader) rubricTemplate(category string) (*template.Template, error) {
+	text := defaultRubricTemplate
+	if g.rubricDir != "" && category != "" {
+		path := filepath.Join(g.rubricDir, category, "rubric.tmpl")
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			text = string(data)
+		case !os.IsNotExist(err):
+			return nil, fmt.Errorf("reading rubric template %s: %w", path, err)
+		}
+	}
+
+	tmpl, err := template.New("rubric").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing rubric template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// Grade renders the rubric template for input.Context["category"] with
+// input.Context["check"] and input.Content, asks the configured provider
+// to score the result, and parses its JSON-mode response. It returns
+// ErrNoProvider, wrapped, if no provider is configured.
+func (g *RubricGrader) Grade(input GradeInput) (Result, error) {
+	if _, isNoop := g.provider.(NoopProvider); isNoop {
+		return Result{}, fmt.Errorf("grading rubric check: %w", ErrNoProvider)
+	}
+
+	check, _ := input.Context["check"].(string)
+	category, _ := input.Context["category"].(string)
+	var seed int64
+	if s, ok := input.Context["seed"].(int64); ok {
+		seed = s
+	}
+	if cp, ok := g.provider.(*CachingProvider); ok {
+		cp.Seed = seed
+	}
+
+	tmpl, err := g.rubricTemplate(category)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, rubricTemplateData{Check: check, Content: input.Content}); err != nil {
+		return Result{}, fmt.Errorf("rendering rubric template: %w", err)
+	}
+
+	text, usage, err := g.complete(context.Background(), buf.String())
+	if err != nil {
+		return Result{}, fmt.Errorf("grading rubric check %q: %w", check, err)
+	}
+
+	var resp rubricLLMResponse
+	if err := json.Unmarshal([]byte(text), &resp); err != nil {
+		return Result{}, fmt.Errorf("parsing rubric response: %w", err)
+	}
+	if resp.Score < 0 || resp.Score > 100 {
+		return Result{}, fmt.Errorf("rubric score %v out of range [0,100]", resp.Score)
+	}
+
+	return Result{
+		Passed:  resp.Passed,
+		Score:   resp.Score,
+		Message: fmt.Sprintf("Rubric check %q scored %.1f/100: %s", check, resp.Score, resp.Rationale),
+		Details: map[string]any{
+			"rationale": resp.Rationale,
+			"usage":     usage,
+			"seed":      seed,
+		},
+	}, nil
+}
+
+// complete calls g.provider, using CompleteWithUsage when the provider
+// supports it so Result.Details can report token usage alongside the
+// rationale.
+func (g *RubricGrader) complete(ctx context.Context, prompt string) (string, Usage, error) {
+	if up, ok := g.provider.(UsageLLMProvider); ok {
+		return up.CompleteWithUsage(ctx, prompt, rubricResponseSchema)
+	}
+	text, err := g.provider.Complete(ctx, prompt, rubricResponseSchema)
+	return text, Usage{}, err
+}
@@ -0,0 +1,105 @@
+package modelbased
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRubricGraderNoProviderReturnsErrNoProvider(t *testing.T) {
+	grader := NewRubricGrader()
+
+	_, err := grader.Grade(GradeInput{Content: "some output", Context: map[string]any{"check": "is it correct"}})
+	if err == nil {
+		t.Fatal("Grade() error = nil, want ErrNoProvider")
+	}
+}
+
+func TestRubricGraderParsesLLMResponse(t *testing.T) {
+	provider := &fakeProvider{response: `{"score":85,"passed":true,"rationale":"meets the bar"}`}
+	grader := NewRubricGrader(WithRubricProvider(provider))
+
+	result, err := grader.Grade(GradeInput{
+		Content: "candidate output",
+		Context: map[string]any{"check": "output is well-formed JSON"},
+	})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if !result.Passed {
+		t.Error("Passed = false, want true")
+	}
+	if result.Score != 85 {
+		t.Errorf("Score = %v, want 85", result.Score)
+	}
+	if result.Details["rationale"] != "meets the bar" {
+		t.Errorf("Details[rationale] = %v, want %q", result.Details["rationale"], "meets the bar")
+	}
+}
+
+func TestRubricGraderRejectsOutOfRangeScore(t *testing.T) {
+	provider := &fakeProvider{response: `{"score":150,"passed":true,"rationale":"nope"}`}
+	grader := NewRubricGrader(WithRubricProvider(provider))
+
+	if _, err := grader.Grade(GradeInput{Content: "x", Context: map[string]any{"check": "x"}}); err == nil {
+		t.Fatal("Grade() error = nil, want out-of-range error")
+	}
+}
+
+func TestRubricGraderLoadsPerCategoryTemplate(t *testing.T) {
+	dir := t.TempDir()
+	categoryDir := filepath.Join(dir, "missed-tasks")
+	if err := os.MkdirAll(categoryDir, 0755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	tmplPath := filepath.Join(categoryDir, "rubric.tmpl")
+	if err := os.WriteFile(tmplPath, []byte("CUSTOM TEMPLATE check={{.Check}} content={{.Content}}"), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	var sentPrompt string
+	provider := &capturingProvider{fakeProvider: fakeProvider{response: `{"score":100,"passed":true,"rationale":"ok"}`}, captured: &sentPrompt}
+	grader := NewRubricGrader(WithRubricProvider(provider), WithRubricDir(dir))
+
+	if _, err := grader.Grade(GradeInput{
+		Content: "the content",
+		Context: map[string]any{"check": "the check", "category": "missed-tasks"},
+	}); err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+
+	if !strings.Contains(sentPrompt, "CUSTOM TEMPLATE check=the check content=the content") {
+		t.Errorf("prompt = %q, want rendered custom template", sentPrompt)
+	}
+}
+
+func TestRubricGraderFallsBackToDefaultTemplateForUnknownCategory(t *testing.T) {
+	var sentPrompt string
+	provider := &capturingProvider{fakeProvider: fakeProvider{response: `{"score":100,"passed":true,"rationale":"ok"}`}, captured: &sentPrompt}
+	grader := NewRubricGrader(WithRubricProvider(provider), WithRubricDir(t.TempDir()))
+
+	if _, err := grader.Grade(GradeInput{
+		Content: "the content",
+		Context: map[string]any{"check": "the check", "category": "no-such-category"},
+	}); err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+
+	if !strings.Contains(sentPrompt, "Check: the check") {
+		t.Errorf("prompt = %q, want rendered default template", sentPrompt)
+	}
+}
+
+// capturingProvider wraps fakeProvider and records the last prompt it was
+// asked to complete, for assertions on rendered templates.
+type capturingProvider struct {
+	fakeProvider
+	captured *string
+}
+
+func (c *capturingProvider) Complete(ctx context.Context, prompt string, schema string) (string, error) {
+	*c.captured = prompt
+	return c.fakeProvider.Complete(ctx, prompt, schema)
+}
@@ -1,6 +1,8 @@
 package modelbased
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -9,33 +11,146 @@ import (
 type SkillClarityGrader struct {
 	// Criteria weights for evaluation
 	weights map[string]float64
+	// criteria is the configured set evaluateCriteria and
+	// evaluateCriteriaLLM iterate over; defaultCriteria unless
+	// WithCriteria (typically via LoadGraderConfig) overrides it.
+	criteria []CriterionConfig
+	// criteriaByName indexes criteria by name for the per-criterion pass
+	// threshold lookup in Grade.
+	criteriaByName map[string]CriterionConfig
 	// Passing threshold (0-100)
 	passingScore float64
+	// provider grades each criterion via an LLM when set to anything
+	// other than NoopProvider; NoopProvider (the default) keeps Grade on
+	// the heuristic evaluateCriteria path, so grading still works
+	// offline.
+	provider LLMProvider
+	// model and temperature are applied to provider via
+	// configurableProvider, if it implements that interface, once every
+	// Option has run.
+	model       string
+	temperature float64
+	// judge, when set (see WithJudge), takes priority over provider:
+	// Grade scores every criterion with the G-Eval technique instead of
+	// evaluateCriteriaLLM's single-shot JSON scoring.
+	judge Judge
 }
 
 // Criterion represents a single evaluation criterion with its score and feedback
 type Criterion struct {
 	Score    float64
 	Feedback string
+	// Reasoning holds the judge's full chain-of-thought rationale when
+	// Criterion came from evaluateCriteriaGEval; empty otherwise.
+	Reasoning string
 }
 
-// NewSkillClarityGrader creates a new skill clarity grader with default weights
-func NewSkillClarityGrader() *SkillClarityGrader {
-	return &SkillClarityGrader{
-		weights: map[string]float64{
-			"clear_instructions": 0.30, // 30% - Are instructions unambiguous?
-			"actionable_steps":   0.25, // 25% - Are steps concrete and executable?
-			"good_examples":      0.25, // 25% - Are examples helpful and realistic?
-			"appropriate_scope":  0.20, // 20% - Is the skill focused, not too broad/narrow?
-		},
+// Option configures a SkillClarityGrader built by NewSkillClarityGrader.
+type Option func(*SkillClarityGrader)
+
+// WithProvider sets the LLMProvider Grade uses to evaluate criteria.
+// Without this option, Grade uses its built-in heuristic evaluation.
+func WithProvider(provider LLMProvider) Option {
+	return func(g *SkillClarityGrader) { g.provider = provider }
+}
+
+// WithModel sets the model name passed to the configured provider (via
+// its SetModel method), overriding the provider's own default.
+func WithModel(model string) Option {
+	return func(g *SkillClarityGrader) { g.model = model }
+}
+
+// WithTemperature sets the sampling temperature passed to the configured
+// provider (via its SetTemperature method), overriding the provider's
+// own default.
+func WithTemperature(temperature float64) Option {
+	return func(g *SkillClarityGrader) { g.temperature = temperature }
+}
+
+// WithCriteria replaces the grader's default four criteria with an
+// arbitrary configured set - e.g. a domain-specific
+// "security_considerations" criterion - without forking the grader. See
+// LoadGraderConfig to load these from a file instead of constructing
+// them in code.
+func WithCriteria(criteria []CriterionConfig) Option {
+	return func(g *SkillClarityGrader) {
+		g.criteria = criteria
+
+		g.weights = make(map[string]float64, len(criteria))
+		g.criteriaByName = make(map[string]CriterionConfig, len(criteria))
+		for _, c := range criteria {
+			g.weights[c.Name] = c.Weight
+			g.criteriaByName[c.Name] = c
+		}
+	}
+}
+
+// WithPassingScore overrides the default overall passing threshold (70).
+func WithPassingScore(passingScore float64) Option {
+	return func(g *SkillClarityGrader) { g.passingScore = passingScore }
+}
+
+// WithJudge sets the Judge Grade uses to score each criterion with the
+// G-Eval technique (chain-of-thought scoring smoothed by token
+// log-probabilities). When set, it takes priority over WithProvider.
+func WithJudge(judge Judge) Option {
+	return func(g *SkillClarityGrader) { g.judge = judge }
+}
+
+// NewSkillClarityGraderWithJudge is a convenience wrapper around
+// NewSkillClarityGrader(WithJudge(judge), opts...) for the common case of
+// grading with a G-Eval judge and nothing else.
+func NewSkillClarityGraderWithJudge(judge Judge, opts ...Option) *SkillClarityGrader {
+	return NewSkillClarityGrader(append([]Option{WithJudge(judge)}, opts...)...)
+}
+
+// NewSkillClarityGrader creates a new skill clarity grader with default
+// weights and, unless WithProvider or WithJudge says otherwise, the
+// heuristic NoopProvider.
+func NewSkillClarityGrader(opts ...Option) *SkillClarityGrader {
+	g := &SkillClarityGrader{
 		passingScore: 70.0, // Default passing threshold
+		provider:     NoopProvider{},
+	}
+	WithCriteria(defaultCriteria)(g)
+
+	for _, opt := range opts {
+		opt(g)
 	}
+
+	if cfg, ok := g.provider.(configurableProvider); ok {
+		if g.model != "" {
+			cfg.SetModel(g.model)
+		}
+		if g.temperature != 0 {
+			cfg.SetTemperature(g.temperature)
+		}
+	}
+
+	return g
 }
 
-// Grade evaluates skill content against clarity criteria
+// Grade evaluates skill content against clarity criteria. When a Judge
+// is configured (see WithJudge) it takes priority, scoring each criterion
+// with the G-Eval technique; otherwise, when a provider is configured
+// (see WithProvider) it asks the LLM to score each criterion in one
+// shot. If the configured judge or provider fails, or none is
+// configured, Grade falls back to the heuristic evaluateCriteria.
 func (g *SkillClarityGrader) Grade(input GradeInput) (Result, error) {
-	// Stub implementation - will be replaced with LLM-based evaluation
 	criteria := g.evaluateCriteria(input.Content)
+	usedLLM := false
+
+	if g.judge != nil {
+		if gEvalCriteria, err := g.evaluateCriteriaGEval(context.Background(), input.Content); err == nil {
+			criteria = gEvalCriteria
+			usedLLM = true
+		}
+	} else if _, isNoop := g.provider.(NoopProvider); !isNoop {
+		if llmCriteria, err := g.evaluateCriteriaLLM(context.Background(), input.Content); err == nil {
+			criteria = llmCriteria
+			usedLLM = true
+		}
+	}
 
 	// Calculate weighted score
 	totalScore := 0.0
@@ -47,15 +162,22 @@ func (g *SkillClarityGrader) Grade(input GradeInput) (Result, error) {
 	// Build detailed feedback
 	details := make(map[string]any)
 	for name, criterion := range criteria {
+		minScore := g.criteriaByName[name].MinScore
+		passed := minScore <= 0 || criterion.Score >= minScore
+
 		details[name] = map[string]any{
 			"score":    criterion.Score,
 			"feedback": criterion.Feedback,
 			"weight":   g.weights[name],
+			"passed":   passed,
+		}
+		if criterion.Reasoning != "" {
+			details[name].(map[string]any)["reasoning"] = criterion.Reasoning
 		}
 	}
 
 	// Generate summary message
-	message := g.generateMessage(totalScore, criteria)
+	message := g.generateMessage(totalScore, criteria, usedLLM)
 
 	return Result{
 		Passed:  totalScore >= g.passingScore,
@@ -65,91 +187,171 @@ func (g *SkillClarityGrader) Grade(input GradeInput) (Result, error) {
 	}, nil
 }
 
-// evaluateCriteria performs stub evaluation of each criterion
+// criterionGuidance is what evaluateCriteriaLLM asks the model to judge
+// for each criterion, alongside the weights above.
+var criterionGuidance = map[string]string{
+	"clear_instructions": "Are the instructions in this skill documentation unambiguous and easy to follow?",
+	"actionable_steps":   "Are the steps described concrete, ordered, and directly executable?",
+	"good_examples":      "Are the examples in this skill documentation realistic and genuinely helpful?",
+	"appropriate_scope":  "Is the skill's scope focused - neither too broad nor too narrow?",
+}
+
+// criterionResponseSchema is the JSON Schema every criterion prompt asks
+// the model to conform to; passed to LLMProvider.Complete for providers
+// that support constrained output.
+const criterionResponseSchema = `{"type":"object","properties":{"score":{"type":"number"},"rationale":{"type":"string"}},"required":["score","rationale"]}`
+
+const criterionPromptTemplate = `You are grading pokayokay skill documentation against one clarity criterion.
+
+Criterion: %s
+
+Respond with a JSON object matching {"score": <0-100>, "rationale": "<one sentence>"} and nothing else.
+
+Skill documentation:
+%s
+`
+
+// criterionLLMResponse is the JSON shape each criterion prompt asks the
+// model to return.
+type criterionLLMResponse struct {
+	Score     float64 `json:"score"`
+	Rationale string  `json:"rationale"`
+}
+
+// evaluateCriteriaLLM asks g.provider to score each configured criterion
+// independently, returning an error (and leaving Grade to fall back to
+// evaluateCriteria) if any criterion's call or response fails to
+// validate.
+func (g *SkillClarityGrader) evaluateCriteriaLLM(ctx context.Context, content string) (map[string]Criterion, error) {
+	criteria := make(map[string]Criterion, len(g.criteria))
+
+	for _, c := range g.criteria {
+		prompt := fmt.Sprintf(criterionPromptTemplate, c.Rubric, content)
+		if c.FewShot != "" {
+			prompt += fmt.Sprintf("\nExample:\n%s\n", c.FewShot)
+		}
+
+		raw, err := g.provider.Complete(ctx, prompt, criterionResponseSchema)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating %s: %w", c.Name, err)
+		}
+
+		var resp criterionLLMResponse
+		if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+			return nil, fmt.Errorf("parsing %s response: %w", c.Name, err)
+		}
+		if resp.Score < 0 || resp.Score > 100 {
+			return nil, fmt.Errorf("%s score %v out of range [0,100]", c.Name, resp.Score)
+		}
+
+		criteria[c.Name] = Criterion{Score: resp.Score, Feedback: resp.Rationale}
+	}
+
+	return criteria, nil
+}
+
+// builtinHeuristics holds the hand-tuned stub evaluation for each of the
+// four default criteria; evaluateCriteria falls back to
+// genericCriterionHeuristic for any configured criterion (see
+// WithCriteria, LoadGraderConfig) that isn't one of these.
+var builtinHeuristics = map[string]func(content string) Criterion{
+	"clear_instructions": heuristicClearInstructions,
+	"actionable_steps":   heuristicActionableSteps,
+	"good_examples":      heuristicGoodExamples,
+	"appropriate_scope":  heuristicAppropriateScope,
+}
+
+// evaluateCriteria performs stub evaluation of each configured criterion
 // TODO: Replace with LLM-based evaluation
 func (g *SkillClarityGrader) evaluateCriteria(content string) map[string]Criterion {
-	// Stub implementation using basic heuristics
-	// This will be replaced with LLM calls in the future
-
-	criteria := make(map[string]Criterion)
+	criteria := make(map[string]Criterion, len(g.criteria))
 
-	// Clear Instructions - check for instruction markers
-	instructionScore := 50.0 // default neutral score
-	instructionFeedback := "Stub evaluation: Instructions clarity not yet evaluated by LLM"
-	if strings.Contains(strings.ToLower(content), "instruction") {
-		instructionScore = 75.0
-		instructionFeedback = "Stub evaluation: Found instruction section"
+	for _, c := range g.criteria {
+		if heuristic, ok := builtinHeuristics[c.Name]; ok {
+			criteria[c.Name] = heuristic(content)
+			continue
+		}
+		criteria[c.Name] = genericCriterionHeuristic(c, content)
 	}
+
+	return criteria
+}
+
+// heuristicClearInstructions checks for instruction markers.
+func heuristicClearInstructions(content string) Criterion {
 	if content == "" {
-		instructionScore = 0.0
-		instructionFeedback = "Stub evaluation: Empty content"
+		return Criterion{Score: 0.0, Feedback: "Stub evaluation: Empty content"}
 	}
-	criteria["clear_instructions"] = Criterion{
-		Score:    instructionScore,
-		Feedback: instructionFeedback,
+	if strings.Contains(strings.ToLower(content), "instruction") {
+		return Criterion{Score: 75.0, Feedback: "Stub evaluation: Found instruction section"}
 	}
+	return Criterion{Score: 50.0, Feedback: "Stub evaluation: Instructions clarity not yet evaluated by LLM"}
+}
 
-	// Actionable Steps - check for step indicators
-	stepsScore := 50.0
-	stepsFeedback := "Stub evaluation: Actionable steps not yet evaluated by LLM"
-	if strings.Contains(content, "-") || strings.Contains(content, "1.") {
-		stepsScore = 75.0
-		stepsFeedback = "Stub evaluation: Found step-like markers"
-	}
+// heuristicActionableSteps checks for step indicators.
+func heuristicActionableSteps(content string) Criterion {
 	if content == "" {
-		stepsScore = 0.0
-		stepsFeedback = "Stub evaluation: Empty content"
+		return Criterion{Score: 0.0, Feedback: "Stub evaluation: Empty content"}
 	}
-	criteria["actionable_steps"] = Criterion{
-		Score:    stepsScore,
-		Feedback: stepsFeedback,
+	if strings.Contains(content, "-") || strings.Contains(content, "1.") {
+		return Criterion{Score: 75.0, Feedback: "Stub evaluation: Found step-like markers"}
 	}
+	return Criterion{Score: 50.0, Feedback: "Stub evaluation: Actionable steps not yet evaluated by LLM"}
+}
 
-	// Good Examples - check for example markers
-	examplesScore := 50.0
-	examplesFeedback := "Stub evaluation: Examples quality not yet evaluated by LLM"
-	if strings.Contains(strings.ToLower(content), "example") {
-		examplesScore = 75.0
-		examplesFeedback = "Stub evaluation: Found example section"
-	}
+// heuristicGoodExamples checks for example markers.
+func heuristicGoodExamples(content string) Criterion {
 	if content == "" {
-		examplesScore = 0.0
-		examplesFeedback = "Stub evaluation: Empty content"
+		return Criterion{Score: 0.0, Feedback: "Stub evaluation: Empty content"}
 	}
-	criteria["good_examples"] = Criterion{
-		Score:    examplesScore,
-		Feedback: examplesFeedback,
+	if strings.Contains(strings.ToLower(content), "example") {
+		return Criterion{Score: 75.0, Feedback: "Stub evaluation: Found example section"}
 	}
+	return Criterion{Score: 50.0, Feedback: "Stub evaluation: Examples quality not yet evaluated by LLM"}
+}
 
-	// Appropriate Scope - basic content length check
-	scopeScore := 50.0
-	scopeFeedback := "Stub evaluation: Scope appropriateness not yet evaluated by LLM"
+// heuristicAppropriateScope performs a basic content length check.
+func heuristicAppropriateScope(content string) Criterion {
 	contentLength := len(content)
-	if contentLength > 100 && contentLength < 5000 {
-		scopeScore = 75.0
-		scopeFeedback = "Stub evaluation: Content length seems reasonable"
-	} else if contentLength == 0 {
-		scopeScore = 0.0
-		scopeFeedback = "Stub evaluation: Empty content"
-	} else if contentLength >= 5000 {
-		scopeScore = 40.0
-		scopeFeedback = "Stub evaluation: Content might be too broad"
-	} else {
-		scopeScore = 40.0
-		scopeFeedback = "Stub evaluation: Content might be too narrow"
-	}
-	criteria["appropriate_scope"] = Criterion{
-		Score:    scopeScore,
-		Feedback: scopeFeedback,
+	switch {
+	case contentLength == 0:
+		return Criterion{Score: 0.0, Feedback: "Stub evaluation: Empty content"}
+	case contentLength > 100 && contentLength < 5000:
+		return Criterion{Score: 75.0, Feedback: "Stub evaluation: Content length seems reasonable"}
+	case contentLength >= 5000:
+		return Criterion{Score: 40.0, Feedback: "Stub evaluation: Content might be too broad"}
+	default:
+		return Criterion{Score: 40.0, Feedback: "Stub evaluation: Content might be too narrow"}
 	}
+}
 
-	return criteria
+// genericCriterionHeuristic is the fallback stub evaluation for any
+// user-configured criterion without a hand-tuned heuristic (see
+// builtinHeuristics): it looks for the criterion's own name, loosely
+// matched, in the content.
+func genericCriterionHeuristic(c CriterionConfig, content string) Criterion {
+	if content == "" {
+		return Criterion{Score: 0.0, Feedback: "Stub evaluation: Empty content"}
+	}
+
+	keyword := strings.ToLower(strings.ReplaceAll(c.Name, "_", " "))
+	if strings.Contains(strings.ToLower(content), keyword) {
+		return Criterion{Score: 75.0, Feedback: fmt.Sprintf("Stub evaluation: Found content relevant to %s", keyword)}
+	}
+	return Criterion{Score: 50.0, Feedback: fmt.Sprintf("Stub evaluation: %s not yet evaluated by LLM", keyword)}
 }
 
-// generateMessage creates a human-readable summary message
-func (g *SkillClarityGrader) generateMessage(score float64, criteria map[string]Criterion) string {
+// generateMessage creates a human-readable summary message. The stub
+// note is only appended when usedLLM is false, i.e. criteria came from
+// evaluateCriteria rather than evaluateCriteriaLLM.
+func (g *SkillClarityGrader) generateMessage(score float64, criteria map[string]Criterion, usedLLM bool) string {
+	note := " Note: Scored by stub evaluation; no LLM grading result was used."
+	if usedLLM {
+		note = ""
+	}
+
 	if score >= g.passingScore {
-		return fmt.Sprintf("Skill clarity evaluation passed with score %.1f/100. Note: Using stub evaluation; LLM-based grading not yet implemented.", score)
+		return fmt.Sprintf("Skill clarity evaluation passed with score %.1f/100.%s", score, note)
 	}
 
 	// Find weakest criterion
@@ -162,6 +364,6 @@ func (g *SkillClarityGrader) generateMessage(score float64, criteria map[string]
 		}
 	}
 
-	return fmt.Sprintf("Skill clarity evaluation failed with score %.1f/100. Weakest area: %s (%.1f). Note: Using stub evaluation; LLM-based grading not yet implemented.",
-		score, weakestName, weakestScore)
+	return fmt.Sprintf("Skill clarity evaluation failed with score %.1f/100. Weakest area: %s (%.1f).%s",
+		score, weakestName, weakestScore, note)
 }
@@ -1,6 +1,9 @@
 package modelbased
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -128,3 +131,75 @@ Focused and clear.
 		}
 	}
 }
+
+// fakeProvider is a stub LLMProvider for exercising WithProvider without
+// a real network call.
+type fakeProvider struct {
+	response string
+	err      error
+	model    string
+	temp     float64
+}
+
+func (f *fakeProvider) Complete(ctx context.Context, prompt string, schema string) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	return f.response, nil
+}
+
+func (f *fakeProvider) SetModel(model string)              { f.model = model }
+func (f *fakeProvider) SetTemperature(temperature float64) { f.temp = temperature }
+
+func TestSkillClarityGrader_WithProviderUsesLLMScores(t *testing.T) {
+	provider := &fakeProvider{response: `{"score":90,"rationale":"excellent"}`}
+	grader := NewSkillClarityGrader(WithProvider(provider))
+
+	result, err := grader.Grade(GradeInput{Content: "some skill content", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if result.Score != 90 {
+		t.Errorf("Score = %v, want 90 (every criterion scored 90 by the fake provider)", result.Score)
+	}
+	if strings.Contains(result.Message, "stub evaluation") {
+		t.Errorf("Message = %q, should not mention stub evaluation when the LLM path succeeded", result.Message)
+	}
+}
+
+func TestSkillClarityGrader_WithProviderFallsBackOnError(t *testing.T) {
+	provider := &fakeProvider{err: errors.New("network error")}
+	grader := NewSkillClarityGrader(WithProvider(provider))
+
+	result, err := grader.Grade(GradeInput{Content: "## Instructions\n- step one\n## Examples\nstuff", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if !strings.Contains(result.Message, "stub evaluation") {
+		t.Errorf("Message = %q, want fallback to mention stub evaluation when the provider failed", result.Message)
+	}
+}
+
+func TestSkillClarityGrader_WithModelAndTemperatureConfigureProvider(t *testing.T) {
+	provider := &fakeProvider{response: `{"score":50,"rationale":"ok"}`}
+	NewSkillClarityGrader(WithProvider(provider), WithModel("gpt-5"), WithTemperature(0.2))
+
+	if provider.model != "gpt-5" {
+		t.Errorf("provider.model = %q, want %q", provider.model, "gpt-5")
+	}
+	if provider.temp != 0.2 {
+		t.Errorf("provider.temp = %v, want 0.2", provider.temp)
+	}
+}
+
+func TestSkillClarityGrader_NoopProviderUsesHeuristic(t *testing.T) {
+	grader := NewSkillClarityGrader()
+
+	result, err := grader.Grade(GradeInput{Content: "## Instructions\n- step\n## Examples\nstuff", Context: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Grade() error = %v", err)
+	}
+	if !strings.Contains(result.Message, "stub evaluation") {
+		t.Errorf("Message = %q, want default NoopProvider to use the heuristic path", result.Message)
+	}
+}
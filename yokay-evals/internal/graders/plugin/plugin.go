@@ -0,0 +1,211 @@
+// Package plugin lets third-party binaries act as graders (see
+// internal/graders/modelbased) without being compiled into yokay-evals.
+// A grader plugin is any executable named grader-* that speaks a small
+// JSON protocol: `<binary> --describe` reports the kinds of content and
+// criteria weights it grades, and `<binary>` with a GradeRequest on
+// stdin writes a GradeResponse to stdout. This mirrors ExecAdapter's
+// JSON-on-stdin/stdout shape in internal/adapters, which is the repo's
+// existing precedent for out-of-process evaluation.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DescribeResponse is what a grader plugin reports for `--describe`.
+type DescribeResponse struct {
+	Name     string             `json:"name"`
+	Version  string             `json:"version"`
+	Kinds    []string           `json:"kinds"`
+	Criteria map[string]float64 `json:"criteria"`
+}
+
+// GradeRequest is the document sent to a plugin on stdin.
+type GradeRequest struct {
+	Content string         `json:"content"`
+	Context map[string]any `json:"context"`
+	Kind    string         `json:"kind"`
+}
+
+// CriterionResult is one entry in a GradeResponse's Criteria breakdown.
+type CriterionResult struct {
+	Name     string  `json:"name"`
+	Score    float64 `json:"score"`
+	Weight   float64 `json:"weight"`
+	Feedback string  `json:"feedback"`
+}
+
+// GradeResponse is the document a plugin writes to stdout after grading.
+type GradeResponse struct {
+	Score    float64           `json:"score"`
+	Passed   bool              `json:"passed"`
+	Message  string            `json:"message"`
+	Details  map[string]any    `json:"details"`
+	Criteria []CriterionResult `json:"criteria"`
+}
+
+// Grader is a discovered grader-* executable, already --describe'd.
+type Grader struct {
+	Path     string
+	Describe DescribeResponse
+}
+
+// SupportsKind reports whether the plugin declared support for kind in
+// its --describe response.
+func (g *Grader) SupportsKind(kind string) bool {
+	for _, k := range g.Describe.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Grade sends req to the plugin on stdin and parses its GradeResponse
+// from stdout.
+func (g *Grader) Grade(ctx context.Context, req GradeRequest) (GradeResponse, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return GradeResponse{}, fmt.Errorf("marshaling grade request: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, g.Path)
+	cmd.Stdin = bytes.NewReader(payload)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return GradeResponse{}, fmt.Errorf("running grader %s: %w (stderr: %s)", g.Describe.Name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var resp GradeResponse
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return GradeResponse{}, fmt.Errorf("parsing grader %s output: %w", g.Describe.Name, err)
+	}
+	return resp, nil
+}
+
+// load runs `<path> --describe` and wraps the result as a Grader.
+func load(path string) (*Grader, error) {
+	cmd := exec.Command(path, "--describe")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("describing grader %s: %w (stderr: %s)", path, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var d DescribeResponse
+	if err := json.Unmarshal(stdout.Bytes(), &d); err != nil {
+		return nil, fmt.Errorf("parsing --describe output from %s: %w", path, err)
+	}
+	if d.Name == "" {
+		d.Name = filepath.Base(path)
+	}
+	return &Grader{Path: path, Describe: d}, nil
+}
+
+// AllowlistEntry pins or toggles a single grader in graders.yaml.
+type AllowlistEntry struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version,omitempty"`
+	Enabled *bool  `yaml:"enabled,omitempty"`
+}
+
+// Allowlist is the parsed graders.yaml: which grader-* executables (by
+// file name) may run, and at what pinned version. An Allowlist with no
+// entries allows every discovered grader-*, matching the zero-config
+// "just add an executable and it runs" default the request asks for.
+type Allowlist struct {
+	Graders []AllowlistEntry `yaml:"graders"`
+}
+
+// LoadAllowlist reads graders.yaml at path. A missing file is not an
+// error: it means every discovered grader-* runs unpinned.
+func LoadAllowlist(path string) (Allowlist, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Allowlist{}, nil
+	}
+	if err != nil {
+		return Allowlist{}, fmt.Errorf("reading graders allowlist %s: %w", path, err)
+	}
+
+	var al Allowlist
+	if err := yaml.Unmarshal(data, &al); err != nil {
+		return Allowlist{}, fmt.Errorf("parsing graders allowlist %s: %w", path, err)
+	}
+	return al, nil
+}
+
+// allows reports whether name (the grader's file name) may run, and
+// whether its pinned version (if any) matches describedVersion. A
+// grader with no matching entry is allowed by default, so graders.yaml
+// only needs to list the ones an operator wants to pin or disable.
+func (al Allowlist) allows(name, describedVersion string) bool {
+	for _, e := range al.Graders {
+		if e.Name != name {
+			continue
+		}
+		if e.Enabled != nil && !*e.Enabled {
+			return false
+		}
+		if e.Version != "" && e.Version != describedVersion {
+			return false
+		}
+		return true
+	}
+	return true
+}
+
+// Discover finds every executable grader-* file directly under dir,
+// --describe's each one, and drops any that the allowlist rejects or
+// that fail to describe (logged to stderr rather than aborting the
+// whole discovery, since one broken plugin shouldn't take down every
+// other grader). A missing dir is not an error: it means no plugins are
+// installed.
+func Discover(dir string, al Allowlist) ([]*Grader, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading grader directory %s: %w", dir, err)
+	}
+
+	var graders []*Grader
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), "grader-") {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0111 == 0 {
+			continue
+		}
+
+		g, err := load(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping grader plugin %s: %v\n", path, err)
+			continue
+		}
+		if !al.allows(entry.Name(), g.Describe.Version) {
+			continue
+		}
+		graders = append(graders, g)
+	}
+
+	sort.Slice(graders, func(i, j int) bool { return graders[i].Path < graders[j].Path })
+	return graders, nil
+}
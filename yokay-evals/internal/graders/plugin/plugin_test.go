@@ -0,0 +1,126 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeFakeGrader writes a shell script at dir/grader-<name> that answers
+// --describe with describeJSON and otherwise echoes a fixed GradeResponse.
+func writeFakeGrader(t *testing.T, dir, name, describeJSON, gradeJSON string) string {
+	t.Helper()
+	path := filepath.Join(dir, "grader-"+name)
+	script := "#!/bin/sh\n" +
+		"if [ \"$1\" = \"--describe\" ]; then\n" +
+		"  cat <<'EOF'\n" + describeJSON + "\nEOF\n" +
+		"  exit 0\n" +
+		"fi\n" +
+		"cat <<'EOF'\n" + gradeJSON + "\nEOF\n"
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatalf("writing fake grader: %v", err)
+	}
+	return path
+}
+
+func TestDiscoverFindsExecutableGraders(t *testing.T) {
+	dir := t.TempDir()
+	writeFakeGrader(t, dir, "tone",
+		`{"name":"tone","version":"1.0.0","kinds":["skill"],"criteria":{"tone":1.0}}`,
+		`{"score":80,"passed":true,"message":"fine"}`)
+
+	// Non-executable and non-matching files should be ignored.
+	if err := os.WriteFile(filepath.Join(dir, "grader-disabled.txt"), []byte("not a binary"), 0644); err != nil {
+		t.Fatalf("writing non-executable file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "not-a-grader"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatalf("writing unrelated executable: %v", err)
+	}
+
+	graders, err := Discover(dir, Allowlist{})
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if len(graders) != 1 {
+		t.Fatalf("got %d graders, want 1: %+v", len(graders), graders)
+	}
+	if graders[0].Describe.Name != "tone" {
+		t.Errorf("Describe.Name = %q, want %q", graders[0].Describe.Name, "tone")
+	}
+	if !graders[0].SupportsKind("skill") {
+		t.Error("expected grader to support kind \"skill\"")
+	}
+	if graders[0].SupportsKind("eval-run") {
+		t.Error("expected grader not to support kind \"eval-run\"")
+	}
+}
+
+func TestDiscoverMissingDirReturnsEmpty(t *testing.T) {
+	graders, err := Discover(filepath.Join(t.TempDir(), "nonexistent"), Allowlist{})
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if graders != nil {
+		t.Errorf("expected nil, got %+v", graders)
+	}
+}
+
+func TestDiscoverHonorsAllowlist(t *testing.T) {
+	dir := t.TempDir()
+	writeFakeGrader(t, dir, "tone",
+		`{"name":"tone","version":"1.0.0","kinds":["skill"]}`,
+		`{"score":80,"passed":true}`)
+	writeFakeGrader(t, dir, "security",
+		`{"name":"security","version":"1.0.0","kinds":["skill"]}`,
+		`{"score":50,"passed":false}`)
+
+	disabled := false
+	al := Allowlist{Graders: []AllowlistEntry{
+		{Name: "grader-security", Enabled: &disabled},
+	}}
+
+	graders, err := Discover(dir, al)
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if len(graders) != 1 || graders[0].Describe.Name != "tone" {
+		t.Fatalf("expected only \"tone\" to survive the allowlist, got %+v", graders)
+	}
+}
+
+func TestGraderGrade(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFakeGrader(t, dir, "tone",
+		`{"name":"tone","version":"1.0.0","kinds":["skill"]}`,
+		`{"score":91,"passed":true,"message":"good tone","criteria":[{"name":"tone","score":91,"weight":1.0,"feedback":"warm"}]}`)
+
+	graders, err := Discover(dir, Allowlist{})
+	if err != nil || len(graders) != 1 {
+		t.Fatalf("Discover: graders=%v err=%v", graders, err)
+	}
+	if graders[0].Path != path {
+		t.Fatalf("Path = %q, want %q", graders[0].Path, path)
+	}
+
+	resp, err := graders[0].Grade(context.Background(), GradeRequest{Content: "some skill content", Kind: "skill"})
+	if err != nil {
+		t.Fatalf("Grade: %v", err)
+	}
+	if !resp.Passed || resp.Score != 91 || resp.Message != "good tone" {
+		t.Errorf("resp = %+v", resp)
+	}
+	if len(resp.Criteria) != 1 || resp.Criteria[0].Name != "tone" {
+		t.Errorf("resp.Criteria = %+v", resp.Criteria)
+	}
+}
+
+func TestLoadAllowlistMissingFileIsNotError(t *testing.T) {
+	al, err := LoadAllowlist(filepath.Join(t.TempDir(), "graders.yaml"))
+	if err != nil {
+		t.Fatalf("LoadAllowlist: %v", err)
+	}
+	if len(al.Graders) != 0 {
+		t.Errorf("expected empty allowlist, got %+v", al)
+	}
+}
@@ -0,0 +1,258 @@
+// Package graderspec loads user-defined skill-clarity grading criteria
+// from a YAML spec file: a list of named, weighted criteria, each backed
+// by one or more markdown assertions borrowed from venom's ShouldX
+// vocabulary (see internal/assert for the adapter-result flavor of the
+// same idea). NewGraderFromSpec turns a loaded Spec into a
+// modelbased.Grader so it plugs into `grade-skills --spec` exactly like
+// the built-in SkillClarityGrader.
+package graderspec
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+	"gopkg.in/yaml.v3"
+)
+
+// Operator names one of the supported markdown assertions. Names follow
+// the venom/GoConvey convention (ShouldX), matching internal/assert's
+// vocabulary where the two overlap.
+type Operator string
+
+const (
+	ShouldContain       Operator = "ShouldContain"
+	ShouldMatchRegex    Operator = "ShouldMatchRegex"
+	ShouldHaveHeading   Operator = "ShouldHaveHeading"
+	ShouldHaveMinWords  Operator = "ShouldHaveMinWords"
+	ShouldNotContain    Operator = "ShouldNotContain"
+	ShouldHaveCodeBlock Operator = "ShouldHaveCodeBlock"
+)
+
+// operators is the set of Operators ValidateOperator accepts, keyed for
+// an O(1) lookup.
+var operators = map[Operator]bool{
+	ShouldContain:       true,
+	ShouldMatchRegex:    true,
+	ShouldHaveHeading:   true,
+	ShouldHaveMinWords:  true,
+	ShouldNotContain:    true,
+	ShouldHaveCodeBlock: true,
+}
+
+// ValidateOperator reports an error naming the invalid operator (and
+// listing the valid ones) if op is not one of the supported Operators.
+// LoadSpec calls this at load time so a typo in an operator name fails
+// fast instead of silently never matching.
+func ValidateOperator(op Operator) error {
+	if operators[op] {
+		return nil
+	}
+	return fmt.Errorf("unknown assertion operator %q (want one of ShouldContain, ShouldMatchRegex, ShouldHaveHeading, ShouldHaveMinWords, ShouldNotContain, ShouldHaveCodeBlock)", op)
+}
+
+// Assertion is a single markdown check, evaluated directly against a
+// skill's raw content rather than a selector into a structured result
+// (contrast internal/assert.Assertion, which asserts on adapter output).
+type Assertion struct {
+	Operator Operator `yaml:"operator"`
+	// Expected holds the operator's argument: the substring/regex/heading
+	// text to look for, the minimum word count for ShouldHaveMinWords, or
+	// the fenced language for ShouldHaveCodeBlock (empty matches any
+	// fenced code block). Unused by no current operator, but left
+	// optional for ones that don't need it.
+	Expected string `yaml:"expected,omitempty"`
+}
+
+// Criterion is one named, weighted grading dimension, satisfied by the
+// fraction of its Assertions that hold against the skill content.
+type Criterion struct {
+	Name       string      `yaml:"name"`
+	Weight     float64     `yaml:"weight"`
+	Assertions []Assertion `yaml:"assertions"`
+}
+
+// Spec is the top-level shape of a --spec YAML file.
+type Spec struct {
+	Criteria []Criterion `yaml:"criteria"`
+}
+
+// weightTolerance allows for the rounding error inherent in hand-writing
+// weights like 0.33/0.33/0.34.
+const weightTolerance = 0.001
+
+// LoadSpec reads and validates a Spec from path: every criterion needs a
+// name and at least one assertion, every assertion operator must be
+// known, and the criteria weights must sum to 1.0 (within
+// weightTolerance) so Grader.Grade's weighted sum stays on a 0-100
+// scale.
+func LoadSpec(path string) (Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Spec{}, fmt.Errorf("reading grader spec: %w", err)
+	}
+
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return Spec{}, fmt.Errorf("parsing grader spec: %w", err)
+	}
+
+	if len(spec.Criteria) == 0 {
+		return Spec{}, fmt.Errorf("grader spec %s defines no criteria", path)
+	}
+
+	var totalWeight float64
+	for _, c := range spec.Criteria {
+		if c.Name == "" {
+			return Spec{}, fmt.Errorf("grader spec %s: criterion with empty name", path)
+		}
+		if len(c.Assertions) == 0 {
+			return Spec{}, fmt.Errorf("grader spec %s: criterion %q has no assertions", path, c.Name)
+		}
+		for _, a := range c.Assertions {
+			if err := ValidateOperator(a.Operator); err != nil {
+				return Spec{}, fmt.Errorf("grader spec %s: criterion %q: %w", path, c.Name, err)
+			}
+		}
+		totalWeight += c.Weight
+	}
+
+	if math.Abs(totalWeight-1.0) > weightTolerance {
+		return Spec{}, fmt.Errorf("grader spec %s: criteria weights sum to %.4f, want 1.0", path, totalWeight)
+	}
+
+	return spec, nil
+}
+
+// defaultPassingScore matches modelbased.NewSkillClarityGrader's default
+// so a --spec grade and the heuristic grade-skills agree on what
+// "passing" means.
+const defaultPassingScore = 70.0
+
+// Grader is a modelbased.Grader driven by a Spec: each criterion scores
+// 100 * (assertions that hold / total assertions), and the overall score
+// is the weighted sum, mirroring how
+// modelbased.SkillClarityGrader.evaluateCriteria scores so --spec
+// reports plug into the same parseGradeReport/report --trend pipeline.
+type Grader struct {
+	spec         Spec
+	passingScore float64
+}
+
+// NewGraderFromSpec loads and validates the YAML spec at path and
+// returns a modelbased.Grader that scores skill content against it.
+func NewGraderFromSpec(path string) (modelbased.Grader, error) {
+	spec, err := LoadSpec(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Grader{spec: spec, passingScore: defaultPassingScore}, nil
+}
+
+// Grade implements modelbased.Grader.
+func (g *Grader) Grade(input modelbased.GradeInput) (modelbased.Result, error) {
+	details := make(map[string]any, len(g.spec.Criteria))
+	totalScore := 0.0
+
+	for _, c := range g.spec.Criteria {
+		passed := 0
+		var failures []string
+		for _, a := range c.Assertions {
+			ok, err := evaluate(a, input.Content)
+			if err != nil {
+				return modelbased.Result{}, fmt.Errorf("criterion %q: %w", c.Name, err)
+			}
+			if ok {
+				passed++
+			} else {
+				failures = append(failures, describeAssertion(a))
+			}
+		}
+
+		score := 100 * float64(passed) / float64(len(c.Assertions))
+		feedback := "All checks passed."
+		if len(failures) > 0 {
+			feedback = fmt.Sprintf("Failed: %s", strings.Join(failures, "; "))
+		}
+
+		details[c.Name] = map[string]any{
+			"score":    score,
+			"feedback": feedback,
+			"weight":   c.Weight,
+		}
+		totalScore += score * c.Weight
+	}
+
+	return modelbased.Result{
+		Passed:  totalScore >= g.passingScore,
+		Score:   totalScore,
+		Message: fmt.Sprintf("Spec-based grading scored %.1f/100.", totalScore),
+		Details: details,
+	}, nil
+}
+
+// describeAssertion renders a failed Assertion for a criterion's
+// feedback message.
+func describeAssertion(a Assertion) string {
+	if a.Expected == "" {
+		return string(a.Operator)
+	}
+	return fmt.Sprintf("%s(%q)", a.Operator, a.Expected)
+}
+
+// evaluate checks a single Assertion against content.
+func evaluate(a Assertion, content string) (bool, error) {
+	switch a.Operator {
+	case ShouldContain:
+		return strings.Contains(content, a.Expected), nil
+	case ShouldNotContain:
+		return !strings.Contains(content, a.Expected), nil
+	case ShouldMatchRegex:
+		re, err := regexp.Compile(a.Expected)
+		if err != nil {
+			return false, fmt.Errorf("invalid regex %q: %w", a.Expected, err)
+		}
+		return re.MatchString(content), nil
+	case ShouldHaveHeading:
+		return hasHeading(content, a.Expected), nil
+	case ShouldHaveMinWords:
+		minWords, err := strconv.Atoi(strings.TrimSpace(a.Expected))
+		if err != nil {
+			return false, fmt.Errorf("ShouldHaveMinWords expected %q is not an integer: %w", a.Expected, err)
+		}
+		return len(strings.Fields(content)) >= minWords, nil
+	case ShouldHaveCodeBlock:
+		return hasCodeBlock(content, a.Expected), nil
+	default:
+		return false, fmt.Errorf("unknown operator %q", a.Operator)
+	}
+}
+
+// headingPattern matches a markdown ATX heading line ("# Title", "## Title", ...).
+var headingPattern = regexp.MustCompile(`(?m)^#{1,6}\s+(.+)$`)
+
+// hasHeading reports whether content has any heading whose text contains
+// want, case-insensitively.
+func hasHeading(content, want string) bool {
+	want = strings.ToLower(strings.TrimSpace(want))
+	for _, m := range headingPattern.FindAllStringSubmatch(content, -1) {
+		if strings.Contains(strings.ToLower(m[1]), want) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCodeBlock reports whether content has a fenced code block, matching
+// a specific fence language when lang is non-empty (e.g. "bash" for
+// "```bash") or any fence at all when lang is empty.
+func hasCodeBlock(content, lang string) bool {
+	if lang == "" {
+		return strings.Contains(content, "```")
+	}
+	return strings.Contains(content, "```"+lang)
+}
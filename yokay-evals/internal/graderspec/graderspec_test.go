@@ -0,0 +1,138 @@
+package graderspec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stevestomp/yokay-evals/internal/graders/modelbased"
+)
+
+func TestValidateOperator(t *testing.T) {
+	if err := ValidateOperator(ShouldHaveHeading); err != nil {
+		t.Errorf("ValidateOperator(ShouldHaveHeading) = %v, want nil", err)
+	}
+	if err := ValidateOperator("ShouldExplode"); err == nil {
+		t.Error(`ValidateOperator("ShouldExplode") = nil, want an error`)
+	}
+}
+
+func TestLoadSpec(t *testing.T) {
+	cases := []struct {
+		name    string
+		yaml    string
+		wantErr bool
+	}{
+		{"valid", `
+criteria:
+  - name: has_heading
+    weight: 0.5
+    assertions:
+      - operator: ShouldHaveHeading
+        expected: Instructions
+  - name: has_example
+    weight: 0.5
+    assertions:
+      - operator: ShouldContain
+        expected: example
+`, false},
+		{"weights don't sum to 1", `
+criteria:
+  - name: a
+    weight: 0.5
+    assertions:
+      - operator: ShouldContain
+        expected: x
+`, true},
+		{"unknown operator", `
+criteria:
+  - name: a
+    weight: 1.0
+    assertions:
+      - operator: ShouldExplode
+        expected: x
+`, true},
+		{"no criteria", `criteria: []`, true},
+		{"criterion with no assertions", `
+criteria:
+  - name: a
+    weight: 1.0
+`, true},
+		{"criterion with no name", `
+criteria:
+  - weight: 1.0
+    assertions:
+      - operator: ShouldContain
+        expected: x
+`, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "spec.yaml")
+			if err := os.WriteFile(path, []byte(c.yaml), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := LoadSpec(path)
+			if (err != nil) != c.wantErr {
+				t.Errorf("LoadSpec() err = %v, wantErr %v", err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestGraderGrade(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spec.yaml")
+	spec := `
+criteria:
+  - name: clarity
+    weight: 0.6
+    assertions:
+      - operator: ShouldHaveHeading
+        expected: Instructions
+      - operator: ShouldHaveMinWords
+        expected: "5"
+  - name: examples
+    weight: 0.4
+    assertions:
+      - operator: ShouldHaveCodeBlock
+        expected: bash
+`
+	if err := os.WriteFile(path, []byte(spec), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader, err := NewGraderFromSpec(path)
+	if err != nil {
+		t.Fatalf("NewGraderFromSpec() err = %v", err)
+	}
+
+	content := "# Instructions\n\nDo the thing carefully every time.\n\n```bash\necho hi\n```\n"
+	result, err := grader.Grade(modelbased.GradeInput{Content: content})
+	if err != nil {
+		t.Fatalf("Grade() err = %v", err)
+	}
+	if result.Score != 100 {
+		t.Errorf("Score = %v, want 100", result.Score)
+	}
+	if !result.Passed {
+		t.Error("Passed = false, want true")
+	}
+
+	clarity, ok := result.Details["clarity"].(map[string]any)
+	if !ok {
+		t.Fatal("Details[\"clarity\"] missing or wrong type")
+	}
+	if clarity["weight"] != 0.6 {
+		t.Errorf("clarity weight = %v, want 0.6", clarity["weight"])
+	}
+
+	failing, err := grader.Grade(modelbased.GradeInput{Content: "no heading, no code, too short"})
+	if err != nil {
+		t.Fatalf("Grade() err = %v", err)
+	}
+	if failing.Passed {
+		t.Error("Passed = true for failing content, want false")
+	}
+}
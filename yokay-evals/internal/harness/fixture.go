@@ -0,0 +1,333 @@
+package harness
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v3"
+)
+
+// Fixture is a replayable agent regression case, loaded from a
+// fixture directory's fixture.yaml. Unlike a plain FailureCase, a Fixture
+// declares the inputs an agent driver needs to reproduce a scenario
+// (seed files, env vars, mocked tool responses) and the working-directory
+// state expected afterwards, so the whole exchange can be replayed and
+// diffed without a human re-describing it in eval criteria.
+type Fixture struct {
+	ID       string          `yaml:"id"`
+	Category string          `yaml:"category"`
+	Inputs   FixtureInputs   `yaml:"inputs"`
+	Expected FixtureExpected `yaml:"expected"`
+
+	// Dir is the fixture's directory on disk. It is set by LoadFixture,
+	// not read from YAML, and anchors the SeedDir lookup below.
+	Dir string `yaml:"-"`
+}
+
+// FixtureInputs describes what must be true of the working directory and
+// environment before the agent driver runs.
+type FixtureInputs struct {
+	// Task is the task spec handed to the agent driver.
+	Task string `yaml:"task"`
+	// SeedDir, relative to the fixture's directory, holds files to copy
+	// into the working directory before the driver runs. Defaults to
+	// "input" when unset.
+	SeedDir string `yaml:"seed_dir,omitempty"`
+	// Env is the set of environment variables the driver should see.
+	Env map[string]string `yaml:"env,omitempty"`
+	// ToolResponses maps a mocked tool name to the canned response the
+	// driver should return for it, so a fixture can replay a scenario
+	// without hitting real tools.
+	ToolResponses map[string]string `yaml:"tool_responses,omitempty"`
+}
+
+// FixtureExpected describes what must be true of the working directory
+// (and driver output) after the agent driver runs.
+type FixtureExpected struct {
+	// Files lists the working-directory files that must match.
+	Files []ExpectedFile `yaml:"files,omitempty"`
+	// LogLines is a set of regexes that must each match at least one line
+	// the driver produced.
+	LogLines []string `yaml:"log_lines,omitempty"`
+	// IgnorePaths are path prefixes (relative to the working directory)
+	// excluded from comparison, e.g. scratch files the agent is allowed
+	// to leave behind.
+	IgnorePaths []string `yaml:"ignore_paths,omitempty"`
+}
+
+// ExpectedFile is a single file assertion within FixtureExpected.
+type ExpectedFile struct {
+	Path string `yaml:"path"`
+	// Equals, when set, requires the file's contents to match exactly.
+	Equals string `yaml:"equals,omitempty"`
+	// Regex, when set, requires the file's contents to match the pattern.
+	Regex string `yaml:"regex,omitempty"`
+	// JSON, when true, compares the file as a JSON snapshot against
+	// Equals: both sides are unmarshaled and compared structurally, so
+	// key order and formatting differences don't fail the fixture.
+	JSON bool `yaml:"json,omitempty"`
+}
+
+// AgentDriver invokes whatever produces the working-directory changes a
+// Fixture expects. The real driver (a subprocess agent runner, an API
+// client, etc.) is pluggable; RunFixture only depends on this interface.
+type AgentDriver interface {
+	Run(workingDir, task string, env, toolResponses map[string]string) (logLines []string, err error)
+}
+
+// NoopAgentDriver is a placeholder AgentDriver for fixtures that only
+// assert on pre-seeded working-directory state (e.g. a fixture exercising
+// assertion logic itself) or until a real driver is wired in. It performs
+// no action and produces no log lines.
+type NoopAgentDriver struct{}
+
+// Run implements AgentDriver by doing nothing.
+func (NoopAgentDriver) Run(workingDir, task string, env, toolResponses map[string]string) ([]string, error) {
+	return nil, nil
+}
+
+// FixtureResult is the outcome of RunFixture.
+type FixtureResult struct {
+	CaseID string
+	Passed bool
+	// Diffs holds one human-readable line per mismatch between the
+	// working directory (and log lines) and FixtureExpected.
+	Diffs []string
+}
+
+// LoadFixture reads fixture.yaml from dir and returns the parsed Fixture
+// with Dir populated.
+func LoadFixture(dir string) (Fixture, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "fixture.yaml"))
+	if err != nil {
+		return Fixture{}, fmt.Errorf("reading fixture.yaml: %w", err)
+	}
+
+	var fixture Fixture
+	if err := yaml.Unmarshal(data, &fixture); err != nil {
+		return Fixture{}, fmt.Errorf("parsing fixture.yaml: %w", err)
+	}
+	fixture.Dir = dir
+
+	return fixture, nil
+}
+
+// FindFixtures walks fixturesRoot and returns one Fixture per immediate
+// subdirectory that contains a fixture.yaml, mirroring crowdsec's
+// per-directory test layout (each directory is one case, not each file).
+func FindFixtures(fixturesRoot string) ([]Fixture, error) {
+	entries, err := os.ReadDir(fixturesRoot)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading fixtures directory: %w", err)
+	}
+
+	var fixtures []Fixture
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(fixturesRoot, entry.Name())
+		if _, err := os.Stat(filepath.Join(dir, "fixture.yaml")); err != nil {
+			continue
+		}
+		fixture, err := LoadFixture(dir)
+		if err != nil {
+			return nil, fmt.Errorf("loading fixture %s: %w", dir, err)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+
+	sort.Slice(fixtures, func(i, j int) bool { return fixtures[i].ID < fixtures[j].ID })
+	return fixtures, nil
+}
+
+// WorkingDirProvider is anything that exposes a working directory to run
+// a fixture in. *IsolatedContext satisfies this; ExistingDir lets callers
+// that already manage their own directory lifecycle (e.g. an eval run
+// that owns its own IsolatedContext) reuse it without a second temp dir.
+type WorkingDirProvider interface {
+	WorkingDir() string
+}
+
+// existingDir adapts a directory that already exists (and is cleaned up
+// by its owner) to WorkingDirProvider.
+type existingDir string
+
+func (d existingDir) WorkingDir() string { return string(d) }
+
+// ExistingDir wraps an already-created directory as a WorkingDirProvider,
+// for callers running a fixture inside a working directory they manage
+// themselves rather than one created fresh by NewIsolatedContext.
+func ExistingDir(dir string) WorkingDirProvider {
+	return existingDir(dir)
+}
+
+// RunFixture materializes fixture's inputs into ctx's working directory,
+// invokes driver, and diffs the resulting working directory (and captured
+// log lines) against fixture.Expected.
+func RunFixture(ctx WorkingDirProvider, fixture Fixture, driver AgentDriver) (*FixtureResult, error) {
+	if err := seedWorkingDir(ctx.WorkingDir(), fixture); err != nil {
+		return nil, fmt.Errorf("seeding working directory: %w", err)
+	}
+
+	logLines, err := driver.Run(ctx.WorkingDir(), fixture.Inputs.Task, fixture.Inputs.Env, fixture.Inputs.ToolResponses)
+	if err != nil {
+		return nil, fmt.Errorf("running agent driver: %w", err)
+	}
+
+	result := &FixtureResult{CaseID: fixture.ID}
+	result.Diffs = append(result.Diffs, diffFiles(ctx.WorkingDir(), fixture.Expected)...)
+	result.Diffs = append(result.Diffs, diffLogLines(logLines, fixture.Expected.LogLines)...)
+	result.Passed = len(result.Diffs) == 0
+
+	return result, nil
+}
+
+// seedWorkingDir copies fixture.Inputs.SeedDir (default "input") into dir.
+func seedWorkingDir(dir string, fixture Fixture) error {
+	seedDir := fixture.Inputs.SeedDir
+	if seedDir == "" {
+		seedDir = "input"
+	}
+	src := filepath.Join(fixture.Dir, seedDir)
+
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(dir, rel)
+		if info.IsDir() {
+			return os.MkdirAll(dest, 0755)
+		}
+		return copyFile(path, dest)
+	})
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// diffFiles checks every expected file against the working directory,
+// honoring FixtureExpected.IgnorePaths.
+func diffFiles(workingDir string, expected FixtureExpected) []string {
+	var diffs []string
+
+	for _, ef := range expected.Files {
+		if isIgnored(ef.Path, expected.IgnorePaths) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(workingDir, ef.Path))
+		if err != nil {
+			diffs = append(diffs, fmt.Sprintf("%s: expected file was not produced", ef.Path))
+			continue
+		}
+
+		switch {
+		case ef.JSON:
+			if diff := diffJSON(ef.Path, ef.Equals, string(data)); diff != "" {
+				diffs = append(diffs, diff)
+			}
+		case ef.Regex != "":
+			re, err := regexp.Compile(ef.Regex)
+			if err != nil {
+				diffs = append(diffs, fmt.Sprintf("%s: invalid regex %q: %v", ef.Path, ef.Regex, err))
+				continue
+			}
+			if !re.Match(data) {
+				diffs = append(diffs, fmt.Sprintf("%s: content does not match regex %q", ef.Path, ef.Regex))
+			}
+		case ef.Equals != "":
+			if string(data) != ef.Equals {
+				diffs = append(diffs, fmt.Sprintf("%s: content mismatch (want %q, got %q)", ef.Path, ef.Equals, string(data)))
+			}
+		}
+	}
+
+	return diffs
+}
+
+// diffJSON compares two JSON documents structurally, ignoring key order
+// and formatting, so a snapshot isn't broken by re-serialization. It uses
+// go-cmp so a mismatch reports exactly which field diverged rather than
+// just the two top-level values.
+func diffJSON(path, want, got string) string {
+	var wantDoc, gotDoc any
+	if err := json.Unmarshal([]byte(want), &wantDoc); err != nil {
+		return fmt.Sprintf("%s: expected JSON snapshot is invalid: %v", path, err)
+	}
+	if err := json.Unmarshal([]byte(got), &gotDoc); err != nil {
+		return fmt.Sprintf("%s: produced file is not valid JSON: %v", path, err)
+	}
+	if diff := cmp.Diff(wantDoc, gotDoc); diff != "" {
+		return fmt.Sprintf("%s: JSON snapshot mismatch (-want +got):\n%s", path, diff)
+	}
+	return ""
+}
+
+// diffLogLines requires every pattern to match at least one captured line.
+func diffLogLines(lines []string, patterns []string) []string {
+	var diffs []string
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			diffs = append(diffs, fmt.Sprintf("log pattern %q is invalid: %v", pattern, err))
+			continue
+		}
+		matched := false
+		for _, line := range lines {
+			if re.MatchString(line) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			diffs = append(diffs, fmt.Sprintf("no log line matched pattern %q", pattern))
+		}
+	}
+	return diffs
+}
+
+func isIgnored(path string, ignorePaths []string) bool {
+	for _, prefix := range ignorePaths {
+		if path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
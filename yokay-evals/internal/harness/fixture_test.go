@@ -0,0 +1,166 @@
+package harness
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type recordingDriver struct {
+	logLines []string
+}
+
+func (d recordingDriver) Run(workingDir, task string, env, toolResponses map[string]string) ([]string, error) {
+	// A real driver would act on the working directory; this test driver
+	// just drops a marker file so RunFixture has something to diff.
+	if err := os.WriteFile(filepath.Join(workingDir, "output.txt"), []byte(task+"\n"), 0644); err != nil {
+		return nil, err
+	}
+	return d.logLines, nil
+}
+
+func writeFixtureDir(t *testing.T, root string) string {
+	t.Helper()
+
+	dir := filepath.Join(root, "greet")
+	if err := os.MkdirAll(filepath.Join(dir, "input"), 0755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+
+	manifest := `id: greet
+category: smoke
+inputs:
+  task: "hello fixture"
+expected:
+  files:
+    - path: output.txt
+      equals: "hello fixture\n"
+  log_lines:
+    - "^driver ran$"
+`
+	if err := os.WriteFile(filepath.Join(dir, "fixture.yaml"), []byte(manifest), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	return dir
+}
+
+func TestLoadFixture(t *testing.T) {
+	root := t.TempDir()
+	dir := writeFixtureDir(t, root)
+
+	fixture, err := LoadFixture(dir)
+	if err != nil {
+		t.Fatalf("LoadFixture() error = %v", err)
+	}
+
+	if fixture.ID != "greet" {
+		t.Errorf("ID = %q, want %q", fixture.ID, "greet")
+	}
+	if fixture.Inputs.Task != "hello fixture" {
+		t.Errorf("Inputs.Task = %q, want %q", fixture.Inputs.Task, "hello fixture")
+	}
+}
+
+func TestFindFixtures(t *testing.T) {
+	root := t.TempDir()
+	writeFixtureDir(t, root)
+
+	fixtures, err := FindFixtures(root)
+	if err != nil {
+		t.Fatalf("FindFixtures() error = %v", err)
+	}
+
+	if len(fixtures) != 1 {
+		t.Fatalf("expected 1 fixture, got %d", len(fixtures))
+	}
+	if fixtures[0].ID != "greet" {
+		t.Errorf("fixtures[0].ID = %q, want %q", fixtures[0].ID, "greet")
+	}
+}
+
+func TestFindFixturesMissingDir(t *testing.T) {
+	fixtures, err := FindFixtures(filepath.Join(t.TempDir(), "does-not-exist"))
+	if err != nil {
+		t.Fatalf("FindFixtures() error = %v, want nil for a missing directory", err)
+	}
+	if fixtures != nil {
+		t.Errorf("expected no fixtures, got %v", fixtures)
+	}
+}
+
+func TestRunFixturePass(t *testing.T) {
+	root := t.TempDir()
+	dir := writeFixtureDir(t, root)
+
+	fixture, err := LoadFixture(dir)
+	if err != nil {
+		t.Fatalf("LoadFixture() error = %v", err)
+	}
+
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v", err)
+	}
+	defer ctx.Cleanup()
+
+	result, err := RunFixture(ctx, fixture, recordingDriver{logLines: []string{"driver ran"}})
+	if err != nil {
+		t.Fatalf("RunFixture() error = %v", err)
+	}
+	if !result.Passed {
+		t.Errorf("expected fixture to pass, diffs: %v", result.Diffs)
+	}
+}
+
+func TestRunFixtureFailsOnMismatch(t *testing.T) {
+	root := t.TempDir()
+	dir := writeFixtureDir(t, root)
+
+	fixture, err := LoadFixture(dir)
+	if err != nil {
+		t.Fatalf("LoadFixture() error = %v", err)
+	}
+
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v", err)
+	}
+	defer ctx.Cleanup()
+
+	// No log lines produced, so the log_lines expectation should fail.
+	result, err := RunFixture(ctx, fixture, recordingDriver{})
+	if err != nil {
+		t.Fatalf("RunFixture() error = %v", err)
+	}
+	if result.Passed {
+		t.Error("expected fixture to fail due to missing log line")
+	}
+	if len(result.Diffs) == 0 {
+		t.Error("expected at least one diff")
+	}
+}
+
+func TestRunFixtureMissingFile(t *testing.T) {
+	root := t.TempDir()
+	dir := writeFixtureDir(t, root)
+
+	fixture, err := LoadFixture(dir)
+	if err != nil {
+		t.Fatalf("LoadFixture() error = %v", err)
+	}
+
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v", err)
+	}
+	defer ctx.Cleanup()
+
+	result, err := RunFixture(ctx, fixture, NoopAgentDriver{})
+	if err != nil {
+		t.Fatalf("RunFixture() error = %v", err)
+	}
+	if result.Passed {
+		t.Error("expected fixture to fail because output.txt was never produced")
+	}
+}
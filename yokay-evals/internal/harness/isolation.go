@@ -1,8 +1,14 @@
 package harness
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"sort"
 )
 
 // IsolatedContext manages the lifecycle of an isolated evaluation environment.
@@ -59,3 +65,259 @@ func (c *IsolatedContext) Cleanup() error {
 	c.cleaned = true
 	return nil
 }
+
+// cleanupReporter is the subset of *testing.T that KeepOnFailure needs.
+// Tests for KeepOnFailure itself can't drive both the pass and fail
+// branches through a real *testing.T without one of those subtests
+// always being reported as a genuine failure, so they satisfy this with
+// a fake instead.
+type cleanupReporter interface {
+	Cleanup(func())
+	Failed() bool
+	Logf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// KeepOnFailure registers a t.Cleanup that removes the working directory
+// on success but, when t has failed, logs the retained path and leaves
+// it on disk instead - invaluable when debugging why a grading run
+// scored a skill's working tree differently than expected. It replaces
+// a bare defer ctx.Cleanup(); callers should not also defer Cleanup
+// themselves.
+func (c *IsolatedContext) KeepOnFailure(t cleanupReporter) {
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Logf("keeping working directory for inspection: %s", c.workingDir)
+			c.cleaned = true
+			return
+		}
+		if err := c.Cleanup(); err != nil {
+			t.Errorf("Cleanup() error = %v", err)
+		}
+	})
+}
+
+// Seed copies every file in fsys into the working directory, rooted at
+// fsys's root. Use this to materialize an embedded or OS filesystem
+// (e.g. a realistic repository fixture) before grading against it; call
+// Overlay afterwards to layer in-memory edits on top.
+func (c *IsolatedContext) Seed(fsys fs.FS) error {
+	return fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(c.workingDir, path)
+		if d.IsDir() {
+			return os.MkdirAll(dest, 0755)
+		}
+
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(dest, data, 0644)
+	})
+}
+
+// Overlay writes files atop whatever Seed (or nothing) already put in
+// the working directory, keyed by path relative to it - mirroring the
+// golang.org/x/tools/go/packages/packagestest overlay concept, where a
+// small in-memory diff is layered onto a real checkout without mutating
+// the original source.
+func (c *IsolatedContext) Overlay(files map[string][]byte) error {
+	for rel, data := range files {
+		dest := filepath.Join(c.workingDir, rel)
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return fmt.Errorf("creating directory for overlay file %s: %w", rel, err)
+		}
+		if err := os.WriteFile(dest, data, 0644); err != nil {
+			return fmt.Errorf("writing overlay file %s: %w", rel, err)
+		}
+	}
+	return nil
+}
+
+// Snapshot is a point-in-time, in-memory copy of an IsolatedContext's
+// working directory, suitable for Restore. Keeping it in memory (rather
+// than a second temp directory) is what lets a grader mutate the tree,
+// evaluate, and roll back between runs without paying the cost of
+// another MkdirTemp and Seed/Overlay pass per iteration.
+type Snapshot struct {
+	files map[string][]byte
+}
+
+// Snapshot captures every file currently in the working directory so a
+// later Restore can bring it back to this exact state.
+func (c *IsolatedContext) Snapshot() (*Snapshot, error) {
+	files := make(map[string][]byte)
+
+	err := filepath.Walk(c.workingDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(c.workingDir, path)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", rel, err)
+		}
+		files[rel] = data
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("snapshotting working directory: %w", err)
+	}
+
+	return &Snapshot{files: files}, nil
+}
+
+// Restore replaces the working directory's contents with exactly what
+// snapshot captured, discarding any files written or removed since.
+func (c *IsolatedContext) Restore(snapshot *Snapshot) error {
+	if err := os.RemoveAll(c.workingDir); err != nil {
+		return fmt.Errorf("clearing working directory: %w", err)
+	}
+	if err := os.MkdirAll(c.workingDir, 0755); err != nil {
+		return fmt.Errorf("recreating working directory: %w", err)
+	}
+
+	for rel, data := range snapshot.files {
+		dest := filepath.Join(c.workingDir, rel)
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return fmt.Errorf("creating directory for %s: %w", rel, err)
+		}
+		if err := os.WriteFile(dest, data, 0644); err != nil {
+			return fmt.Errorf("restoring %s: %w", rel, err)
+		}
+	}
+
+	return nil
+}
+
+// InodeKeyword names what kind of change an InodeDelta represents,
+// mirroring mtree's Check/Compare vocabulary.
+type InodeKeyword string
+
+const (
+	// Missing means the path was present in the "before" TreeSnapshot but
+	// is gone from the "after" one.
+	Missing InodeKeyword = "missing"
+	// Extra means the path wasn't present in the "before" TreeSnapshot but
+	// exists in the "after" one.
+	Extra InodeKeyword = "extra"
+	// Modified means the path exists in both, but its mode, size, or
+	// SHA-256 changed.
+	Modified InodeKeyword = "modified"
+)
+
+// InodeInfo is one file's metadata as recorded by a TreeSnapshot.
+type InodeInfo struct {
+	Mode   os.FileMode
+	Size   int64
+	SHA256 string
+}
+
+// TreeSnapshot is a lightweight, metadata-only record of every file under
+// a WorkingDir() at a point in time: unlike Snapshot (which keeps full
+// file contents in memory for Restore), it keeps only mode/size/SHA-256
+// per path, which is all DiffTree needs to report what changed between
+// two points in a run without paying to hold every byte twice.
+type TreeSnapshot struct {
+	Entries map[string]InodeInfo
+}
+
+// InodeDelta is one path's difference between two TreeSnapshots - the
+// same shape as an mtree Check/Compare result entry. Expected and Got are
+// each path's SHA-256 from the "before" and "after" TreeSnapshot
+// respectively; one of them is empty for Missing/Extra entries.
+type InodeDelta struct {
+	Path     string
+	Keyword  InodeKeyword
+	Expected string
+	Got      string
+}
+
+// SnapshotTree records every file currently in the working directory as a
+// TreeSnapshot, for a later DiffTree call. Call it once before letting an
+// agent or candidate artifact tree touch the working directory, and pass
+// the result to DiffTree once it's done, to see exactly what it added,
+// removed, or changed.
+func (c *IsolatedContext) SnapshotTree() (*TreeSnapshot, error) {
+	entries := make(map[string]InodeInfo)
+
+	err := filepath.Walk(c.workingDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(c.workingDir, path)
+		if err != nil {
+			return err
+		}
+		sum, err := sha256File(path)
+		if err != nil {
+			return fmt.Errorf("hashing %s: %w", rel, err)
+		}
+		entries[rel] = InodeInfo{Mode: info.Mode(), Size: info.Size(), SHA256: sum}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("snapshotting working directory tree: %w", err)
+	}
+
+	return &TreeSnapshot{Entries: entries}, nil
+}
+
+// DiffTree snapshots the working directory's current state and reports
+// every InodeDelta between prev and now, sorted by path so the result is
+// deterministic regardless of filesystem iteration order.
+func (c *IsolatedContext) DiffTree(prev *TreeSnapshot) ([]InodeDelta, error) {
+	next, err := c.SnapshotTree()
+	if err != nil {
+		return nil, err
+	}
+
+	var deltas []InodeDelta
+	for path, before := range prev.Entries {
+		after, ok := next.Entries[path]
+		if !ok {
+			deltas = append(deltas, InodeDelta{Path: path, Keyword: Missing, Expected: before.SHA256})
+			continue
+		}
+		if after.SHA256 != before.SHA256 || after.Mode != before.Mode || after.Size != before.Size {
+			deltas = append(deltas, InodeDelta{Path: path, Keyword: Modified, Expected: before.SHA256, Got: after.SHA256})
+		}
+	}
+	for path, after := range next.Entries {
+		if _, ok := prev.Entries[path]; !ok {
+			deltas = append(deltas, InodeDelta{Path: path, Keyword: Extra, Got: after.SHA256})
+		}
+	}
+
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i].Path < deltas[j].Path })
+	return deltas, nil
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"testing/fstest"
 )
 
 func TestNewIsolatedContext(t *testing.T) {
@@ -128,3 +129,236 @@ func TestMultipleIsolatedContexts(t *testing.T) {
 		t.Errorf("Context #2 working directory does not exist: %v", err)
 	}
 }
+
+func TestSeedCopiesFsysIntoWorkingDir(t *testing.T) {
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v, want nil", err)
+	}
+	defer ctx.Cleanup()
+
+	fsys := fstest.MapFS{
+		"README.md":   &fstest.MapFile{Data: []byte("hello")},
+		"src/main.go": &fstest.MapFile{Data: []byte("package main")},
+	}
+
+	if err := ctx.Seed(fsys); err != nil {
+		t.Fatalf("Seed() error = %v, want nil", err)
+	}
+
+	for path, want := range map[string]string{"README.md": "hello", "src/main.go": "package main"} {
+		got, err := os.ReadFile(filepath.Join(ctx.WorkingDir(), path))
+		if err != nil {
+			t.Fatalf("reading seeded %s: %v", path, err)
+		}
+		if string(got) != want {
+			t.Errorf("seeded %s = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestOverlayWritesAtopSeed(t *testing.T) {
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v, want nil", err)
+	}
+	defer ctx.Cleanup()
+
+	fsys := fstest.MapFS{"a.txt": &fstest.MapFile{Data: []byte("original")}}
+	if err := ctx.Seed(fsys); err != nil {
+		t.Fatalf("Seed() error = %v, want nil", err)
+	}
+
+	if err := ctx.Overlay(map[string][]byte{
+		"a.txt":         []byte("overlaid"),
+		"new/added.txt": []byte("new file"),
+	}); err != nil {
+		t.Fatalf("Overlay() error = %v, want nil", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(ctx.WorkingDir(), "a.txt"))
+	if err != nil {
+		t.Fatalf("reading overlaid a.txt: %v", err)
+	}
+	if string(got) != "overlaid" {
+		t.Errorf("a.txt = %q, want %q", got, "overlaid")
+	}
+
+	got, err = os.ReadFile(filepath.Join(ctx.WorkingDir(), "new/added.txt"))
+	if err != nil {
+		t.Fatalf("reading new/added.txt: %v", err)
+	}
+	if string(got) != "new file" {
+		t.Errorf("new/added.txt = %q, want %q", got, "new file")
+	}
+}
+
+func TestSnapshotAndRestore(t *testing.T) {
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v, want nil", err)
+	}
+	defer ctx.Cleanup()
+
+	if err := ctx.Overlay(map[string][]byte{"state.txt": []byte("before")}); err != nil {
+		t.Fatalf("Overlay() error = %v, want nil", err)
+	}
+
+	snapshot, err := ctx.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v, want nil", err)
+	}
+
+	if err := ctx.Overlay(map[string][]byte{
+		"state.txt": []byte("mutated"),
+		"extra.txt": []byte("should disappear on restore"),
+	}); err != nil {
+		t.Fatalf("Overlay() error = %v, want nil", err)
+	}
+
+	if err := ctx.Restore(snapshot); err != nil {
+		t.Fatalf("Restore() error = %v, want nil", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(ctx.WorkingDir(), "state.txt"))
+	if err != nil {
+		t.Fatalf("reading restored state.txt: %v", err)
+	}
+	if string(got) != "before" {
+		t.Errorf("state.txt after restore = %q, want %q", got, "before")
+	}
+
+	if _, err := os.Stat(filepath.Join(ctx.WorkingDir(), "extra.txt")); !os.IsNotExist(err) {
+		t.Error("extra.txt should not exist after Restore()")
+	}
+}
+
+func TestDiffTreeReportsMissingExtraModified(t *testing.T) {
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v, want nil", err)
+	}
+	defer ctx.Cleanup()
+
+	if err := ctx.Overlay(map[string][]byte{
+		"unchanged.txt": []byte("same"),
+		"removed.txt":   []byte("gone soon"),
+		"changed.txt":   []byte("before"),
+	}); err != nil {
+		t.Fatalf("Overlay() error = %v, want nil", err)
+	}
+
+	before, err := ctx.SnapshotTree()
+	if err != nil {
+		t.Fatalf("SnapshotTree() error = %v, want nil", err)
+	}
+
+	if err := os.Remove(filepath.Join(ctx.WorkingDir(), "removed.txt")); err != nil {
+		t.Fatalf("Remove() error = %v, want nil", err)
+	}
+	if err := ctx.Overlay(map[string][]byte{
+		"changed.txt": []byte("after"),
+		"added.txt":   []byte("new"),
+	}); err != nil {
+		t.Fatalf("Overlay() error = %v, want nil", err)
+	}
+
+	deltas, err := ctx.DiffTree(before)
+	if err != nil {
+		t.Fatalf("DiffTree() error = %v, want nil", err)
+	}
+
+	byPath := make(map[string]InodeDelta, len(deltas))
+	for _, d := range deltas {
+		byPath[d.Path] = d
+	}
+
+	if _, ok := byPath["unchanged.txt"]; ok {
+		t.Error("unchanged.txt should not appear in the diff")
+	}
+	if d, ok := byPath["removed.txt"]; !ok || d.Keyword != Missing {
+		t.Errorf("removed.txt delta = %+v, want Keyword=Missing", d)
+	}
+	if d, ok := byPath["added.txt"]; !ok || d.Keyword != Extra {
+		t.Errorf("added.txt delta = %+v, want Keyword=Extra", d)
+	}
+	if d, ok := byPath["changed.txt"]; !ok || d.Keyword != Modified {
+		t.Errorf("changed.txt delta = %+v, want Keyword=Modified", d)
+	}
+	if len(deltas) != 3 {
+		t.Errorf("len(deltas) = %d, want 3", len(deltas))
+	}
+}
+
+func TestSnapshotTreeNoChangesProducesEmptyDiff(t *testing.T) {
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v, want nil", err)
+	}
+	defer ctx.Cleanup()
+
+	if err := ctx.Overlay(map[string][]byte{"state.txt": []byte("stable")}); err != nil {
+		t.Fatalf("Overlay() error = %v, want nil", err)
+	}
+
+	before, err := ctx.SnapshotTree()
+	if err != nil {
+		t.Fatalf("SnapshotTree() error = %v, want nil", err)
+	}
+
+	deltas, err := ctx.DiffTree(before)
+	if err != nil {
+		t.Fatalf("DiffTree() error = %v, want nil", err)
+	}
+	if len(deltas) != 0 {
+		t.Errorf("deltas = %+v, want none", deltas)
+	}
+}
+
+// fakeCleanupReporter is a minimal cleanupReporter stand-in, used so
+// KeepOnFailure's pass/fail branches can both be exercised directly
+// instead of through a real *testing.T subtest - which would always
+// report the "should fail" case as a genuine test failure regardless of
+// whether KeepOnFailure behaved correctly.
+type fakeCleanupReporter struct {
+	failed  bool
+	cleanup func()
+}
+
+func (f *fakeCleanupReporter) Cleanup(fn func())                       { f.cleanup = fn }
+func (f *fakeCleanupReporter) Failed() bool                            { return f.failed }
+func (f *fakeCleanupReporter) Logf(format string, args ...interface{}) {}
+func (f *fakeCleanupReporter) Errorf(format string, args ...interface{}) {}
+
+func TestKeepOnFailureRemovesDirOnSuccess(t *testing.T) {
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v, want nil", err)
+	}
+	workingDir := ctx.WorkingDir()
+
+	ft := &fakeCleanupReporter{failed: false}
+	ctx.KeepOnFailure(ft)
+	ft.cleanup()
+
+	if _, err := os.Stat(workingDir); !os.IsNotExist(err) {
+		t.Error("working directory should have been removed after a passing test")
+	}
+}
+
+func TestKeepOnFailureKeepsDirOnFailure(t *testing.T) {
+	ctx, err := NewIsolatedContext()
+	if err != nil {
+		t.Fatalf("NewIsolatedContext() error = %v, want nil", err)
+	}
+	workingDir := ctx.WorkingDir()
+	defer os.RemoveAll(workingDir)
+
+	ft := &fakeCleanupReporter{failed: true}
+	ctx.KeepOnFailure(ft)
+	ft.cleanup()
+
+	if _, err := os.Stat(workingDir); os.IsNotExist(err) {
+		t.Error("working directory should have been kept after a failing test")
+	}
+}
@@ -0,0 +1,116 @@
+// Package history owns reports/index.jsonl, the append-only time series
+// of grade-report summaries that `report trend` reads instead of
+// re-parsing every skill-clarity-*.md file on each invocation.
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// SkillPoint is one skill's score at a single Record's date.
+type SkillPoint struct {
+	Name   string  `json:"name"`
+	Score  float64 `json:"score"`
+	Passed bool    `json:"passed"`
+}
+
+// CriterionPoint is one criterion's average score at a single Record's
+// date.
+type CriterionPoint struct {
+	Name    string  `json:"name"`
+	Average float64 `json:"average"`
+}
+
+// Record is a single dated grade-report summary: one line in
+// index.jsonl.
+type Record struct {
+	Date         string           `json:"date"`
+	TotalSkills  int              `json:"totalSkills"`
+	AverageScore float64          `json:"averageScore"`
+	PassRate     float64          `json:"passRate"`
+	Skills       []SkillPoint     `json:"skills"`
+	Criteria     []CriterionPoint `json:"criteria"`
+}
+
+// ReadIndex reads every Record in indexPath, oldest first. A missing
+// file is not an error: it means no history has been recorded yet.
+func ReadIndex(indexPath string) ([]Record, error) {
+	f, err := os.Open(indexPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening history index %s: %w", indexPath, err)
+	}
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		var rec Record
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			return nil, fmt.Errorf("parsing history index %s: %w", indexPath, err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading history index %s: %w", indexPath, err)
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].Date < records[j].Date })
+	return records, nil
+}
+
+// AppendRecord appends rec to indexPath as one JSON line, creating the
+// file if it doesn't exist yet. A record whose Date already exists in
+// the index is skipped rather than duplicated, so re-running
+// gradeSkillsWithFormats for the same day (e.g. to regenerate a report)
+// doesn't grow the index.
+func AppendRecord(indexPath string, rec Record) error {
+	existing, err := ReadIndex(indexPath)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if r.Date == rec.Date {
+			return nil
+		}
+	}
+
+	f, err := os.OpenFile(indexPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening history index %s: %w", indexPath, err)
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshaling history record: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing history index %s: %w", indexPath, err)
+	}
+	return nil
+}
+
+// Migrate backfills indexPath with every record in records whose Date
+// isn't already present, preserving chronological order. It's how
+// `report trend` bootstraps index.jsonl from markdown reports that
+// predate this package.
+func Migrate(indexPath string, records []Record) error {
+	for _, rec := range records {
+		if err := AppendRecord(indexPath, rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
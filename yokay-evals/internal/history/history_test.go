@@ -0,0 +1,83 @@
+package history
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestReadIndexMissingFileReturnsEmpty(t *testing.T) {
+	records, err := ReadIndex(filepath.Join(t.TempDir(), "index.jsonl"))
+	if err != nil {
+		t.Fatalf("ReadIndex: %v", err)
+	}
+	if records != nil {
+		t.Errorf("expected nil records for missing file, got %v", records)
+	}
+}
+
+func TestAppendRecordThenReadIndexRoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.jsonl")
+
+	rec := Record{
+		Date:         "2026-01-01",
+		TotalSkills:  2,
+		AverageScore: 82.5,
+		PassRate:     100,
+		Skills:       []SkillPoint{{Name: "foo", Score: 80, Passed: true}},
+	}
+	if err := AppendRecord(path, rec); err != nil {
+		t.Fatalf("AppendRecord: %v", err)
+	}
+
+	records, err := ReadIndex(path)
+	if err != nil {
+		t.Fatalf("ReadIndex: %v", err)
+	}
+	if len(records) != 1 || records[0].Date != rec.Date {
+		t.Fatalf("expected 1 record for %s, got %v", rec.Date, records)
+	}
+}
+
+func TestAppendRecordSkipsDuplicateDate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.jsonl")
+
+	first := Record{Date: "2026-01-01", AverageScore: 80}
+	second := Record{Date: "2026-01-01", AverageScore: 95}
+	if err := AppendRecord(path, first); err != nil {
+		t.Fatalf("AppendRecord(first): %v", err)
+	}
+	if err := AppendRecord(path, second); err != nil {
+		t.Fatalf("AppendRecord(second): %v", err)
+	}
+
+	records, err := ReadIndex(path)
+	if err != nil {
+		t.Fatalf("ReadIndex: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected duplicate date to be skipped, got %d records", len(records))
+	}
+	if records[0].AverageScore != 80 {
+		t.Errorf("expected the original record to be kept, got AverageScore=%v", records[0].AverageScore)
+	}
+}
+
+func TestMigrateBackfillsInChronologicalOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.jsonl")
+
+	err := Migrate(path, []Record{
+		{Date: "2026-01-02", AverageScore: 90},
+		{Date: "2026-01-01", AverageScore: 85},
+	})
+	if err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+
+	records, err := ReadIndex(path)
+	if err != nil {
+		t.Fatalf("ReadIndex: %v", err)
+	}
+	if len(records) != 2 || records[0].Date != "2026-01-01" || records[1].Date != "2026-01-02" {
+		t.Fatalf("expected records sorted by date, got %v", records)
+	}
+}
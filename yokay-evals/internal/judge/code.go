@@ -0,0 +1,138 @@
+package judge
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/stevestomp/yokay-evals/internal/codebased"
+)
+
+// CodeJudge evaluates a Case.Check two ways, depending on its shape. A
+// `key==value && key2!=value2` clause expression is evaluated
+// deterministically against Transcript alone, without shelling out or
+// invoking a model; its grammar and semantics match assertExpr in
+// cmd/yokay-evals/assertions.go exactly (judge can't import that package
+// - cmd depends on internal, not the reverse - and no expression sandbox
+// such as cel-go or go.starlark.net is vendored here, so the clause
+// grammar is reimplemented rather than pulled in as a dependency).
+// Supported keys: exit_code, stdout, stderr. Any other Check is run
+// through internal/codebased.Executor as a shell snippet or a registered
+// named check against a real working directory (see Case.WorkingDir),
+// since that kind of check needs an actual artifact tree rather than
+// just the Transcript text.
+type CodeJudge struct{}
+
+// NewCodeJudge returns a CodeJudge.
+func NewCodeJudge() *CodeJudge {
+	return &CodeJudge{}
+}
+
+// Evaluate dispatches c.Check to the clause grammar if it looks like one
+// (see isClauseExpr), otherwise to codebased.Executor.
+func (j *CodeJudge) Evaluate(ctx context.Context, c Case, tr Transcript) (Verdict, error) {
+	if isClauseExpr(c.Check) {
+		pass, reason, err := evalCodeExpr(c.Check, tr)
+		if err != nil {
+			return Verdict{}, fmt.Errorf("code judge %q: %w", c.ID, err)
+		}
+		if pass {
+			return Verdict{Pass: true, Reasoning: "all clauses matched", Score: 100}, nil
+		}
+		return Verdict{Pass: false, Reasoning: reason, Score: 0}, nil
+	}
+
+	return j.evaluateCodebasedCheck(ctx, c)
+}
+
+// evaluateCodebasedCheck runs c.Check through codebased.Executor, which
+// requires a real working directory to materialize evidence.what_was_built
+// (and, optionally, a candidate directory) into.
+func (j *CodeJudge) evaluateCodebasedCheck(ctx context.Context, c Case) (Verdict, error) {
+	if c.WorkingDir == "" {
+		return Verdict{}, fmt.Errorf("code judge %q: check %q requires a working directory (Case.WorkingDir)", c.ID, c.Check)
+	}
+
+	result, err := codebased.NewExecutor(0).Execute(ctx, c.WorkingDir, c.WhatWasBuilt, c.CandidateDir, c.Check)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("code judge %q: %w", c.ID, err)
+	}
+	return Verdict{Pass: result.Passed, Reasoning: result.Message, Score: result.Score}, nil
+}
+
+// isClauseExpr reports whether check looks like the `&&`-joined
+// key==value/key!=value clause grammar, rather than a codebased shell
+// snippet or named check: every top-level clause must contain "==" or
+// "!=". An empty check, or one with no such operator in every clause,
+// falls through to codebased.Executor instead.
+func isClauseExpr(check string) bool {
+	check = strings.TrimSpace(check)
+	if check == "" {
+		return false
+	}
+	for _, clause := range strings.Split(check, "&&") {
+		clause = strings.TrimSpace(clause)
+		if !strings.Contains(clause, "==") && !strings.Contains(clause, "!=") {
+			return false
+		}
+	}
+	return true
+}
+
+func evalCodeExpr(expr string, tr Transcript) (bool, string, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return false, "", fmt.Errorf("empty check expression")
+	}
+
+	clauses := strings.Split(expr, "&&")
+	for _, clause := range clauses {
+		clause = strings.TrimSpace(clause)
+
+		negate := false
+		sep := "=="
+		if strings.Contains(clause, "!=") {
+			negate = true
+			sep = "!="
+		} else if !strings.Contains(clause, "==") {
+			return false, "", fmt.Errorf("malformed clause %q: expected key==value or key!=value", clause)
+		}
+
+		parts := strings.SplitN(clause, sep, 2)
+		if len(parts) != 2 {
+			return false, "", fmt.Errorf("malformed clause %q", clause)
+		}
+		key := strings.TrimSpace(parts[0])
+		want := strings.Trim(strings.TrimSpace(parts[1]), `"'`)
+
+		matched, err := clauseMatches(key, want, tr)
+		if err != nil {
+			return false, "", err
+		}
+		if negate {
+			matched = !matched
+		}
+		if !matched {
+			return false, fmt.Sprintf("clause %q did not hold", clause), nil
+		}
+	}
+	return true, "", nil
+}
+
+func clauseMatches(key, want string, tr Transcript) (bool, error) {
+	switch key {
+	case "exit_code":
+		wantCode, err := strconv.Atoi(want)
+		if err != nil {
+			return false, fmt.Errorf("exit_code clause: %q is not an integer", want)
+		}
+		return tr.ExitCode == wantCode, nil
+	case "stdout":
+		return tr.Stdout == want, nil
+	case "stderr":
+		return tr.Stderr == want, nil
+	default:
+		return false, fmt.Errorf("unknown clause key %q", key)
+	}
+}
@@ -0,0 +1,87 @@
+package judge
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCodeJudgeEvaluate(t *testing.T) {
+	tr := Transcript{Stdout: "build succeeded", Stderr: "", ExitCode: 0}
+
+	tests := []struct {
+		name      string
+		check     string
+		wantPass  bool
+		wantError bool
+	}{
+		{name: "single clause passes", check: "exit_code==0", wantPass: true},
+		{name: "single clause fails", check: "exit_code==1", wantPass: false},
+		{name: "stdout exact match", check: `stdout=="build succeeded"`, wantPass: true},
+		{name: "stdout mismatch", check: `stdout==succeeded`, wantPass: false},
+		{name: "negated clause", check: `stderr!=panic`, wantPass: true},
+		{name: "combined clauses", check: `exit_code==0 && stdout=="build succeeded"`, wantPass: true},
+		{name: "combined clauses one fails", check: "exit_code==0 && stdout==nope", wantPass: false},
+		{name: "unknown key errors", check: "bogus==1", wantError: true},
+		{name: "empty expression errors", check: "", wantError: true},
+	}
+
+	j := NewCodeJudge()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := j.Evaluate(context.Background(), Case{ID: tt.name, Check: tt.check}, tr)
+			if tt.wantError {
+				if err == nil {
+					t.Fatalf("expected error, got verdict %+v", verdict)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if verdict.Pass != tt.wantPass {
+				t.Errorf("Pass = %v, want %v (reasoning: %s)", verdict.Pass, tt.wantPass, verdict.Reasoning)
+			}
+		})
+	}
+}
+
+func TestCodeJudgeEvaluateShellCheckRequiresWorkingDir(t *testing.T) {
+	j := NewCodeJudge()
+	_, err := j.Evaluate(context.Background(), Case{ID: "shell", Check: "exit 0"}, Transcript{})
+	if err == nil {
+		t.Fatal("expected an error when a non-clause check has no WorkingDir")
+	}
+}
+
+func TestCodeJudgeEvaluateShellCheckWithWorkingDir(t *testing.T) {
+	j := NewCodeJudge()
+	verdict, err := j.Evaluate(context.Background(), Case{
+		ID:         "shell",
+		Check:      "exit 0",
+		WorkingDir: t.TempDir(),
+	}, Transcript{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !verdict.Pass {
+		t.Errorf("Pass = false, want true (reasoning: %s)", verdict.Reasoning)
+	}
+}
+
+func TestIsClauseExpr(t *testing.T) {
+	tests := []struct {
+		check string
+		want  bool
+	}{
+		{"exit_code==0", true},
+		{"exit_code==0 && stdout==ok", true},
+		{"", false},
+		{"exit 0", false},
+		{"grep_test_file login", false},
+	}
+	for _, tt := range tests {
+		if got := isClauseExpr(tt.check); got != tt.want {
+			t.Errorf("isClauseExpr(%q) = %v, want %v", tt.check, got, tt.want)
+		}
+	}
+}
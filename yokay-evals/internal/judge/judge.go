@@ -0,0 +1,92 @@
+// Package judge dispatches `type: code-based` and `type: model-based`
+// eval_criteria to a runtime that actually evaluates them, instead of the
+// legacy pass-unconditionally placeholder in cmd/yokay-evals/assertions.go.
+// A Judge takes a Case (the criterion under test) and a Transcript (the
+// structured output of the run being judged) and returns a Verdict.
+// Judges are registered by the same string used as eval_criteria's `type`
+// YAML tag, so New("code-based", nil) and New("model-based", cfg) are the
+// only two entry points callers need.
+package judge
+
+import (
+	"context"
+	"fmt"
+)
+
+// Case is the eval_criteria entry a Judge evaluates.
+type Case struct {
+	// ID identifies the criterion for error messages and prompt text; it
+	// is EvalCriterion.Name (or Type as a fallback), not the
+	// FailureCase's ID.
+	ID string
+	// Check is the criterion's check field: a boolean expression or a
+	// codebased check for CodeJudge, or free-form rubric instructions for
+	// ModelJudge.
+	Check string
+	// Task and RootCause carry the FailureCase's context so a ModelJudge
+	// prompt can reference what the agent was asked to do and what went
+	// wrong the first time.
+	Task      string
+	RootCause string
+	// WorkingDir, WhatWasBuilt, and CandidateDir are only consumed by
+	// CodeJudge, and only when Check isn't a key==value clause
+	// expression: they're forwarded to codebased.Executor.Execute, which
+	// needs a real working directory to materialize artifacts into and
+	// run a shell or named check from. WorkingDir is typically the
+	// caller's harness.IsolatedContext.WorkingDir().
+	WorkingDir   string
+	WhatWasBuilt string
+	CandidateDir string
+}
+
+// Transcript is the structured output of the run being judged.
+type Transcript struct {
+	Stdout    string
+	Stderr    string
+	ExitCode  int
+	Artifacts map[string]string
+}
+
+// Verdict is a Judge's evaluation of a Case against a Transcript.
+type Verdict struct {
+	Pass      bool
+	Reasoning string
+	// Score is a 0-100 confidence/quality score. CodeJudge always returns
+	// 0 or 100; ModelJudge reports whatever its backend returns.
+	Score float64
+}
+
+// Judge evaluates a Case against a Transcript and returns a Verdict.
+type Judge interface {
+	Evaluate(ctx context.Context, c Case, tr Transcript) (Verdict, error)
+}
+
+// Factory constructs a Judge from its judge_config block (mirrors
+// internal/adapters.Factory).
+type Factory func(config map[string]any) (Judge, error)
+
+// registry maps an eval_criteria `type` to the Factory that builds its
+// Judge.
+var registry = map[string]Factory{
+	"code-based":  newCodeJudgeFromConfig,
+	"model-based": newModelJudgeFromConfig,
+}
+
+// Register adds or replaces the Factory for a criterion type.
+func Register(criterionType string, factory Factory) {
+	registry[criterionType] = factory
+}
+
+// New builds the Judge registered for criterionType with the given
+// config.
+func New(criterionType string, config map[string]any) (Judge, error) {
+	factory, ok := registry[criterionType]
+	if !ok {
+		return nil, fmt.Errorf("unknown judge type: %s", criterionType)
+	}
+	return factory(config)
+}
+
+func newCodeJudgeFromConfig(config map[string]any) (Judge, error) {
+	return NewCodeJudge(), nil
+}
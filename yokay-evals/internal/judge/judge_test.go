@@ -0,0 +1,15 @@
+package judge
+
+import "testing"
+
+func TestNewBuildsRegisteredJudges(t *testing.T) {
+	if _, err := New("code-based", nil); err != nil {
+		t.Errorf("New(code-based): %v", err)
+	}
+	if _, err := New("model-based", nil); err != nil {
+		t.Errorf("New(model-based): %v", err)
+	}
+	if _, err := New("nonexistent", nil); err == nil {
+		t.Error("expected error for unregistered judge type")
+	}
+}
@@ -0,0 +1,191 @@
+package judge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// httpLLMClient is the shared transport for the HTTP-based LLMClient
+// implementations below: only the request/response shaping differs
+// between providers.
+type httpLLMClient struct {
+	endpoint     string
+	apiKey       string
+	model        string
+	client       *http.Client
+	buildRequest func(endpoint, apiKey, model, prompt string) (*http.Request, error)
+	parseReply   func(body []byte) (string, error)
+}
+
+func (c *httpLLMClient) Complete(ctx context.Context, prompt string) (string, error) {
+	req, err := c.buildRequest(c.endpoint, c.apiKey, c.model, prompt)
+	if err != nil {
+		return "", fmt.Errorf("building request: %w", err)
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("calling %s: %w", c.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned %s: %s", c.endpoint, resp.Status, body)
+	}
+
+	return c.parseReply(body)
+}
+
+const defaultOpenAIEndpoint = "https://api.openai.com/v1/chat/completions"
+
+// NewOpenAIClient returns an LLMClient that sends prompts to the OpenAI
+// chat completions API.
+func NewOpenAIClient(apiKey, model string) LLMClient {
+	if model == "" {
+		model = "gpt-4o-mini"
+	}
+	return &httpLLMClient{
+		endpoint: defaultOpenAIEndpoint,
+		apiKey:   apiKey,
+		model:    model,
+		client:   &http.Client{Timeout: 60 * time.Second},
+		buildRequest: func(endpoint, apiKey, model, prompt string) (*http.Request, error) {
+			payload, err := json.Marshal(map[string]any{
+				"model": model,
+				"messages": []map[string]string{
+					{"role": "user", "content": prompt},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+apiKey)
+			return req, nil
+		},
+		parseReply: func(body []byte) (string, error) {
+			var reply struct {
+				Choices []struct {
+					Message struct {
+						Content string `json:"content"`
+					} `json:"message"`
+				} `json:"choices"`
+			}
+			if err := json.Unmarshal(body, &reply); err != nil {
+				return "", fmt.Errorf("parsing openai response: %w", err)
+			}
+			if len(reply.Choices) == 0 {
+				return "", fmt.Errorf("openai response had no choices: %s", body)
+			}
+			return reply.Choices[0].Message.Content, nil
+		},
+	}
+}
+
+const defaultAnthropicEndpoint = "https://api.anthropic.com/v1/messages"
+
+// NewAnthropicClient returns an LLMClient that sends prompts to the
+// Anthropic messages API.
+func NewAnthropicClient(apiKey, model string) LLMClient {
+	if model == "" {
+		model = "claude-3-5-sonnet-latest"
+	}
+	return &httpLLMClient{
+		endpoint: defaultAnthropicEndpoint,
+		apiKey:   apiKey,
+		model:    model,
+		client:   &http.Client{Timeout: 60 * time.Second},
+		buildRequest: func(endpoint, apiKey, model, prompt string) (*http.Request, error) {
+			payload, err := json.Marshal(map[string]any{
+				"model":      model,
+				"max_tokens": 1024,
+				"messages": []map[string]string{
+					{"role": "user", "content": prompt},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("x-api-key", apiKey)
+			req.Header.Set("anthropic-version", "2023-06-01")
+			return req, nil
+		},
+		parseReply: func(body []byte) (string, error) {
+			var reply struct {
+				Content []struct {
+					Text string `json:"text"`
+				} `json:"content"`
+			}
+			if err := json.Unmarshal(body, &reply); err != nil {
+				return "", fmt.Errorf("parsing anthropic response: %w", err)
+			}
+			if len(reply.Content) == 0 {
+				return "", fmt.Errorf("anthropic response had no content blocks: %s", body)
+			}
+			return reply.Content[0].Text, nil
+		},
+	}
+}
+
+const defaultOllamaEndpoint = "http://localhost:11434/api/generate"
+
+// NewOllamaClient returns an LLMClient that sends prompts to a local
+// Ollama server's generate endpoint. endpoint defaults to the standard
+// local Ollama address when empty.
+func NewOllamaClient(endpoint, model string) LLMClient {
+	if endpoint == "" {
+		endpoint = defaultOllamaEndpoint
+	}
+	if model == "" {
+		model = "llama3"
+	}
+	return &httpLLMClient{
+		endpoint: endpoint,
+		model:    model,
+		client:   &http.Client{Timeout: 120 * time.Second},
+		buildRequest: func(endpoint, apiKey, model, prompt string) (*http.Request, error) {
+			payload, err := json.Marshal(map[string]any{
+				"model":  model,
+				"prompt": prompt,
+				"stream": false,
+			})
+			if err != nil {
+				return nil, err
+			}
+			req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			return req, nil
+		},
+		parseReply: func(body []byte) (string, error) {
+			var reply struct {
+				Response string `json:"response"`
+			}
+			if err := json.Unmarshal(body, &reply); err != nil {
+				return "", fmt.Errorf("parsing ollama response: %w", err)
+			}
+			return reply.Response, nil
+		},
+	}
+}
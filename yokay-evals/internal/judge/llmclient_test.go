@@ -0,0 +1,120 @@
+package judge
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+// serveFixture spins up an httptest.Server that replies with the
+// recorded JSON body at testdata/<name>, regardless of request shape —
+// the golden fixtures stand in for the provider's real response so
+// these tests exercise the client's own response-parsing path.
+func serveFixture(t *testing.T, name string) *httptest.Server {
+	t.Helper()
+	body, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("reading fixture %s: %v", name, err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+}
+
+func TestOpenAIClientCompleteParsesFixture(t *testing.T) {
+	srv := serveFixture(t, "openai_response.json")
+	defer srv.Close()
+
+	client := NewOpenAIClient("test-key", "gpt-4o-mini")
+	client.(*httpLLMClient).endpoint = srv.URL
+
+	reply, err := client.Complete(context.Background(), "grade this run")
+	if err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	want := `{"pass": true, "reasoning": "the agent addressed the root cause", "score": 92}`
+	if reply != want {
+		t.Errorf("reply = %q, want %q", reply, want)
+	}
+}
+
+func TestAnthropicClientCompleteParsesFixture(t *testing.T) {
+	srv := serveFixture(t, "anthropic_response.json")
+	defer srv.Close()
+
+	client := NewAnthropicClient("test-key", "claude-3-5-sonnet-latest")
+	client.(*httpLLMClient).endpoint = srv.URL
+
+	reply, err := client.Complete(context.Background(), "grade this run")
+	if err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	want := `{"pass": false, "reasoning": "the regression is still present in stderr", "score": 20}`
+	if reply != want {
+		t.Errorf("reply = %q, want %q", reply, want)
+	}
+}
+
+func TestOllamaClientCompleteParsesFixture(t *testing.T) {
+	srv := serveFixture(t, "ollama_response.json")
+	defer srv.Close()
+
+	client := NewOllamaClient(srv.URL, "llama3")
+
+	reply, err := client.Complete(context.Background(), "grade this run")
+	if err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	want := `{"pass": true, "reasoning": "looks correct", "score": 75}`
+	if reply != want {
+		t.Errorf("reply = %q, want %q", reply, want)
+	}
+}
+
+// fixtureClient replays a fixed response, so ModelJudge's parsing logic
+// can be tested independently of any HTTP transport.
+type fixtureClient struct{ reply string }
+
+func (c fixtureClient) Complete(ctx context.Context, prompt string) (string, error) {
+	return c.reply, nil
+}
+
+func TestModelJudgeEvaluateParsesVerdict(t *testing.T) {
+	j := NewModelJudge(fixtureClient{reply: `{"pass": true, "reasoning": "matches rubric", "score": 88}`})
+
+	verdict, err := j.Evaluate(context.Background(), Case{ID: "rubric-1", Check: "output explains the fix"}, Transcript{Stdout: "fixed the race"})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !verdict.Pass || verdict.Reasoning != "matches rubric" || verdict.Score != 88 {
+		t.Errorf("verdict = %+v, want Pass=true Reasoning=%q Score=88", verdict, "matches rubric")
+	}
+}
+
+func TestModelJudgeEvaluateToleratesMarkdownFence(t *testing.T) {
+	fenced := "Here is my assessment:\n```json\n{\"pass\": false, \"reasoning\": \"missing test coverage\", \"score\": 40}\n```\n"
+	j := NewModelJudge(fixtureClient{reply: fenced})
+
+	verdict, err := j.Evaluate(context.Background(), Case{ID: "fenced"}, Transcript{})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if verdict.Pass || verdict.Reasoning != "missing test coverage" || verdict.Score != 40 {
+		t.Errorf("verdict = %+v, want Pass=false Reasoning=%q Score=40", verdict, "missing test coverage")
+	}
+}
+
+func TestNoopLLMClientAlwaysPasses(t *testing.T) {
+	j := NewModelJudge(NoopLLMClient{})
+
+	verdict, err := j.Evaluate(context.Background(), Case{ID: "unconfigured"}, Transcript{})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !verdict.Pass {
+		t.Errorf("expected NoopLLMClient-backed judge to pass, got %+v", verdict)
+	}
+}
@@ -0,0 +1,121 @@
+package judge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LLMClient sends a single prompt to a model backend and returns its raw
+// text response.
+type LLMClient interface {
+	Complete(ctx context.Context, prompt string) (string, error)
+}
+
+// ModelJudge evaluates a Case by asking an LLMClient to grade the
+// Transcript against the Case's rubric (Check) and parsing the backend's
+// response as a {"pass":bool,"reasoning":string,"score":number} verdict.
+type ModelJudge struct {
+	client LLMClient
+}
+
+// NewModelJudge returns a ModelJudge backed by client.
+func NewModelJudge(client LLMClient) *ModelJudge {
+	return &ModelJudge{client: client}
+}
+
+func newModelJudgeFromConfig(config map[string]any) (Judge, error) {
+	return NewModelJudge(llmClientFromConfig(config)), nil
+}
+
+// llmClientFromConfig picks an LLMClient based on a judge_config block
+// (provider/model/endpoint) or, absent a provider there, the
+// YOKAY_JUDGE_PROVIDER environment variable. The API key is always read
+// from OPENAI_API_KEY or ANTHROPIC_API_KEY; the config block has no key
+// setting of its own. With neither provider source set it falls
+// back to NoopLLMClient, so model-based criteria keep passing by default
+// until a real backend is configured — the same "swap in a real driver
+// later" shape as harness.NoopAgentDriver.
+func llmClientFromConfig(config map[string]any) LLMClient {
+	provider, _ := config["provider"].(string)
+	if provider == "" {
+		provider = os.Getenv("YOKAY_JUDGE_PROVIDER")
+	}
+	model, _ := config["model"].(string)
+	endpoint, _ := config["endpoint"].(string)
+
+	switch strings.ToLower(provider) {
+	case "openai":
+		return NewOpenAIClient(os.Getenv("OPENAI_API_KEY"), model)
+	case "anthropic":
+		return NewAnthropicClient(os.Getenv("ANTHROPIC_API_KEY"), model)
+	case "ollama":
+		return NewOllamaClient(endpoint, model)
+	default:
+		return NoopLLMClient{}
+	}
+}
+
+// rubricPrompt builds the prompt sent to an LLMClient for a model-based
+// criterion.
+func rubricPrompt(c Case, tr Transcript) string {
+	var b strings.Builder
+	b.WriteString("You are grading whether an AI coding agent's run satisfies a rubric criterion.\n\n")
+	if c.Task != "" {
+		fmt.Fprintf(&b, "Task given to the agent:\n%s\n\n", c.Task)
+	}
+	if c.RootCause != "" {
+		fmt.Fprintf(&b, "Known failure mode to watch for:\n%s\n\n", c.RootCause)
+	}
+	fmt.Fprintf(&b, "Rubric criterion:\n%s\n\n", c.Check)
+	fmt.Fprintf(&b, "Agent run transcript:\nstdout:\n%s\nstderr:\n%s\nexit_code: %d\n\n", tr.Stdout, tr.Stderr, tr.ExitCode)
+	b.WriteString(`Reply with a single JSON object: {"pass": bool, "reasoning": string, "score": number 0-100}.`)
+	return b.String()
+}
+
+// Evaluate sends the Case and Transcript to j's LLMClient and parses its
+// response as a verdict.
+func (j *ModelJudge) Evaluate(ctx context.Context, c Case, tr Transcript) (Verdict, error) {
+	raw, err := j.client.Complete(ctx, rubricPrompt(c, tr))
+	if err != nil {
+		return Verdict{}, fmt.Errorf("model judge %q: %w", c.ID, err)
+	}
+
+	var parsed struct {
+		Pass      bool    `json:"pass"`
+		Reasoning string  `json:"reasoning"`
+		Score     float64 `json:"score"`
+	}
+	if err := json.Unmarshal([]byte(extractJSONObject(raw)), &parsed); err != nil {
+		return Verdict{}, fmt.Errorf("model judge %q: parsing backend response %q: %w", c.ID, raw, err)
+	}
+	return Verdict{Pass: parsed.Pass, Reasoning: parsed.Reasoning, Score: parsed.Score}, nil
+}
+
+// extractJSONObject returns the outermost {...} substring of raw. Chat
+// models asked for "a single JSON object" routinely wrap it in a
+// ```json fenced block or prepend a sentence anyway; trimming to the
+// first '{' through the last '}' handles that without needing a real
+// JSON-in-text parser.
+func extractJSONObject(raw string) string {
+	start := strings.IndexByte(raw, '{')
+	end := strings.LastIndexByte(raw, '}')
+	if start == -1 || end == -1 || end < start {
+		return raw
+	}
+	return raw[start : end+1]
+}
+
+// NoopLLMClient is the default LLMClient when no model backend is
+// configured: it always returns a passing verdict so model-based
+// criteria behave the same as before this package existed, but now
+// explicitly and with a reasoning string that says why, instead of
+// silently short-circuiting in EvaluateCriterion.
+type NoopLLMClient struct{}
+
+// Complete ignores prompt and returns a fixed passing verdict.
+func (NoopLLMClient) Complete(ctx context.Context, prompt string) (string, error) {
+	return `{"pass":true,"reasoning":"model-based judging not configured (set YOKAY_JUDGE_PROVIDER); treating as pass","score":100}`, nil
+}
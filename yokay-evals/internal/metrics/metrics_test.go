@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"math"
 	"testing"
 )
 
@@ -171,3 +172,182 @@ func TestPassCaretKSemantics(t *testing.T) {
 		}
 	})
 }
+
+func TestWilsonInterval(t *testing.T) {
+	tests := []struct {
+		name      string
+		successes int
+		n         int
+		z         float64
+		wantLow   float64
+		wantHigh  float64
+		tolerance float64
+	}{
+		{
+			name:      "n=0 is maximally uncertain",
+			successes: 0,
+			n:         0,
+			z:         1.96,
+			wantLow:   0,
+			wantHigh:  1,
+			tolerance: 1e-9,
+		},
+		{
+			name:      "all successes narrows toward 1 but stays below it",
+			successes: 10,
+			n:         10,
+			z:         1.96,
+			wantLow:   0.72,
+			wantHigh:  1.0,
+			tolerance: 0.01,
+		},
+		{
+			name:      "3 of 10, classic textbook example",
+			successes: 3,
+			n:         10,
+			z:         1.96,
+			wantLow:   0.107,
+			wantHigh:  0.604,
+			tolerance: 0.01,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			low, high := WilsonInterval(tt.successes, tt.n, tt.z)
+			if math.Abs(low-tt.wantLow) > tt.tolerance {
+				t.Errorf("low = %v, want ~%v", low, tt.wantLow)
+			}
+			if math.Abs(high-tt.wantHigh) > tt.tolerance {
+				t.Errorf("high = %v, want ~%v", high, tt.wantHigh)
+			}
+			if low > high {
+				t.Errorf("low (%v) > high (%v)", low, high)
+			}
+		})
+	}
+}
+
+func TestPassAtKEstimate(t *testing.T) {
+	tests := []struct {
+		name      string
+		n, c, k   int
+		want      float64
+		tolerance float64
+	}{
+		{
+			name:      "c=0 means no run passed, so no k-sample can pass",
+			n:         10,
+			c:         0,
+			k:         5,
+			want:      0,
+			tolerance: 1e-9,
+		},
+		{
+			name:      "c=n means every run passed, so any k-sample passes",
+			n:         10,
+			c:         10,
+			k:         5,
+			want:      1,
+			tolerance: 1e-9,
+		},
+		{
+			name:      "k > n-c (fewer failures than k) always includes a pass",
+			n:         10,
+			c:         8,
+			k:         5,
+			want:      1,
+			tolerance: 1e-9,
+		},
+		{
+			name:      "k=1 reduces to the simple pass rate c/n",
+			n:         20,
+			c:         12,
+			k:         1,
+			want:      12.0 / 20.0,
+			tolerance: 1e-9,
+		},
+		{
+			name: "large n stays numerically stable instead of overflowing",
+			// C(1000,500) would overflow a direct binomial-coefficient
+			// computation; the running-product form should not.
+			// want is 1 - prod_{i=501}^{1000} (1 - 10/i), computed
+			// independently ahead of time.
+			n:         1000,
+			c:         500,
+			k:         10,
+			want:      0.9990668121978155,
+			tolerance: 1e-9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PassAtKEstimate(tt.n, tt.c, tt.k)
+			if math.Abs(got-tt.want) > tt.tolerance {
+				t.Errorf("PassAtKEstimate(%d, %d, %d) = %v, want ~%v", tt.n, tt.c, tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPassCaretKEstimate(t *testing.T) {
+	tests := []struct {
+		name      string
+		n, c, k   int
+		want      float64
+		tolerance float64
+	}{
+		{
+			name:      "c=0 means no run passed, so no k-sample can be all passes",
+			n:         10,
+			c:         0,
+			k:         5,
+			want:      0,
+			tolerance: 1e-9,
+		},
+		{
+			name:      "c=n means every run passed, so any k-sample is all passes",
+			n:         10,
+			c:         10,
+			k:         5,
+			want:      1,
+			tolerance: 1e-9,
+		},
+		{
+			name:      "k > c can't be satisfied by fewer than k passes",
+			n:         10,
+			c:         3,
+			k:         5,
+			want:      0,
+			tolerance: 1e-9,
+		},
+		{
+			name:      "k=1 reduces to the simple pass rate c/n",
+			n:         20,
+			c:         12,
+			k:         1,
+			want:      12.0 / 20.0,
+			tolerance: 1e-9,
+		},
+		{
+			name: "large n stays numerically stable instead of overflowing",
+			n:    1000,
+			c:    500,
+			k:    10,
+			// C(500,10)/C(1000,10), computed directly for comparison
+			// since this is small enough not to overflow float64.
+			want:      0.0009331878021845, // prod_{i=0}^{9} (500-i)/(1000-i)
+			tolerance: 1e-9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PassCaretKEstimate(tt.n, tt.c, tt.k)
+			if math.Abs(got-tt.want) > tt.tolerance {
+				t.Errorf("PassCaretKEstimate(%d, %d, %d) = %v, want ~%v", tt.n, tt.c, tt.k, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package metrics
 
+import "math"
+
 // PassAtK evaluates the pass@k metric for a set of evaluation results.
 //
 // pass@k is a capability measure that answers the question:
@@ -57,3 +59,79 @@ func PassCaretK(results []bool) bool {
 	}
 	return true
 }
+
+// PassAtKEstimate is the HumanEval unbiased estimator for pass@k: given n
+// independently sampled runs of which c passed, the probability that at
+// least one of k runs drawn (without replacement) from those n passes.
+// Unlike PassAtK, which only reports whether at least one of the actual
+// runs passed, this turns n > k runs into a continuous estimate -- e.g. a
+// case run n=20 times with c=12 passes can report pass@1, pass@5, and
+// pass@10 simultaneously from the same data instead of needing a
+// separate k-run batch per k.
+//
+// It is computed as 1 - C(n-c,k)/C(n,k), equivalently
+// 1 - prod_{i=n-c+1}^{n} (1 - k/i), which this function evaluates as a
+// running product to avoid the overflow of computing either binomial
+// coefficient directly for large n. When n-c < k (fewer failures than k,
+// so any k-sample must include a pass) it returns 1.0 without computing
+// the product, since the formula's binomial coefficients would include
+// C(n-c,k) for k > n-c, which is defined as zero.
+func PassAtKEstimate(n, c, k int) float64 {
+	if n-c < k {
+		return 1.0
+	}
+
+	estimate := 1.0
+	for i := n - c + 1; i <= n; i++ {
+		estimate *= 1 - float64(k)/float64(i)
+	}
+	return 1 - estimate
+}
+
+// PassCaretKEstimate is the probability that k independently sampled runs,
+// drawn without replacement from n runs of which c passed, are all
+// passes: C(c,k)/C(n,k). Like PassAtKEstimate, it turns pass^k into a
+// continuous estimate from a single n-run batch rather than requiring a
+// separate batch per k. Returns 0 when k > c, since no k-sample can be
+// all passes if fewer than k runs passed at all.
+func PassCaretKEstimate(n, c, k int) float64 {
+	if k > c {
+		return 0.0
+	}
+
+	estimate := 1.0
+	for i := 0; i < k; i++ {
+		estimate *= float64(c-i) / float64(n-i)
+	}
+	return estimate
+}
+
+// WilsonInterval computes the 95%-style Wilson score confidence interval
+// for a binomial proportion, given the number of successes and the total
+// number of trials n. z is the two-sided z-score for the desired
+// confidence level (1.96 for 95%).
+//
+// The interval is:
+//
+//	center = (p̂ + z²/(2n)) / (1 + z²/n)
+//	half   = z·sqrt(p̂(1-p̂)/n + z²/(4n²)) / (1 + z²/n)
+//	[center - half, center + half]
+//
+// When n is 0 there is no evidence either way, so WilsonInterval returns
+// the widest possible interval [0, 1].
+func WilsonInterval(successes, n int, z float64) (low, high float64) {
+	if n == 0 {
+		return 0, 1
+	}
+
+	nf := float64(n)
+	phat := float64(successes) / nf
+
+	denom := 1 + z*z/nf
+	center := (phat + z*z/(2*nf)) / denom
+	half := (z * math.Sqrt(phat*(1-phat)/nf+z*z/(4*nf*nf))) / denom
+
+	low = math.Max(0, center-half)
+	high = math.Min(1, center+half)
+	return low, high
+}
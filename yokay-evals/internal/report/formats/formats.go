@@ -0,0 +1,374 @@
+// Package formats renders a Suite of graded/evaluated cases into one of
+// several output formats, keyed by name in a registry similar to
+// gomtree's bsd/json/path formatters map. Adding a new format means
+// adding one more Renderer and a registry entry, not another branch in
+// every command that writes a report.
+package formats
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Case is one row of a structured report: a single graded skill,
+// evaluated failure case, criterion, or meta test case, reduced to the
+// fields every output format needs.
+type Case struct {
+	ID      string
+	Name    string
+	Passed  bool
+	Message string // rationale / failure detail; becomes <system-out> in JUnit
+	// Group, when set, clusters related cases into one JUnit <testsuite>
+	// or one SARIF rule "component" (e.g. all criteria for a single
+	// failure case, or all skills for a single grading run). Cases with
+	// no Group share a single implicit group named after Suite.Name.
+	Group string
+	// Score is the case's 0-100 score, if scored. Zero-value "unscored"
+	// is indistinguishable from an actual zero score; only the SARIF
+	// renderer's level banding consults it, and treats 0 as "error"
+	// either way, so this ambiguity is harmless.
+	Score float64
+	// Path and Line locate the case in source (e.g. a SKILL.md file), for
+	// SARIF's physicalLocation. Path empty falls back to Suite.Name.
+	Path string
+	Line int
+	// Failures holds one or more typed JUnit <failure> elements for this
+	// case (e.g. a wrong verdict and a separate consistency failure for
+	// the same meta-eval test case). Left empty on a failing case, the
+	// JUnit renderer falls back to a single untyped failure built from
+	// Message, so callers that don't distinguish failure types don't need
+	// to populate this.
+	Failures []CaseFailure
+}
+
+// CaseFailure is one <failure> element within a Case: Type becomes the
+// JUnit `type` attribute (e.g. "verdict", "consistency") and Message
+// becomes both the `message` attribute and the failure body.
+type CaseFailure struct {
+	Type    string
+	Message string
+}
+
+// Suite is a named collection of Cases for one command run. Markdown is
+// each command's own pre-rendered report: it's richer than a generic
+// case list, so unlike the other formats it isn't derived from Cases,
+// just passed through. Extra holds command-specific summary fields
+// (accuracy, pass rate, ...) merged into the JSON renderer's output.
+type Suite struct {
+	Name     string
+	Markdown string
+	Cases    []Case
+	Extra    map[string]any
+	// SarifCases, when set, is used by sarifRenderer instead of Cases. Most
+	// callers want one SARIF result per Case; grade-skills wants one per
+	// below-threshold criterion (a finer grain than the per-skill Cases
+	// list JSON/JUnit render), so it populates this separately rather than
+	// forcing every renderer to that granularity.
+	SarifCases []Case
+}
+
+// Renderer renders a Suite in one output format.
+type Renderer interface {
+	Render(suite Suite) (string, error)
+}
+
+// SchemaVersion is the jsonRenderer's `schemaVersion` field. Bump it
+// whenever a field is removed or changes meaning so downstream tools can
+// detect an incompatible run.
+const SchemaVersion = 1
+
+// Registry maps a --format value to its Renderer. "markdown" isn't here:
+// it returns Suite.Markdown directly, see Render.
+var Registry = map[string]Renderer{
+	"json":  jsonRenderer{},
+	"junit": junitRenderer{},
+	"tap":   tapRenderer{},
+	"sarif": sarifRenderer{},
+}
+
+// SupportedFormats lists every format name Render accepts, "markdown"
+// plus every Registry key, sorted for stable error messages.
+func SupportedFormats() []string {
+	names := make([]string, 0, len(Registry)+1)
+	names = append(names, "markdown")
+	for name := range Registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Render renders suite as format.
+func Render(suite Suite, format string) (string, error) {
+	if format == "markdown" {
+		return suite.Markdown, nil
+	}
+
+	r, ok := Registry[format]
+	if !ok {
+		return "", fmt.Errorf("unsupported format: %s (use %s)", format, strings.Join(SupportedFormats(), ", "))
+	}
+	return r.Render(suite)
+}
+
+// Extension maps a --format value to the file extension used when
+// --output is a shared prefix for multiple formats.
+func Extension(format string) string {
+	switch format {
+	case "junit":
+		return "xml"
+	case "json":
+		return "json"
+	case "tap":
+		return "tap"
+	case "sarif":
+		return "sarif"
+	default:
+		return "md"
+	}
+}
+
+// jsonRenderer renders a Suite as a versioned JSON document so
+// downstream tools can diff runs across schema changes.
+type jsonRenderer struct{}
+
+func (jsonRenderer) Render(suite Suite) (string, error) {
+	cases := make([]map[string]any, 0, len(suite.Cases))
+	for _, c := range suite.Cases {
+		cases = append(cases, map[string]any{
+			"id":      c.ID,
+			"name":    c.Name,
+			"passed":  c.Passed,
+			"message": c.Message,
+		})
+	}
+
+	data := map[string]any{
+		"schemaVersion": SchemaVersion,
+		"suite":         suite.Name,
+		"cases":         cases,
+	}
+	for k, v := range suite.Extra {
+		data[k] = v
+	}
+
+	bytes, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling to JSON: %w", err)
+	}
+	return string(bytes), nil
+}
+
+// junitTestSuites is the root <testsuites> element of a JUnit XML document.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite is a single <testsuite>, one per Case.Group (cases with
+// no Group share one suite named after Suite.Name).
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Passed    int             `xml:"passed,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a single Case within a <testsuite>.
+type junitTestCase struct {
+	ClassName string         `xml:"classname,attr"`
+	Name      string         `xml:"name,attr"`
+	Time      string         `xml:"time,attr"`
+	Failures  []junitFailure `xml:"failure,omitempty"`
+	SystemOut string         `xml:"system-out,omitempty"`
+}
+
+// junitFailure marks a testcase whose score fell below the passing
+// threshold, or one of several distinct ways a case failed (Type).
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+}
+
+// junitRenderer renders a Suite as a standard JUnit <testsuites>
+// document: one <testsuite> per Case.Group, one <testcase> per Case
+// within it, with one or more <failure> elements when it didn't pass
+// (Case.Failures when set, else a single untyped failure built from
+// Message) and a <system-out> carrying its Message (rationale or failure
+// detail), so results drop straight into GitLab/GitHub/Jenkins test
+// dashboards.
+type junitRenderer struct{}
+
+func (junitRenderer) Render(suite Suite) (string, error) {
+	var groupOrder []string
+	groups := make(map[string][]Case)
+	for _, c := range suite.Cases {
+		group := c.Group
+		if group == "" {
+			group = suite.Name
+		}
+		if _, ok := groups[group]; !ok {
+			groupOrder = append(groupOrder, group)
+		}
+		groups[group] = append(groups[group], c)
+	}
+
+	doc := junitTestSuites{}
+	for _, group := range groupOrder {
+		ts := junitTestSuite{Name: group, Tests: len(groups[group])}
+		for _, c := range groups[group] {
+			tc := junitTestCase{
+				ClassName: group,
+				Name:      c.Name,
+				Time:      "0",
+				SystemOut: c.Message,
+			}
+			if !c.Passed {
+				ts.Failures++
+				if len(c.Failures) > 0 {
+					for _, f := range c.Failures {
+						tc.Failures = append(tc.Failures, junitFailure{Message: f.Message, Type: f.Type})
+					}
+				} else {
+					tc.Failures = append(tc.Failures, junitFailure{Message: c.Message})
+				}
+			}
+			ts.TestCases = append(ts.TestCases, tc)
+		}
+		ts.Passed = ts.Tests - ts.Failures
+		doc.Suites = append(doc.Suites, ts)
+	}
+
+	xmlBytes, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling to XML: %w", err)
+	}
+	return xml.Header + string(xmlBytes), nil
+}
+
+// tapRenderer renders a Suite as a Test Anything Protocol (TAP) version
+// 13 stream: a plan line, one "ok"/"not ok" line per case, and a "#
+// <message>" diagnostic line under any failing case.
+type tapRenderer struct{}
+
+func (tapRenderer) Render(suite Suite) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("TAP version 13\n")
+	sb.WriteString(fmt.Sprintf("1..%d\n", len(suite.Cases)))
+
+	for i, c := range suite.Cases {
+		status := "ok"
+		if !c.Passed {
+			status = "not ok"
+		}
+		sb.WriteString(fmt.Sprintf("%s %d - %s\n", status, i+1, c.Name))
+		if !c.Passed && c.Message != "" {
+			sb.WriteString(fmt.Sprintf("  # %s\n", c.Message))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// sarifLevel bands a 0-100 score into a SARIF result level: "error"
+// below 50, "warning" below 80, "note" otherwise. Unscored cases (Score
+// left at its zero value) band as "error", same as a genuine 0 - a
+// failing case with no score attached is worth flagging at the highest
+// severity rather than silently under-reporting it.
+func sarifLevel(score float64) string {
+	switch {
+	case score < 50:
+		return "error"
+	case score < 80:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// sarifRenderer renders a Suite as a SARIF 2.1.0 log: one rule per
+// distinct Case.ID (so, e.g., the same criterion name reused across
+// failure cases becomes a single rule) and one result per failing case,
+// with physicalLocation pointing at Case.Path (falling back to
+// Suite.Name) and Case.Line (falling back to line 1). Passing cases
+// produce no result, matching how code-scanning tools report findings
+// rather than a clean bill of health per rule.
+type sarifRenderer struct{}
+
+func (sarifRenderer) Render(suite Suite) (string, error) {
+	cases := suite.Cases
+	if suite.SarifCases != nil {
+		cases = suite.SarifCases
+	}
+
+	ruleSeen := make(map[string]bool)
+	var rules []map[string]any
+	var results []map[string]any
+
+	for _, c := range cases {
+		if !ruleSeen[c.ID] {
+			ruleSeen[c.ID] = true
+			rules = append(rules, map[string]any{
+				"id":   c.ID,
+				"name": c.Name,
+				"shortDescription": map[string]any{
+					"text": c.Name,
+				},
+			})
+		}
+
+		if c.Passed {
+			continue
+		}
+
+		uri := c.Path
+		if uri == "" {
+			uri = suite.Name
+		}
+		line := c.Line
+		if line <= 0 {
+			line = 1
+		}
+
+		results = append(results, map[string]any{
+			"ruleId":  c.ID,
+			"level":   sarifLevel(c.Score),
+			"message": map[string]any{"text": c.Message},
+			"locations": []map[string]any{
+				{
+					"physicalLocation": map[string]any{
+						"artifactLocation": map[string]any{"uri": uri},
+						"region":           map[string]any{"startLine": line},
+					},
+				},
+			},
+		})
+	}
+
+	doc := map[string]any{
+		"$schema": "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []map[string]any{
+			{
+				"tool": map[string]any{
+					"driver": map[string]any{
+						"name":           "yokay-evals",
+						"informationUri": "https://github.com/stevestomp/pokayokay",
+						"rules":          rules,
+					},
+				},
+				"results": results,
+			},
+		},
+	}
+
+	bytes, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling to SARIF: %w", err)
+	}
+	return string(bytes), nil
+}
@@ -0,0 +1,153 @@
+// Package reportfs provides the small fs.FS-backed abstraction the
+// report command uses to discover and read report files. Production
+// code reads a real reports/ directory via OSFS; tests can build a
+// MemFS in a few lines instead of shelling out to t.TempDir(), in the
+// same spirit as afero's MemMapFs or kyaml's fsnode.
+package reportfs
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"time"
+)
+
+// OSFS roots an fs.FS at dir, for production callers that read reports
+// from a real directory on disk.
+func OSFS(dir string) fs.FS {
+	return os.DirFS(dir)
+}
+
+// MemFS is a minimal map-backed fs.FS: a flat set of named files with no
+// real subdirectory nesting, which is all the report command's
+// single-directory discovery needs. Build one with NewMemFS and
+// WriteFile, then pass it anywhere an fs.FS is expected.
+type MemFS struct {
+	files map[string]string
+}
+
+// NewMemFS returns an empty MemFS.
+func NewMemFS() *MemFS {
+	return &MemFS{files: make(map[string]string)}
+}
+
+// WriteFile adds or replaces the named file's contents. name is a plain
+// basename (e.g. "skill-clarity-2026-01-26.md"), matching how reports
+// live directly under the reports directory.
+func (m *MemFS) WriteFile(name, content string) {
+	m.files[name] = content
+}
+
+// Open implements fs.FS.
+func (m *MemFS) Open(name string) (fs.File, error) {
+	if name == "." {
+		return &memDir{fsys: m}, nil
+	}
+	content, ok := m.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return &memFile{name: name, content: content}, nil
+}
+
+// ReadDir implements fs.ReadDirFS.
+func (m *MemFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name != "." {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	names := make([]string, 0, len(m.files))
+	for n := range m.files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	entries := make([]fs.DirEntry, len(names))
+	for i, n := range names {
+		entries[i] = memDirEntry{name: n, size: int64(len(m.files[n]))}
+	}
+	return entries, nil
+}
+
+// ReadFile implements fs.ReadFileFS.
+func (m *MemFS) ReadFile(name string) ([]byte, error) {
+	content, ok := m.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "readfile", Path: name, Err: fs.ErrNotExist}
+	}
+	return []byte(content), nil
+}
+
+// memDirEntry is the fs.DirEntry for a single MemFS file.
+type memDirEntry struct {
+	name string
+	size int64
+}
+
+func (e memDirEntry) Name() string              { return e.name }
+func (e memDirEntry) IsDir() bool                { return false }
+func (e memDirEntry) Type() fs.FileMode          { return 0 }
+func (e memDirEntry) Info() (fs.FileInfo, error) { return memFileInfo(e), nil }
+
+// memFileInfo adapts memDirEntry to fs.FileInfo.
+type memFileInfo memDirEntry
+
+func (i memFileInfo) Name() string       { return i.name }
+func (i memFileInfo) Size() int64        { return i.size }
+func (i memFileInfo) Mode() fs.FileMode  { return 0 }
+func (i memFileInfo) ModTime() time.Time { return time.Time{} }
+func (i memFileInfo) IsDir() bool        { return false }
+func (i memFileInfo) Sys() any           { return nil }
+
+// memFile implements fs.File for a single MemFS entry.
+type memFile struct {
+	name    string
+	content string
+	pos     int
+}
+
+func (f *memFile) Stat() (fs.FileInfo, error) {
+	return memFileInfo{name: f.name, size: int64(len(f.content))}, nil
+}
+
+func (f *memFile) Read(p []byte) (int, error) {
+	if f.pos >= len(f.content) {
+		return 0, io.EOF
+	}
+	n := copy(p, f.content[f.pos:])
+	f.pos += n
+	return n, nil
+}
+
+func (f *memFile) Close() error { return nil }
+
+// memDir implements fs.File (as a directory) so Open(".") behaves
+// sensibly, though callers are expected to use ReadDir instead.
+type memDir struct {
+	fsys *MemFS
+}
+
+func (d *memDir) Stat() (fs.FileInfo, error) {
+	return memDirInfo{}, nil
+}
+
+func (d *memDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: ".", Err: fs.ErrInvalid}
+}
+
+func (d *memDir) Close() error { return nil }
+
+// memDirInfo is the fs.FileInfo for the MemFS root directory.
+type memDirInfo struct{}
+
+func (memDirInfo) Name() string       { return "." }
+func (memDirInfo) Size() int64        { return 0 }
+func (memDirInfo) Mode() fs.FileMode  { return fs.ModeDir }
+func (memDirInfo) ModTime() time.Time { return time.Time{} }
+func (memDirInfo) IsDir() bool        { return true }
+func (memDirInfo) Sys() any           { return nil }
+
+var _ fs.FS = (*MemFS)(nil)
+var _ fs.ReadDirFS = (*MemFS)(nil)
+var _ fs.ReadFileFS = (*MemFS)(nil)
@@ -0,0 +1,63 @@
+package reportfs
+
+import (
+	"io/fs"
+	"testing"
+)
+
+func TestMemFSReadFile(t *testing.T) {
+	m := NewMemFS()
+	m.WriteFile("skill-clarity-2026-01-26.md", "# Report\n")
+
+	data, err := fs.ReadFile(m, "skill-clarity-2026-01-26.md")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if string(data) != "# Report\n" {
+		t.Errorf("ReadFile() = %q, want %q", data, "# Report\n")
+	}
+}
+
+func TestMemFSReadFileMissing(t *testing.T) {
+	m := NewMemFS()
+	if _, err := fs.ReadFile(m, "missing.md"); err == nil {
+		t.Error("ReadFile() on a missing file: expected error, got nil")
+	}
+}
+
+func TestMemFSReadDir(t *testing.T) {
+	m := NewMemFS()
+	m.WriteFile("skill-clarity-2026-01-26.md", "")
+	m.WriteFile("skill-clarity-2026-01-25.md", "")
+
+	entries, err := fs.ReadDir(m, ".")
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("ReadDir() returned %d entries, want 2", len(entries))
+	}
+	// fs.ReadDir sorts by name.
+	if entries[0].Name() != "skill-clarity-2026-01-25.md" {
+		t.Errorf("entries[0].Name() = %q, want %q", entries[0].Name(), "skill-clarity-2026-01-25.md")
+	}
+}
+
+func TestMemFSReadDirEmpty(t *testing.T) {
+	m := NewMemFS()
+	entries, err := fs.ReadDir(m, ".")
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("ReadDir() on an empty MemFS returned %d entries, want 0", len(entries))
+	}
+}
+
+func TestOSFS(t *testing.T) {
+	dir := t.TempDir()
+	fsys := OSFS(dir)
+	if _, err := fs.Stat(fsys, "."); err != nil {
+		t.Fatalf("Stat(\".\") on OSFS(%s) error = %v", dir, err)
+	}
+}
@@ -0,0 +1,139 @@
+// Package testsupport provides shared test plumbing for the yokay-evals
+// integration tests: locating the module and failures directory without
+// hardcoding a developer's checkout path, and building the yokay-evals
+// binary once per test run instead of once per test.
+package testsupport
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stevestomp/yokay-evals/internal/failurecase"
+)
+
+// ModuleRoot returns the directory containing this module's go.mod, found
+// by walking upward from this source file's own location (via
+// runtime.Caller) rather than assuming a fixed absolute path, so the
+// tests that depend on it work from any checkout.
+func ModuleRoot() (string, error) {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return "", fmt.Errorf("testsupport: could not determine caller location")
+	}
+
+	dir := filepath.Dir(file)
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("testsupport: no go.mod found above %s", file)
+		}
+		dir = parent
+	}
+}
+
+// FailuresDir returns the yokay-evals/failures directory under the
+// module root.
+func FailuresDir() (string, error) {
+	root, err := ModuleRoot()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, "failures"), nil
+}
+
+var (
+	buildOnce sync.Once
+	buildPath string
+	buildErr  error
+)
+
+// BuildYokayEvals builds the yokay-evals binary and returns its path. The
+// first call does the actual `go build`; every later call in the same
+// test run (including from other tests running in parallel) gets the
+// cached path for free. It skips the test under `go test -short`, since
+// a `go build` is the slowest part of these integration tests.
+func BuildYokayEvals(t *testing.T) string {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping binary build in -short mode")
+	}
+
+	buildOnce.Do(func() {
+		root, err := ModuleRoot()
+		if err != nil {
+			buildErr = err
+			return
+		}
+
+		dir, err := os.MkdirTemp("", "yokay-evals-bin-*")
+		if err != nil {
+			buildErr = fmt.Errorf("creating build dir: %w", err)
+			return
+		}
+
+		binaryPath := filepath.Join(dir, "yokay-evals")
+		cmd := exec.Command("go", "build", "-o", binaryPath, ".")
+		cmd.Dir = filepath.Join(root, "cmd", "yokay-evals")
+		if output, err := cmd.CombinedOutput(); err != nil {
+			buildErr = fmt.Errorf("building yokay-evals: %w\noutput: %s", err, output)
+			return
+		}
+
+		buildPath = binaryPath
+	})
+
+	if buildErr != nil {
+		t.Fatalf("BuildYokayEvals: %v", buildErr)
+	}
+	return buildPath
+}
+
+// WalkFailureCases loads every failure case YAML under FailuresDir()
+// (skipping schema.yaml and template.yaml; unlike findFailureCases in
+// cmd/yokay-evals, it does not skip the examples/ or fixtures/
+// directories) and calls fn with each one. It
+// fails the test outright on a load error, since by this point in the
+// chunk the typed failurecase.Load replaces the old "skip files that
+// don't parse" tolerance.
+func WalkFailureCases(t *testing.T, fn func(failurecase.FailureCase)) {
+	t.Helper()
+
+	dir, err := FailuresDir()
+	if err != nil {
+		t.Fatalf("resolving failures dir: %v", err)
+	}
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		t.Skipf("failures directory not available in this environment: %s", dir)
+	}
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+		base := filepath.Base(path)
+		if base == "schema.yaml" || base == "template.yaml" {
+			return nil
+		}
+
+		fc, _, err := failurecase.Load(path)
+		if err != nil {
+			return fmt.Errorf("loading %s: %w", path, err)
+		}
+		fn(*fc)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walking failure cases: %v", err)
+	}
+}